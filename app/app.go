@@ -72,6 +72,9 @@ type LinoBlockchain struct {
 
 	// global param
 	paramHolder param.ParamHolder
+
+	// queriers for custom queries, indexed by route
+	queriers map[string]types.Querier
 }
 
 // NewLinoBlockchain - create a Lino Blockchain instance
@@ -110,7 +113,7 @@ func NewLinoBlockchain(
 	lb.Router().
 		AddRoute(types.AccountRouterName, acc.NewHandler(lb.accountManager, lb.globalManager)).
 		AddRoute(types.PostRouterName, post.NewHandler(
-			lb.postManager, lb.accountManager, lb.globalManager, lb.developerManager)).
+			lb.postManager, lb.accountManager, lb.globalManager, lb.developerManager, lb.infraManager)).
 		AddRoute(types.VoteRouterName, vote.NewHandler(lb.voteManager, lb.accountManager, lb.globalManager)).
 		AddRoute(types.DeveloperRouterName, developer.NewHandler(
 			lb.developerManager, lb.accountManager, lb.globalManager)).
@@ -120,6 +123,10 @@ func NewLinoBlockchain(
 		AddRoute(types.ValidatorRouterName, val.NewHandler(
 			lb.accountManager, lb.valManager, lb.voteManager, lb.globalManager))
 
+	lb.queriers = map[string]types.Querier{
+		types.PostRouterName: post.NewQuerier(lb.postManager, lb.accountManager, lb.globalManager),
+	}
+
 	lb.SetInitChainer(lb.initChainer)
 	lb.SetBeginBlocker(lb.beginBlocker)
 	lb.SetEndBlocker(lb.endBlocker)
@@ -186,7 +193,12 @@ func MakeCodec() *wire.Codec {
 func registerEvent(cdc *wire.Codec) {
 	cdc.RegisterInterface((*types.Event)(nil), nil)
 	cdc.RegisterConcrete(post.RewardEvent{}, "lino/eventReward", nil)
+	cdc.RegisterConcrete(post.TipRewardEvent{}, "lino/eventTipReward", nil)
 	cdc.RegisterConcrete(acc.ReturnCoinEvent{}, "lino/eventReturn", nil)
+	cdc.RegisterConcrete(acc.SubscriptionEvent{}, "lino/eventSubscription", nil)
+	cdc.RegisterConcrete(developer.MatchingPoolExpireEvent{}, "lino/eventMatchingPoolExpire", nil)
+	cdc.RegisterConcrete(val.ReturnUnbondingEvent{}, "lino/eventReturnUnbonding", nil)
+	cdc.RegisterConcrete(vote.RedelegationCompleteEvent{}, "lino/eventRedelegationComplete", nil)
 	cdc.RegisterConcrete(param.ChangeParamEvent{}, "lino/eventCpe", nil)
 	cdc.RegisterConcrete(proposal.DecideProposalEvent{}, "lino/eventDpe", nil)
 }
@@ -370,7 +382,7 @@ func (lb *LinoBlockchain) beginBlocker(ctx sdk.Context, req abci.RequestBeginBlo
 	}
 
 	tags := global.BeginBlocker(ctx, req, lb.globalManager)
-	actualPenalty := val.BeginBlocker(ctx, req, lb.valManager)
+	actualPenalty := val.BeginBlocker(ctx, req, lb.valManager, lb.voteManager)
 
 	// add coins back to inflation pool
 	if err := lb.globalManager.AddToValidatorInflationPool(ctx, actualPenalty); err != nil {
@@ -412,10 +424,30 @@ func (lb *LinoBlockchain) executeEvents(ctx sdk.Context, eventList []types.Event
 				ctx, lb.postManager, lb.accountManager, lb.globalManager, lb.developerManager); err != nil {
 				panic(err)
 			}
+		case post.TipRewardEvent:
+			if err := e.Execute(ctx, lb.accountManager, lb.globalManager, lb.developerManager); err != nil {
+				panic(err)
+			}
 		case acc.ReturnCoinEvent:
 			if err := e.Execute(ctx, lb.accountManager); err != nil {
 				panic(err)
 			}
+		case acc.SubscriptionEvent:
+			if err := e.Execute(ctx, lb.accountManager); err != nil {
+				panic(err)
+			}
+		case developer.MatchingPoolExpireEvent:
+			if err := e.Execute(ctx, lb.developerManager, lb.accountManager); err != nil {
+				panic(err)
+			}
+		case val.ReturnUnbondingEvent:
+			if err := e.Execute(ctx, lb.valManager, lb.accountManager); err != nil {
+				panic(err)
+			}
+		case vote.RedelegationCompleteEvent:
+			if err := e.Execute(ctx, lb.voteManager); err != nil {
+				panic(err)
+			}
 		case proposal.DecideProposalEvent:
 			if err := e.Execute(
 				ctx, lb.voteManager, lb.valManager, lb.accountManager, lb.proposalManager,
@@ -433,7 +465,7 @@ func (lb *LinoBlockchain) executeEvents(ctx sdk.Context, eventList []types.Event
 
 // udpate validator set
 func (lb *LinoBlockchain) endBlocker(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
-	ABCIValList, err := lb.valManager.GetUpdateValidatorList(ctx)
+	ABCIValList, err := lb.valManager.GetUpdateValidatorList(ctx, lb.voteManager)
 	if err != nil {
 		panic(err)
 	}
@@ -492,12 +524,36 @@ func (lb *LinoBlockchain) distributeInflationToValidator(ctx sdk.Context) {
 	if err != nil {
 		panic(err)
 	}
-	// give inflation to each validator evenly
+	powers := make([]int64, len(lst.OncallValidators))
+	totalPower := int64(0)
 	for i, validator := range lst.OncallValidators {
-		ratPerValidator := coin.ToRat().Quo(sdk.NewRat(int64(len(lst.OncallValidators) - i))).Round(types.PrecisionFactor)
+		power, err := lb.valManager.GetValidatorPower(ctx, validator)
+		if err != nil {
+			panic(err)
+		}
+		powers[i] = power
+		totalPower += power
+	}
+	// give inflation to each validator proportional to its power, validator
+	// keeps commission and shares the rest with delegators of its voter account
+	for i, validator := range lst.OncallValidators {
+		ratPerValidator := coin.ToRat().Mul(sdk.NewRat(powers[i], totalPower)).Round(types.PrecisionFactor)
+		totalPower -= powers[i]
 		coinPerValidator := types.RatToCoin(ratPerValidator)
+		commission, err := lb.valManager.GetCommission(ctx, validator, coinPerValidator)
+		if err != nil {
+			panic(err)
+		}
+		voterReward, err := lb.voteManager.DistributeDelegatorReward(
+			ctx, validator, coinPerValidator.Minus(commission))
+		if err != nil {
+			panic(err)
+		}
 		lb.accountManager.AddSavingCoin(
-			ctx, validator, coinPerValidator, "", "", types.ValidatorInflation)
+			ctx, validator, commission.Plus(voterReward), "", "", types.ValidatorInflation)
+		if err := lb.valManager.AddRewardToLedger(ctx, validator, coinPerValidator); err != nil {
+			panic(err)
+		}
 		coin = coin.Minus(coinPerValidator)
 	}
 }
@@ -172,6 +172,8 @@ func LinoBlockchainGenState(cdc *wire.Codec, appGenTxs []json.RawMessage) (appSt
 				VoterCoinReturnTimes:           int64(7),
 				DelegatorCoinReturnIntervalSec: int64(7 * 24 * 3600),
 				DelegatorCoinReturnTimes:       int64(7),
+				RedelegationCooldownSec:        int64(7 * 24 * 3600),
+				MaxRedelegations:               int64(7),
 			},
 			param.ProposalParam{
 				ContentCensorshipDecideSec:  int64(24 * 7 * 3600),
@@ -202,9 +204,16 @@ func LinoBlockchainGenState(cdc *wire.Codec, appGenTxs []json.RawMessage) (appSt
 				ValidatorCoinReturnTimes:       int64(7),
 				PenaltyMissVote:                types.NewCoinFromInt64(20000 * types.Decimals),
 				PenaltyMissCommit:              types.NewCoinFromInt64(200 * types.Decimals),
-				PenaltyByzantine:               types.NewCoinFromInt64(1000000 * types.Decimals),
+				PenaltyByzantine:               types.NewCoinFromInt64(0),
+				SlashFractionByzantine:         sdk.NewRat(1, 10),
 				ValidatorListSize:              int64(21),
-				AbsentCommitLimitation:         int64(600), // 10min
+				SignedBlocksWindow:             int64(600), // 10min
+				MinSignedPerWindow:             sdk.NewRat(1, 2),
+				JailDurationSec:                int64(24 * 3600),
+				MaxEvidenceAgeSec:              int64(7 * 7 * 24 * 3600),
+				MaxCommissionRate:              sdk.NewRat(1, 5),
+				MaxCommissionChangeRate:        sdk.NewRat(1, 100),
+				MaxPowerChangeRate:             sdk.NewRat(1, 10),
 			},
 			param.CoinDayParam{
 				SecondsToRecoverCoinDayStake: int64(7 * 24 * 3600),
@@ -221,6 +230,14 @@ func LinoBlockchainGenState(cdc *wire.Codec, appGenTxs []json.RawMessage) (appSt
 			param.PostParam{
 				ReportOrUpvoteIntervalSec: 24 * 3600,
 				PostIntervalSec:           600,
+				MaxNumOfTags:              5,
+				CurationRewardRatio:       sdk.NewRat(1, 10),
+				ReportHalfLifeSec:         30 * 24 * 3600,
+				ViewDedupIntervalSec:      3600,
+				ConsumptionPerView:        types.NewCoinFromInt64(types.Decimals / 1000),
+				RepostRoyaltyRate:         sdk.NewRat(1, 5),
+				MaxRepostChainDepth:       5,
+				PenaltyScoreRefreshSec:    3600,
 			},
 		},
 		InitGlobalMeta: globalModel.InitParamList{
@@ -9,6 +9,7 @@ import (
 	"github.com/lino-network/lino/types"
 	globalModel "github.com/lino-network/lino/x/global/model"
 	"github.com/stretchr/testify/assert"
+	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
 
 	"github.com/cosmos/cosmos-sdk/server/config"
@@ -73,6 +74,8 @@ func TestGetGenesisJson(t *testing.T) {
 				VoterCoinReturnTimes:           int64(7),
 				DelegatorCoinReturnIntervalSec: int64(7 * 24 * 3600),
 				DelegatorCoinReturnTimes:       int64(7),
+				RedelegationCooldownSec:        int64(7 * 24 * 3600),
+				MaxRedelegations:               int64(7),
 			},
 			param.ProposalParam{
 				ContentCensorshipDecideSec:  int64(24 * 7 * 3600),
@@ -103,9 +106,16 @@ func TestGetGenesisJson(t *testing.T) {
 				ValidatorCoinReturnTimes:       int64(7),
 				PenaltyMissVote:                types.NewCoinFromInt64(20000 * types.Decimals),
 				PenaltyMissCommit:              types.NewCoinFromInt64(200 * types.Decimals),
-				PenaltyByzantine:               types.NewCoinFromInt64(1000000 * types.Decimals),
+				PenaltyByzantine:               types.NewCoinFromInt64(0),
+				SlashFractionByzantine:         sdk.NewRat(1, 10),
 				ValidatorListSize:              int64(21),
-				AbsentCommitLimitation:         int64(600), // 30min
+				SignedBlocksWindow:             int64(600), // 30min
+				MinSignedPerWindow:             sdk.NewRat(1, 2),
+				JailDurationSec:                int64(24 * 3600),
+				MaxEvidenceAgeSec:              int64(7 * 7 * 24 * 3600),
+				MaxCommissionRate:              sdk.NewRat(1, 5),
+				MaxCommissionChangeRate:        sdk.NewRat(1, 100),
+				MaxPowerChangeRate:             sdk.NewRat(1, 10),
 			},
 			param.CoinDayParam{
 				SecondsToRecoverCoinDayStake: int64(7 * 24 * 3600),
@@ -122,6 +132,14 @@ func TestGetGenesisJson(t *testing.T) {
 			param.PostParam{
 				ReportOrUpvoteIntervalSec: 24 * 3600,
 				PostIntervalSec:           600,
+				MaxNumOfTags:              5,
+				CurationRewardRatio:       sdk.NewRat(1, 10),
+				ReportHalfLifeSec:         30 * 24 * 3600,
+				ViewDedupIntervalSec:      3600,
+				ConsumptionPerView:        types.NewCoinFromInt64(types.Decimals / 1000),
+				RepostRoyaltyRate:         sdk.NewRat(1, 5),
+				MaxRepostChainDepth:       5,
+				PenaltyScoreRefreshSec:    3600,
 			},
 		},
 		InitGlobalMeta: globalModel.InitParamList{
@@ -190,3 +208,35 @@ func TestLinoBlockchainGenState(t *testing.T) {
 	assert.Equal(t, 1, len(genesisState.Developers))
 	assert.Equal(t, 1, len(genesisState.Infra))
 }
+
+func TestLegacyGenesisValidatorParam(t *testing.T) {
+	logger, db := loggerAndDB()
+	lb := NewLinoBlockchain(logger, db, nil)
+	var genTxConfig config.GenTx
+	appGenTx, _, _, err := LinoBlockchainGenTx(lb.cdc, secp256k1.GenPrivKey().PubKey(), genTxConfig)
+	assert.Nil(t, err)
+	appState, err := LinoBlockchainGenState(lb.cdc, []json.RawMessage{appGenTx})
+	assert.Nil(t, err)
+
+	// genesis generated before signing window only has absent commit limitation
+	genesisState := new(GenesisState)
+	err = lb.cdc.UnmarshalJSON(appState, genesisState)
+	assert.Nil(t, err)
+	genesisState.GenesisParam.ValidatorParam.AbsentCommitLimitation = 600
+	genesisState.GenesisParam.ValidatorParam.SignedBlocksWindow = 0
+	legacyState, err := wire.MarshalJSONIndent(lb.cdc, genesisState)
+	assert.Nil(t, err)
+
+	roundTripState := new(GenesisState)
+	err = lb.cdc.UnmarshalJSON(legacyState, roundTripState)
+	assert.Nil(t, err)
+	assert.Equal(t, *genesisState, *roundTripState)
+
+	lb.InitChain(abci.RequestInitChain{AppStateBytes: legacyState})
+	lb.Commit()
+	ctx := lb.BaseApp.NewContext(true, abci.Header{})
+	validatorParam, err := lb.paramHolder.GetValidatorParam(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(600), validatorParam.SignedBlocksWindow)
+	assert.True(t, sdk.NewRat(1, 600).Equal(validatorParam.MinSignedPerWindow))
+}
@@ -0,0 +1,51 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/lino-network/lino/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// Query - custom queries are answered by module queriers with committed state
+// at last block time, other queries are handled by base app. Queriers run on a
+// cache of check state which is never written, so state they touch is discarded
+func (lb *LinoBlockchain) Query(req abci.RequestQuery) abci.ResponseQuery {
+	path := strings.Split(strings.TrimPrefix(req.Path, "/"), "/")
+	if len(path) == 0 || path[0] != types.CustomQueryRoute {
+		return lb.BaseApp.Query(req)
+	}
+	if len(path) < 3 {
+		return queryErrorResponse(sdk.ErrUnknownRequest(fmt.Sprintf("invalid custom query path: %v", req.Path)))
+	}
+	querier, ok := lb.queriers[path[1]]
+	if !ok {
+		return queryErrorResponse(sdk.ErrUnknownRequest(fmt.Sprintf("no querier for route: %v", path[1])))
+	}
+
+	ctx := lb.BaseApp.NewContext(true, abci.Header{})
+	lastBlockTime, err := lb.globalManager.GetLastBlockTime(ctx)
+	if err != nil {
+		return queryErrorResponse(err)
+	}
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(lastBlockTime, 0)}).
+		WithMultiStore(ctx.MultiStore().CacheMultiStore())
+
+	res, err := querier(ctx, path[2:], req.Data)
+	if err != nil {
+		return queryErrorResponse(err)
+	}
+	return abci.ResponseQuery{Value: res}
+}
+
+func queryErrorResponse(err sdk.Error) abci.ResponseQuery {
+	result := err.Result()
+	return abci.ResponseQuery{
+		Code: uint32(result.Code),
+		Log:  result.Log,
+	}
+}
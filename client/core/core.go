@@ -77,6 +77,29 @@ func (ctx CoreContext) query(key cmn.HexBytes, storeName, endPath string) (res [
 	return resp.Value, nil
 }
 
+// QueryCustom - query module querier registered in app with the provided route and endpoint
+func (ctx CoreContext) QueryCustom(route, endpoint string, data []byte) (res []byte, err error) {
+	path := fmt.Sprintf("/custom/%s/%s", route, endpoint)
+	node, err := ctx.GetNode()
+	if err != nil {
+		return res, err
+	}
+
+	opts := rpcclient.ABCIQueryOptions{
+		Height:  ctx.Height,
+		Trusted: ctx.TrustNode,
+	}
+	result, err := node.ABCIQueryWithOptions(path, data, opts)
+	if err != nil {
+		return res, err
+	}
+	resp := result.Response
+	if resp.Code != uint32(0) {
+		return res, errors.Errorf("Query failed: (%d) %s", resp.Code, resp.Log)
+	}
+	return resp.Value, nil
+}
+
 // sign and build the transaction from the msg
 func (ctx CoreContext) SignAndBuild(msgs []sdk.Msg, cdc *wire.Codec) ([]byte, error) {
 	// build the Sign Messsage from the Standard Message
@@ -23,6 +23,12 @@ const (
 	FlagAmount   = "amount"
 	FlagMemo     = "memo"
 
+	// Subscription
+	FlagSubscriber  = "subscriber"
+	FlagCreator     = "creator"
+	FlagIsSubscribe = "is-subscribe"
+	FlagPeriods     = "periods"
+
 	// Developer
 	FlagDeveloper   = "developer"
 	FlagDeposit     = "deposit"
@@ -34,6 +40,8 @@ const (
 	FlagSeconds     = "seconds"
 	FlagPermission  = "permission"
 	FlagGrantAmount = "grant-amount"
+	FlagBudget      = "budget"
+	FlagPerUserCap  = "per-user-cap"
 
 	// Infra
 	FlagProvider = "provider"
@@ -52,12 +60,35 @@ const (
 	FlagSourceAuthor            = "source-author"
 	FlagSourcePostID            = "source-post-ID"
 	FlagRedistributionSplitRate = "redistribution-split-rate"
+	FlagTags                    = "tags"
+	FlagLimit                   = "limit"
+	FlagOffset                  = "offset"
+	FlagSortBy                  = "sort-by"
+	FlagAccessMode              = "access-mode"
+	FlagUnlockPrice             = "unlock-price"
+	FlagCoAuthors               = "co-authors"
+	FlagReference               = "reference"
+	FlagReplyMode               = "reply-mode"
+	FlagCommentAuthor           = "comment-author"
+	FlagCommentPostID           = "comment-post-ID"
+	FlagIsHidden                = "is-hidden"
+	FlagIncludeHidden           = "include-hidden"
+	FlagApp                     = "app"
+	FlagReceipts                = "receipts"
 
 	// Vote
 	FlagVoter      = "voter"
+	FlagFromVoter  = "from-voter"
+	FlagToVoter    = "to-voter"
 	FlagProposalID = "proposal-id"
 	FlagResult     = "result"
 	FlagLink       = "link"
+
+	// Validator
+	FlagCommissionRate = "commission-rate"
+	FlagMoniker        = "moniker"
+	FlagContact        = "contact"
+	FlagPrivValidator  = "priv-validator"
 )
 
 // LineBreak can be included in a command list to provide a blank line
@@ -80,6 +80,10 @@ func main() {
 		client.PostCommands(
 			acccmd.FollowTxCmd(cdc),
 		)...)
+	linocliCmd.AddCommand(
+		client.PostCommands(
+			acccmd.SubscribeTxCmd(cdc),
+		)...)
 	linocliCmd.AddCommand(
 		client.PostCommands(
 			postcmd.PostTxCmd(cdc),
@@ -95,11 +99,25 @@ func main() {
 	linocliCmd.AddCommand(
 		client.PostCommands(
 			postcmd.ViewTxCmd(cdc),
+			postcmd.ViewReceiptsTxCmd(cdc),
 		)...)
 	linocliCmd.AddCommand(
 		client.PostCommands(
 			postcmd.DonateTxCmd(cdc),
 		)...)
+	linocliCmd.AddCommand(
+		client.PostCommands(
+			postcmd.TipTxCmd(cdc),
+		)...)
+	linocliCmd.AddCommand(
+		client.PostCommands(
+			postcmd.UnlockPostTxCmd(cdc),
+		)...)
+	linocliCmd.AddCommand(
+		client.PostCommands(
+			postcmd.ApproveCoAuthorTxCmd(cdc),
+			postcmd.HideCommentTxCmd(cdc),
+		)...)
 	linocliCmd.AddCommand(
 		client.PostCommands(
 			validatorcmd.DepositValidatorTxCmd(cdc),
@@ -112,6 +130,22 @@ func main() {
 		client.PostCommands(
 			validatorcmd.RevokeTxCmd(cdc),
 		)...)
+	linocliCmd.AddCommand(
+		client.PostCommands(
+			validatorcmd.UnjailTxCmd(cdc),
+		)...)
+	linocliCmd.AddCommand(
+		client.PostCommands(
+			validatorcmd.SetCommissionTxCmd(cdc),
+		)...)
+	linocliCmd.AddCommand(
+		client.PostCommands(
+			validatorcmd.UpdateValidatorTxCmd(cdc),
+		)...)
+	linocliCmd.AddCommand(
+		client.PostCommands(
+			validatorcmd.RotateKeyTxCmd(cdc),
+		)...)
 	linocliCmd.AddCommand(
 		client.PostCommands(
 			delegationcmd.RevokeDelegateTxCmd(cdc),
@@ -124,10 +158,26 @@ func main() {
 		client.PostCommands(
 			delegationcmd.WithdrawDelegateTxCmd(cdc),
 		)...)
+	linocliCmd.AddCommand(
+		client.PostCommands(
+			delegationcmd.ClaimDelegatorRewardTxCmd(cdc),
+		)...)
+	linocliCmd.AddCommand(
+		client.PostCommands(
+			delegationcmd.RedelegateTxCmd(cdc),
+		)...)
 	linocliCmd.AddCommand(
 		client.GetCommands(
 			delegatecmd.GetDelegationCmd(types.VoteKVStoreKey, cdc),
 		)...)
+	linocliCmd.AddCommand(
+		client.GetCommands(
+			delegatecmd.GetDelegatorRewardCmd(types.VoteKVStoreKey, cdc),
+		)...)
+	linocliCmd.AddCommand(
+		client.GetCommands(
+			delegatecmd.GetRedelegationsCmd(types.VoteKVStoreKey, cdc),
+		)...)
 
 	linocliCmd.AddCommand(
 		client.PostCommands(
@@ -192,6 +242,10 @@ func main() {
 		client.PostCommands(
 			developercmd.DeveloperUpdateTxCmd(cdc),
 		)...)
+	linocliCmd.AddCommand(
+		client.PostCommands(
+			developercmd.OpenMatchingPoolTxCmd(cdc),
+		)...)
 
 	linocliCmd.AddCommand(
 		client.GetCommands(
@@ -207,7 +261,31 @@ func main() {
 		)...)
 	linocliCmd.AddCommand(
 		client.GetCommands(
-			postcmd.GetPostCmd(types.PostKVStoreKey, cdc),
+			acccmd.GetSubscribersCmd(types.AccountKVStoreKey, cdc),
+		)...)
+	linocliCmd.AddCommand(
+		client.GetCommands(
+			acccmd.GetSubscriptionsCmd(types.AccountKVStoreKey, cdc),
+			acccmd.GetTopSupportersCmd(types.AccountKVStoreKey, cdc),
+		)...)
+	postCmd := postcmd.GetPostCmd(types.PostKVStoreKey, cdc)
+	postCmd.AddCommand(
+		client.GetCommands(
+			postcmd.GetPostsByTagCmd(types.PostKVStoreKey, cdc),
+			postcmd.GetPostCommentsCmd(types.PostKVStoreKey, cdc),
+		)...)
+	linocliCmd.AddCommand(
+		client.GetCommands(
+			postCmd,
+		)...)
+	linocliCmd.AddCommand(
+		client.GetCommands(
+			postcmd.GetPostAccessCmd(types.PostKVStoreKey, cdc),
+			postcmd.GetPostReportsCmd(types.PostKVStoreKey, cdc),
+			postcmd.GetPostDonorsCmd(types.PostKVStoreKey, cdc),
+			postcmd.GetPostRoyaltiesCmd(types.PostKVStoreKey, cdc),
+			postcmd.GetRewardEstimateCmd(types.PostKVStoreKey, cdc),
+			postcmd.GetPostPenaltyScoreCmd(types.PostKVStoreKey, cdc),
 		)...)
 
 	linocliCmd.AddCommand(
@@ -227,14 +305,29 @@ func main() {
 		client.GetCommands(
 			developercmd.GetDevelopersCmd(types.DeveloperKVStoreKey, cdc),
 		)...)
+	linocliCmd.AddCommand(
+		client.GetCommands(
+			developercmd.GetMatchingPoolCmd(types.DeveloperKVStoreKey, cdc),
+		)...)
 
 	linocliCmd.AddCommand(
 		client.GetCommands(
 			validatorcmd.GetValidatorsCmd(types.ValidatorKVStoreKey, cdc),
 		)...)
+	validatorCmd := validatorcmd.GetValidatorCmd(types.ValidatorKVStoreKey, cdc)
+	validatorCmd.AddCommand(
+		client.GetCommands(
+			validatorcmd.GetValidatorListCmd(types.ValidatorKVStoreKey, cdc),
+		)...)
+	linocliCmd.AddCommand(
+		client.GetCommands(
+			validatorCmd,
+		)...)
 	linocliCmd.AddCommand(
 		client.GetCommands(
-			validatorcmd.GetValidatorCmd(types.ValidatorKVStoreKey, cdc),
+			validatorcmd.GetSigningInfoCmd(types.ValidatorKVStoreKey, cdc),
+			validatorcmd.GetEvidenceCmd(types.ValidatorKVStoreKey, cdc),
+			validatorcmd.GetLedgerCmd(types.ValidatorKVStoreKey, cdc),
 		)...)
 
 	// add proxy, version and key info
@@ -67,6 +67,14 @@ func (ph ParamHolder) InitParam(ctx sdk.Context) error {
 	postParam := &PostParam{
 		ReportOrUpvoteIntervalSec: 24 * 3600,
 		PostIntervalSec:           600,
+		MaxNumOfTags:              5,
+		CurationRewardRatio:       sdk.NewRat(1, 10),
+		ReportHalfLifeSec:         30 * 24 * 3600,
+		ViewDedupIntervalSec:      3600,
+		ConsumptionPerView:        types.NewCoinFromInt64(types.Decimals / 1000),
+		RepostRoyaltyRate:         sdk.NewRat(1, 5),
+		MaxRepostChainDepth:       5,
+		PenaltyScoreRefreshSec:    3600,
 	}
 	if err := ph.setPostParam(ctx, postParam); err != nil {
 		return err
@@ -101,9 +109,16 @@ func (ph ParamHolder) InitParam(ctx sdk.Context) error {
 		ValidatorCoinReturnTimes:       int64(7),
 		PenaltyMissVote:                types.NewCoinFromInt64(20000 * types.Decimals),
 		PenaltyMissCommit:              types.NewCoinFromInt64(200 * types.Decimals),
-		PenaltyByzantine:               types.NewCoinFromInt64(1000000 * types.Decimals),
+		PenaltyByzantine:               types.NewCoinFromInt64(0),
+		SlashFractionByzantine:         sdk.NewRat(1, 10),
 		ValidatorListSize:              int64(21),
-		AbsentCommitLimitation:         int64(600), // 30min
+		SignedBlocksWindow:             int64(600), // 30min
+		MinSignedPerWindow:             sdk.NewRat(1, 2),
+		JailDurationSec:                int64(24 * 3600),
+		MaxEvidenceAgeSec:              int64(7 * 7 * 24 * 3600),
+		MaxCommissionRate:              sdk.NewRat(1, 5),
+		MaxCommissionChangeRate:        sdk.NewRat(1, 100),
+		MaxPowerChangeRate:             sdk.NewRat(1, 10),
 	}
 	if err := ph.setValidatorParam(ctx, validatorParam); err != nil {
 		return err
@@ -117,6 +132,8 @@ func (ph ParamHolder) InitParam(ctx sdk.Context) error {
 		VoterCoinReturnTimes:           int64(7),
 		DelegatorCoinReturnIntervalSec: int64(7 * 24 * 3600),
 		DelegatorCoinReturnTimes:       int64(7),
+		RedelegationCooldownSec:        int64(7 * 24 * 3600),
+		MaxRedelegations:               int64(7),
 	}
 	if err := ph.setVoteParam(ctx, voteParam); err != nil {
 		return err
@@ -284,6 +301,44 @@ func (ph ParamHolder) GetPostParam(ctx sdk.Context) (*PostParam, sdk.Error) {
 	if err := ph.cdc.UnmarshalJSON(paramBytes, param); err != nil {
 		return nil, ErrFailedToUnmarshalPostParam(err)
 	}
+	// param set before tags, curation, views and reposts only has post and report intervals
+	legacy := new(struct {
+		MaxNumOfTags           *int64      `json:"max_num_of_tags"`
+		CurationRewardRatio    *sdk.Rat    `json:"curation_reward_ratio"`
+		ReportHalfLifeSec      *int64      `json:"report_half_life_second"`
+		ViewDedupIntervalSec   *int64      `json:"view_dedup_interval_second"`
+		ConsumptionPerView     *types.Coin `json:"consumption_per_view"`
+		RepostRoyaltyRate      *sdk.Rat    `json:"repost_royalty_rate"`
+		MaxRepostChainDepth    *int64      `json:"max_repost_chain_depth"`
+		PenaltyScoreRefreshSec *int64      `json:"penalty_score_refresh_second"`
+	})
+	if err := ph.cdc.UnmarshalJSON(paramBytes, legacy); err != nil {
+		return nil, ErrFailedToUnmarshalPostParam(err)
+	}
+	if legacy.MaxNumOfTags == nil {
+		param.MaxNumOfTags = 5
+	}
+	if legacy.CurationRewardRatio == nil {
+		param.CurationRewardRatio = sdk.NewRat(1, 10)
+	}
+	if legacy.ReportHalfLifeSec == nil {
+		param.ReportHalfLifeSec = 30 * 24 * 3600
+	}
+	if legacy.ViewDedupIntervalSec == nil {
+		param.ViewDedupIntervalSec = 3600
+	}
+	if legacy.ConsumptionPerView == nil {
+		param.ConsumptionPerView = types.NewCoinFromInt64(types.Decimals / 1000)
+	}
+	if legacy.RepostRoyaltyRate == nil {
+		param.RepostRoyaltyRate = sdk.NewRat(1, 5)
+	}
+	if legacy.MaxRepostChainDepth == nil {
+		param.MaxRepostChainDepth = 5
+	}
+	if legacy.PenaltyScoreRefreshSec == nil {
+		param.PenaltyScoreRefreshSec = 3600
+	}
 	return param, nil
 }
 
@@ -312,6 +367,20 @@ func (ph ParamHolder) GetVoteParam(ctx sdk.Context) (*VoteParam, sdk.Error) {
 	if err := ph.cdc.UnmarshalJSON(paramBytes, param); err != nil {
 		return nil, ErrFailedToUnmarshalVoteParam(err)
 	}
+	// param set before redelegation has no redelegation cooldown and limit
+	legacy := new(struct {
+		RedelegationCooldownSec *int64 `json:"redelegation_cooldown_second"`
+		MaxRedelegations        *int64 `json:"max_redelegations"`
+	})
+	if err := ph.cdc.UnmarshalJSON(paramBytes, legacy); err != nil {
+		return nil, ErrFailedToUnmarshalVoteParam(err)
+	}
+	if legacy.RedelegationCooldownSec == nil {
+		param.RedelegationCooldownSec = int64(7 * 24 * 3600)
+	}
+	if legacy.MaxRedelegations == nil {
+		param.MaxRedelegations = int64(7)
+	}
 	return param, nil
 }
 
@@ -340,6 +409,55 @@ func (ph ParamHolder) GetValidatorParam(ctx sdk.Context) (*ValidatorParam, sdk.E
 	if err := ph.cdc.UnmarshalJSON(paramBytes, param); err != nil {
 		return nil, ErrFailedToUnmarshalValidatorParam(err)
 	}
+	// fields missing from param set before they were introduced are backfilled
+	legacy := new(struct {
+		PenaltyByzantine        *types.Coin `json:"penalty_byzantine"`
+		SlashFractionByzantine  *sdk.Rat    `json:"slash_fraction_byzantine"`
+		MinSignedPerWindow      *sdk.Rat    `json:"min_signed_per_window"`
+		JailDurationSec         *int64      `json:"jail_duration_second"`
+		MaxEvidenceAgeSec       *int64      `json:"max_evidence_age_second"`
+		MaxCommissionRate       *sdk.Rat    `json:"max_commission_rate"`
+		MaxCommissionChangeRate *sdk.Rat    `json:"max_commission_change_rate"`
+		MaxPowerChangeRate      *sdk.Rat    `json:"max_power_change_rate"`
+	})
+	if err := ph.cdc.UnmarshalJSON(paramBytes, legacy); err != nil {
+		return nil, ErrFailedToUnmarshalValidatorParam(err)
+	}
+	if legacy.PenaltyByzantine == nil {
+		param.PenaltyByzantine = types.NewCoinFromInt64(0)
+	}
+	// param set before slash fraction only has fixed byzantine penalty
+	if legacy.SlashFractionByzantine == nil {
+		param.SlashFractionByzantine = sdk.ZeroRat()
+	}
+	// param set before signing window only has absent commit limitation,
+	// which punishes validator missing every block in the window
+	if param.SignedBlocksWindow == 0 && param.AbsentCommitLimitation > 0 {
+		param.SignedBlocksWindow = param.AbsentCommitLimitation
+		param.MinSignedPerWindow = sdk.NewRat(1, param.AbsentCommitLimitation)
+	} else {
+		if param.SignedBlocksWindow == 0 {
+			param.SignedBlocksWindow = int64(600)
+		}
+		if legacy.MinSignedPerWindow == nil {
+			param.MinSignedPerWindow = sdk.NewRat(1, 2)
+		}
+	}
+	if legacy.JailDurationSec == nil {
+		param.JailDurationSec = int64(24 * 3600)
+	}
+	if legacy.MaxEvidenceAgeSec == nil {
+		param.MaxEvidenceAgeSec = int64(7 * 7 * 24 * 3600)
+	}
+	if legacy.MaxCommissionRate == nil {
+		param.MaxCommissionRate = sdk.NewRat(1, 5)
+	}
+	if legacy.MaxCommissionChangeRate == nil {
+		param.MaxCommissionChangeRate = sdk.NewRat(1, 100)
+	}
+	if legacy.MaxPowerChangeRate == nil {
+		param.MaxPowerChangeRate = sdk.NewRat(1, 10)
+	}
 	return param, nil
 }
 
@@ -105,9 +105,16 @@ func TestValidatorParam(t *testing.T) {
 		ValidatorCoinReturnTimes:       int64(7),
 		PenaltyMissVote:                types.NewCoinFromInt64(20000 * types.Decimals),
 		PenaltyMissCommit:              types.NewCoinFromInt64(200 * types.Decimals),
-		PenaltyByzantine:               types.NewCoinFromInt64(1000000 * types.Decimals),
+		PenaltyByzantine:               types.NewCoinFromInt64(0),
+		SlashFractionByzantine:         sdk.NewRat(1, 10),
 		ValidatorListSize:              int64(21),
-		AbsentCommitLimitation:         int64(100),
+		SignedBlocksWindow:             int64(100),
+		MinSignedPerWindow:             sdk.NewRat(1, 2),
+		JailDurationSec:                int64(24 * 3600),
+		MaxEvidenceAgeSec:              int64(7 * 7 * 24 * 3600),
+		MaxCommissionRate:              sdk.NewRat(1, 5),
+		MaxCommissionChangeRate:        sdk.NewRat(1, 100),
+		MaxPowerChangeRate:             sdk.NewRat(1, 10),
 	}
 	err := ph.setValidatorParam(ctx, &parameter)
 	assert.Nil(t, err)
@@ -117,6 +124,107 @@ func TestValidatorParam(t *testing.T) {
 	assert.Equal(t, parameter, *resultPtr, "Validator param should be equal")
 }
 
+func TestLegacyValidatorParam(t *testing.T) {
+	ph := NewParamHolder(TestKVStoreKey)
+	ctx := getContext()
+	legacyParam := struct {
+		ValidatorListSize      int64 `json:"validator_list_size"`
+		AbsentCommitLimitation int64 `json:"absent_commit_limitation"`
+	}{
+		ValidatorListSize:      int64(21),
+		AbsentCommitLimitation: int64(600),
+	}
+	paramBytes, err := ph.cdc.MarshalJSON(legacyParam)
+	assert.Nil(t, err)
+	ctx.KVStore(ph.key).Set(GetValidatorParamKey(), paramBytes)
+
+	resultPtr, sdkErr := ph.GetValidatorParam(ctx)
+	assert.Nil(t, sdkErr)
+	assert.Equal(t, int64(600), resultPtr.AbsentCommitLimitation)
+	assert.Equal(t, int64(600), resultPtr.SignedBlocksWindow)
+	assert.True(t, sdk.NewRat(1, 600).Equal(resultPtr.MinSignedPerWindow))
+	assert.Equal(t, int64(21), resultPtr.ValidatorListSize)
+	assert.Equal(t, int64(24*3600), resultPtr.JailDurationSec)
+	assert.Equal(t, int64(7*7*24*3600), resultPtr.MaxEvidenceAgeSec)
+	assert.True(t, sdk.NewRat(1, 5).Equal(resultPtr.MaxCommissionRate))
+	assert.True(t, sdk.NewRat(1, 100).Equal(resultPtr.MaxCommissionChangeRate))
+	assert.True(t, sdk.NewRat(1, 10).Equal(resultPtr.MaxPowerChangeRate))
+}
+
+func TestLegacyVoteParam(t *testing.T) {
+	ph := NewParamHolder(TestKVStoreKey)
+	ctx := getContext()
+	legacyParam := struct {
+		VoterMinDeposit          types.Coin `json:"voter_min_deposit"`
+		DelegatorCoinReturnTimes int64      `json:"delegator_coin_return_times"`
+	}{
+		VoterMinDeposit:          types.NewCoinFromInt64(1000 * types.Decimals),
+		DelegatorCoinReturnTimes: int64(7),
+	}
+	paramBytes, err := ph.cdc.MarshalJSON(legacyParam)
+	assert.Nil(t, err)
+	ctx.KVStore(ph.key).Set(GetVoteParamKey(), paramBytes)
+
+	resultPtr, sdkErr := ph.GetVoteParam(ctx)
+	assert.Nil(t, sdkErr)
+	assert.Equal(t, types.NewCoinFromInt64(1000*types.Decimals), resultPtr.VoterMinDeposit)
+	assert.Equal(t, int64(7*24*3600), resultPtr.RedelegationCooldownSec)
+	assert.Equal(t, int64(7), resultPtr.MaxRedelegations)
+}
+
+func TestLegacyByzantineValidatorParam(t *testing.T) {
+	ph := NewParamHolder(TestKVStoreKey)
+	ctx := getContext()
+	legacyParam := struct {
+		PenaltyByzantine       types.Coin `json:"penalty_byzantine"`
+		ValidatorListSize      int64      `json:"validator_list_size"`
+		AbsentCommitLimitation int64      `json:"absent_commit_limitation"`
+	}{
+		PenaltyByzantine:       types.NewCoinFromInt64(1000000 * types.Decimals),
+		ValidatorListSize:      int64(21),
+		AbsentCommitLimitation: int64(600),
+	}
+	paramBytes, err := ph.cdc.MarshalJSON(legacyParam)
+	assert.Nil(t, err)
+	ctx.KVStore(ph.key).Set(GetValidatorParamKey(), paramBytes)
+
+	resultPtr, sdkErr := ph.GetValidatorParam(ctx)
+	assert.Nil(t, sdkErr)
+	assert.Equal(t, types.NewCoinFromInt64(1000000*types.Decimals), resultPtr.PenaltyByzantine)
+	assert.True(t, sdk.ZeroRat().Equal(resultPtr.SlashFractionByzantine))
+	assert.Equal(t, int64(7*7*24*3600), resultPtr.MaxEvidenceAgeSec)
+}
+
+func TestLegacyPostParam(t *testing.T) {
+	ph := NewParamHolder(TestKVStoreKey)
+	ctx := getContext()
+	legacyParam := struct {
+		ReportOrUpvoteIntervalSec int64 `json:"report_or_upvote_interval_second"`
+		PostIntervalSec           int64 `json:"post_interval_sec"`
+	}{
+		ReportOrUpvoteIntervalSec: int64(24 * 3600),
+		PostIntervalSec:           int64(600),
+	}
+	paramBytes, err := ph.cdc.MarshalJSON(legacyParam)
+	assert.Nil(t, err)
+	ctx.KVStore(ph.key).Set(GetPostParamKey(), paramBytes)
+
+	resultPtr, sdkErr := ph.GetPostParam(ctx)
+	assert.Nil(t, sdkErr)
+	assert.Equal(t, PostParam{
+		ReportOrUpvoteIntervalSec: int64(24 * 3600),
+		PostIntervalSec:           int64(600),
+		MaxNumOfTags:              int64(5),
+		CurationRewardRatio:       sdk.NewRat(1, 10),
+		ReportHalfLifeSec:         30 * 24 * 3600,
+		ViewDedupIntervalSec:      3600,
+		ConsumptionPerView:        types.NewCoinFromInt64(types.Decimals / 1000),
+		RepostRoyaltyRate:         sdk.NewRat(1, 5),
+		MaxRepostChainDepth:       5,
+		PenaltyScoreRefreshSec:    3600,
+	}, *resultPtr)
+}
+
 func TestVoteParam(t *testing.T) {
 	ph := NewParamHolder(TestKVStoreKey)
 	ctx := getContext()
@@ -128,6 +236,8 @@ func TestVoteParam(t *testing.T) {
 		VoterCoinReturnTimes:           int64(7),
 		DelegatorCoinReturnIntervalSec: int64(7 * 24 * 3600),
 		DelegatorCoinReturnTimes:       int64(7),
+		RedelegationCooldownSec:        int64(7 * 24 * 3600),
+		MaxRedelegations:               int64(7),
 	}
 	err := ph.setVoteParam(ctx, &parameter)
 	assert.Nil(t, err)
@@ -252,9 +362,16 @@ func TestInitParam(t *testing.T) {
 		ValidatorCoinReturnTimes:       int64(7),
 		PenaltyMissVote:                types.NewCoinFromInt64(20000 * types.Decimals),
 		PenaltyMissCommit:              types.NewCoinFromInt64(200 * types.Decimals),
-		PenaltyByzantine:               types.NewCoinFromInt64(1000000 * types.Decimals),
+		PenaltyByzantine:               types.NewCoinFromInt64(0),
+		SlashFractionByzantine:         sdk.NewRat(1, 10),
 		ValidatorListSize:              int64(21),
-		AbsentCommitLimitation:         int64(600),
+		SignedBlocksWindow:             int64(600),
+		MinSignedPerWindow:             sdk.NewRat(1, 2),
+		JailDurationSec:                int64(24 * 3600),
+		MaxEvidenceAgeSec:              int64(7 * 7 * 24 * 3600),
+		MaxCommissionRate:              sdk.NewRat(1, 5),
+		MaxCommissionChangeRate:        sdk.NewRat(1, 100),
+		MaxPowerChangeRate:             sdk.NewRat(1, 10),
 	}
 
 	voteParam := VoteParam{
@@ -265,6 +382,8 @@ func TestInitParam(t *testing.T) {
 		VoterCoinReturnTimes:           int64(7),
 		DelegatorCoinReturnIntervalSec: int64(7 * 24 * 3600),
 		DelegatorCoinReturnTimes:       int64(7),
+		RedelegationCooldownSec:        int64(7 * 24 * 3600),
+		MaxRedelegations:               int64(7),
 	}
 	proposalParam := ProposalParam{
 		ContentCensorshipDecideSec:  int64(7 * 24 * 3600),
@@ -299,6 +418,14 @@ func TestInitParam(t *testing.T) {
 	postParam := PostParam{
 		ReportOrUpvoteIntervalSec: int64(24 * 3600),
 		PostIntervalSec:           int64(600),
+		MaxNumOfTags:              int64(5),
+		CurationRewardRatio:       sdk.NewRat(1, 10),
+		ReportHalfLifeSec:         30 * 24 * 3600,
+		ViewDedupIntervalSec:      3600,
+		ConsumptionPerView:        types.NewCoinFromInt64(types.Decimals / 1000),
+		RepostRoyaltyRate:         sdk.NewRat(1, 5),
+		MaxRepostChainDepth:       5,
+		PenaltyScoreRefreshSec:    3600,
 	}
 	checkStorage(t, ctx, ph, globalAllocationParam, infraInternalAllocationParam,
 		evaluateOfContentValueParam, developerParam, validatorParam, voteParam,
@@ -344,9 +471,16 @@ func TestInitParamFromConfig(t *testing.T) {
 		ValidatorCoinReturnTimes:       int64(7),
 		PenaltyMissVote:                types.NewCoinFromInt64(20000 * types.Decimals),
 		PenaltyMissCommit:              types.NewCoinFromInt64(200 * types.Decimals),
-		PenaltyByzantine:               types.NewCoinFromInt64(1000000 * types.Decimals),
+		PenaltyByzantine:               types.NewCoinFromInt64(0),
+		SlashFractionByzantine:         sdk.NewRat(1, 10),
 		ValidatorListSize:              int64(21),
-		AbsentCommitLimitation:         int64(600),
+		SignedBlocksWindow:             int64(600),
+		MinSignedPerWindow:             sdk.NewRat(1, 2),
+		JailDurationSec:                int64(24 * 3600),
+		MaxEvidenceAgeSec:              int64(7 * 7 * 24 * 3600),
+		MaxCommissionRate:              sdk.NewRat(1, 5),
+		MaxCommissionChangeRate:        sdk.NewRat(1, 100),
+		MaxPowerChangeRate:             sdk.NewRat(1, 10),
 	}
 
 	voteParam := VoteParam{
@@ -357,6 +491,8 @@ func TestInitParamFromConfig(t *testing.T) {
 		VoterCoinReturnTimes:           int64(7),
 		DelegatorCoinReturnIntervalSec: int64(7 * 24 * 3600),
 		DelegatorCoinReturnTimes:       int64(7),
+		RedelegationCooldownSec:        int64(7 * 24 * 3600),
+		MaxRedelegations:               int64(7),
 	}
 	proposalParam := ProposalParam{
 		ContentCensorshipDecideSec:  int64(7 * 24 * 3600),
@@ -391,6 +527,14 @@ func TestInitParamFromConfig(t *testing.T) {
 	postParam := PostParam{
 		ReportOrUpvoteIntervalSec: int64(24 * 3600),
 		PostIntervalSec:           int64(600),
+		MaxNumOfTags:              int64(5),
+		CurationRewardRatio:       sdk.NewRat(1, 10),
+		ReportHalfLifeSec:         30 * 24 * 3600,
+		ViewDedupIntervalSec:      3600,
+		ConsumptionPerView:        types.NewCoinFromInt64(types.Decimals / 1000),
+		RepostRoyaltyRate:         sdk.NewRat(1, 5),
+		MaxRepostChainDepth:       5,
+		PenaltyScoreRefreshSec:    3600,
 	}
 
 	err := ph.InitParamFromConfig(
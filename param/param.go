@@ -48,6 +48,8 @@ type InfraInternalAllocationParam struct {
 // VoterCoinReturnTimes - when withdraw or revoke, the deposit return to voter by return event
 // DelegatorCoinReturnIntervalSec - when withdraw or revoke, the deposit return to delegator by return event
 // DelegatorCoinReturnTimes - when withdraw or revoke, the deposit return to delegator by return event
+// RedelegationCooldownSec - seconds a redelegation stays slashable for its previous voter
+// MaxRedelegations - maximum number of redelegations of a delegator in cooldown at the same time
 type VoteParam struct {
 	VoterMinDeposit                types.Coin `json:"voter_min_deposit"`
 	VoterMinWithdraw               types.Coin `json:"voter_min_withdraw"`
@@ -56,6 +58,8 @@ type VoteParam struct {
 	VoterCoinReturnTimes           int64      `json:"voter_coin_return_times"`
 	DelegatorCoinReturnIntervalSec int64      `json:"delegator_coin_return_interval_second"`
 	DelegatorCoinReturnTimes       int64      `json:"delegator_coin_return_times"`
+	RedelegationCooldownSec        int64      `json:"redelegation_cooldown_second"`
+	MaxRedelegations               int64      `json:"max_redelegations"`
 }
 
 // ProposalParam - proposal parameters
@@ -106,11 +110,21 @@ type DeveloperParam struct {
 // ValidatorCoinReturnTimes - when withdraw or revoke, coin return to validator by coin return event
 // PenaltyMissVote - when missing vote for content censorship or protocol upgrade proposal,
 // minus PenaltyMissCommit amount of Coin from validator deposit
-// PenaltyMissCommit - when missing block till AbsentCommitLimitation, minus PenaltyMissCommit amount of Coin from validator deposit
-// PenaltyByzantine - when validator acts as byzantine (double sign, for example),
-// minus PenaltyByzantine amount of Coin from validator deposit
+// PenaltyMissCommit - when signed blocks in window is less than MinSignedPerWindow, minus PenaltyMissCommit amount of Coin from validator deposit
+// PenaltyByzantine - deprecated, kept for genesis and stored param compatibility,
+// fixed amount of Coin minus from byzantine validator deposit besides SlashFractionByzantine
+// SlashFractionByzantine - when validator acts as byzantine (double sign, for example),
+// minus SlashFractionByzantine of validator deposit
 // ValidatorListSize - size of oncall validator
-// AbsentCommitLimitation - absent block limitation till penalty
+// AbsentCommitLimitation - deprecated, kept for genesis and stored param compatibility,
+// used as SignedBlocksWindow if param doesn't have signed blocks window
+// SignedBlocksWindow - number of recent blocks tracked for validator signing
+// MinSignedPerWindow - minimum ratio of signed blocks in window, validator below it is punished
+// JailDurationSec - validator jailed for missing blocks can't unjail until jailed for JailDurationSec
+// MaxEvidenceAgeSec - byzantine evidence older than MaxEvidenceAgeSec is ignored
+// MaxCommissionRate - maximum commission rate validator can take from its inflation
+// MaxCommissionChangeRate - maximum commission rate change validator can make in a day
+// MaxPowerChangeRate - maximum ratio of voting power an oncall validator can gain or lose in a block
 type ValidatorParam struct {
 	ValidatorMinWithdraw           types.Coin `json:"validator_min_withdraw"`
 	ValidatorMinVotingDeposit      types.Coin `json:"validator_min_voting_deposit"`
@@ -120,8 +134,16 @@ type ValidatorParam struct {
 	PenaltyMissVote                types.Coin `json:"penalty_miss_vote"`
 	PenaltyMissCommit              types.Coin `json:"penalty_miss_commit"`
 	PenaltyByzantine               types.Coin `json:"penalty_byzantine"`
+	SlashFractionByzantine         sdk.Rat    `json:"slash_fraction_byzantine"`
 	ValidatorListSize              int64      `json:"validator_list_size"`
 	AbsentCommitLimitation         int64      `json:"absent_commit_limitation"`
+	SignedBlocksWindow             int64      `json:"signed_blocks_window"`
+	MinSignedPerWindow             sdk.Rat    `json:"min_signed_per_window"`
+	JailDurationSec                int64      `json:"jail_duration_second"`
+	MaxEvidenceAgeSec              int64      `json:"max_evidence_age_second"`
+	MaxCommissionRate              sdk.Rat    `json:"max_commission_rate"`
+	MaxCommissionChangeRate        sdk.Rat    `json:"max_commission_change_rate"`
+	MaxPowerChangeRate             sdk.Rat    `json:"max_power_change_rate"`
 }
 
 // CoinDayParam - coin day parameters
@@ -151,7 +173,23 @@ type AccountParam struct {
 // PostParam - post parameters
 // ReportOrUpvoteIntervalSec - report interval second
 // PostIntervalSec - post interval second
+// MaxNumOfTags - maximum number of tags attached to a post
+// CurationRewardRatio - ratio of post inflation reward paid to prior upvoters and donors
+// ReportHalfLifeSec - half life of report weight in penalty score, 0 means no decay
+// ViewDedupIntervalSec - views from the same user to a post within the interval count once
+// ConsumptionPerView - consumption reported to the app for each unique view it submits
+// RepostRoyaltyRate - ratio of donation share each reposter passes up the repost chain
+// MaxRepostChainDepth - maximum number of upstream posts in a repost chain sharing royalties
+// PenaltyScoreRefreshSec - cached penalty score is reused by reward events within the interval
 type PostParam struct {
-	ReportOrUpvoteIntervalSec int64 `json:"report_or_upvote_interval_second"`
-	PostIntervalSec           int64 `json:"post_interval_sec"`
+	ReportOrUpvoteIntervalSec int64      `json:"report_or_upvote_interval_second"`
+	PostIntervalSec           int64      `json:"post_interval_sec"`
+	MaxNumOfTags              int64      `json:"max_num_of_tags"`
+	CurationRewardRatio       sdk.Rat    `json:"curation_reward_ratio"`
+	ReportHalfLifeSec         int64      `json:"report_half_life_second"`
+	ViewDedupIntervalSec      int64      `json:"view_dedup_interval_second"`
+	ConsumptionPerView        types.Coin `json:"consumption_per_view"`
+	RepostRoyaltyRate         sdk.Rat    `json:"repost_royalty_rate"`
+	MaxRepostChainDepth       int64      `json:"max_repost_chain_depth"`
+	PenaltyScoreRefreshSec    int64      `json:"penalty_score_refresh_second"`
 }
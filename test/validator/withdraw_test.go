@@ -39,9 +39,9 @@ func TestValidatorRevoke(t *testing.T) {
 	test.CheckAllValidatorList(t, newAccountName, false, lb)
 	test.CheckOncallValidatorList(t, newAccountName, false, lb)
 	test.CheckBalance(t, newAccountName, lb, types.NewCoinFromInt64(49999*types.Decimals))
-	// check the first coin return
+	// deposit is held until evidence of byzantine behavior before revoke expires
 	test.SimulateOneBlock(lb, baseTime+test.CoinReturnIntervalSec+1)
-	test.CheckBalance(t, newAccountName, lb, types.NewCoinFromInt64(7142757143))
+	test.CheckBalance(t, newAccountName, lb, types.NewCoinFromInt64(49999*types.Decimals))
 
 	// will get all coins back after the freezing period
 	for i := int64(1); i < test.CoinReturnTimes; i++ {
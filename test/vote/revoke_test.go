@@ -62,9 +62,10 @@ func TestVoterRevoke(t *testing.T) {
 	test.SimulateOneBlock(lb, baseTime)
 	test.SignCheckDeliver(t, lb, voterRevokeMsg, 4, true, newAccountTransactionPriv, baseTime)
 
-	// check delegator withdraw first coin return
+	// check delegator withdraw first coin return, validator deposit is
+	// held until evidence expires
 	test.SimulateOneBlock(lb, baseTime+test.CoinReturnIntervalSec+1)
-	test.CheckBalance(t, newAccountName, lb, types.NewCoinFromInt64(11428471429))
+	test.CheckBalance(t, newAccountName, lb, types.NewCoinFromInt64(9285614286))
 	test.CheckBalance(t, delegator1Name, lb, types.NewCoinFromInt64(30099*types.Decimals))
 	test.CheckBalance(t, delegator2Name, lb, types.NewCoinFromInt64(10099*types.Decimals))
 
@@ -1,5 +1,12 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Querier - answer custom query of a module with state computed by its managers
+type Querier func(ctx sdk.Context, path []string, data []byte) ([]byte, sdk.Error)
+
 // AccountKey key format in KVStore
 type AccountKey string
 
@@ -30,6 +37,18 @@ type TransferDetailType int
 // indicates the type of punishment for oncall validators
 type PunishType int
 
+// indicates the type of validator ledger entry
+type ValidatorLedgerType int
+
+// indicates who can access the full content of a post
+type PostAccessMode int
+
+// indicates why a post is reported
+type ReportReason int
+
+// indicates who can reply to a post
+type ReplyMode int
+
 // GetPostKey try to generate PostKey from types.AccountKey and PostID
 func GetPermlink(author AccountKey, postID string) Permlink {
 	return Permlink(string(author) + PermlinkSeparator + postID)
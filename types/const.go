@@ -25,6 +25,9 @@ const (
 	DeveloperRouterName = "developer"
 	ProposalRouterName  = "proposal"
 
+	// CustomQueryRoute - query path prefix for queries answered by module queriers
+	CustomQueryRoute = "custom"
+
 	// Different permission level for msg
 	UnknownPermission          = Permission(0)
 	AppPermission              = Permission(1)
@@ -72,12 +75,45 @@ const (
 	InfraDeposit     = TransferDetailType(19)
 	ProposalDeposit  = TransferDetailType(20)
 
+	// Subscription lock and refund
+	SubscriptionDeposit    = TransferDetailType(21)
+	SubscriptionReturnCoin = TransferDetailType(22)
+
+	// Matching pool lock and refund
+	MatchingPoolDeposit    = TransferDetailType(23)
+	MatchingPoolReturnCoin = TransferDetailType(24)
+
+	// Validator inflation shared with delegators
+	DelegatorReward = TransferDetailType(25)
+
 	// punishment type
 	UnknownPunish      = PunishType(0)
 	PunishByzantine    = PunishType(1)
 	PunishAbsentCommit = PunishType(2)
 	PunishDidntVote    = PunishType(3)
 
+	// validator ledger entry type
+	ValidatorSlash  = ValidatorLedgerType(1)
+	ValidatorReward = ValidatorLedgerType(2)
+
+	// Different post access modes
+	PublicAccess     = PostAccessMode(0)
+	UnlockAccess     = PostAccessMode(1)
+	SubscriberAccess = PostAccessMode(2)
+
+	// Different report reasons, upvote and report without reason use unspecified
+	ReportReasonUnspecified = ReportReason(0)
+	ReportReasonSpam        = ReportReason(1)
+	ReportReasonCopyright   = ReportReason(2)
+	ReportReasonAbuse       = ReportReason(3)
+	ReportReasonIllegal     = ReportReason(4)
+	ReportReasonOther       = ReportReason(5)
+
+	// Different reply modes of a post
+	ReplyEveryone      = ReplyMode(0)
+	ReplyFollowersOnly = ReplyMode(1)
+	ReplyNobody        = ReplyMode(2)
+
 	// UsernameReCheck - UsernameReCheck is used to check user registration
 	UsernameReCheck        = "^[a-z]([a-z0-9-\\.]){1,19}[a-z0-9]$"
 	IlligalUsernameReCheck = "^[a-z0-9\\.-]*([-\\.]){2,}[a-z0-9\\.-]*$"
@@ -91,6 +127,12 @@ const (
 	// MaximumMemoLength - maximum length of memo
 	MaximumMemoLength = 100
 
+	// SubscriptionPeriodSec - length of one subscription period, about a month
+	SubscriptionPeriodSec = 30 * 24 * 3600
+
+	// MaximumSubscriptionPeriods - maximum number of periods paid by one subscription
+	MaximumSubscriptionPeriods = 12
+
 	// MaximumJSONMetaLength - maximum length of account JSON meta
 	MaximumJSONMetaLength = 500
 
@@ -142,6 +184,24 @@ const (
 	// MaximumNumOfLinks - maximum number of links per post
 	MaximumNumOfLinks = 10
 
+	// MaximumNumOfTags - maximum number of tags per post
+	MaximumNumOfTags = 10
+
+	// MaximumLengthOfReportEvidence - maximum length of report evidence reference
+	MaximumLengthOfReportEvidence = 200
+
+	// MaximumLengthOfTipReference - maximum length of tip reference
+	MaximumLengthOfTipReference = 100
+
+	// MaximumNumOfCoAuthors - maximum number of authors of a post, including the author
+	MaximumNumOfCoAuthors = 10
+
+	// MaximumNumOfViewReceipts - maximum number of view receipts in one batch
+	MaximumNumOfViewReceipts = 100
+
+	// MaximumLengthOfTag - maximum length of post tag
+	MaximumLengthOfTag = 20
+
 	// MaximumLengthOfDeveloperWebsite - maximum length of developer website
 	MaximumLengthOfDeveloperWebsite = 100
 
@@ -151,6 +211,18 @@ const (
 	// MaximumLengthOfAppMetadata - maximum length of developer App meta data
 	MaximumLengthOfAppMetadata = 1000
 
+	// MaximumLengthOfValidatorMoniker - maximum length of validator moniker
+	MaximumLengthOfValidatorMoniker = 50
+
+	// MaximumLengthOfValidatorWebsite - maximum length of validator website
+	MaximumLengthOfValidatorWebsite = 100
+
+	// MaximumLengthOfValidatorDescription - maximum length of validator description
+	MaximumLengthOfValidatorDescription = 1000
+
+	// MaximumLengthOfValidatorContact - maximum length of validator contact details
+	MaximumLengthOfValidatorContact = 100
+
 	// MaximumLengthOfProposalReason - maximum length of proposal reason
 	MaximumLengthOfProposalReason = 1000
 
@@ -168,4 +240,7 @@ const (
 
 	// RewardHistoryBundleSize - bundle size for reward history
 	RewardHistoryBundleSize = 100
+
+	// ValidatorLedgerBundleSize - bundle size for validator ledger
+	ValidatorLedgerBundleSize = 100
 )
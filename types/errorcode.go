@@ -98,6 +98,12 @@ const (
 	CodeFailedToUnmarshalRewardHistory     sdk.CodeType = 359
 	CodeGetLastPostAt                      sdk.CodeType = 360
 	CodeUpdateLastPostAt                   sdk.CodeType = 361
+	CodeFailedToMarshalSubscription        sdk.CodeType = 362
+	CodeFailedToUnmarshalSubscription      sdk.CodeType = 363
+	CodeCannotSubscribeToSelf              sdk.CodeType = 364
+	CodeSubscriptionAlreadyExist           sdk.CodeType = 365
+	CodeSubscriptionNotFound               sdk.CodeType = 366
+	CodeInvalidSubscriptionPeriods         sdk.CodeType = 367
 
 	// Lino post errors reserve 400 ~ 499
 	CodePostMetaNotFound                     sdk.CodeType = 400
@@ -141,6 +147,39 @@ const (
 	CodeCreatePostSourceInvalid              sdk.CodeType = 438
 	CodeGetSourcePost                        sdk.CodeType = 439
 	CodePostTooOften                         sdk.CodeType = 440
+	CodeInvalidTag                           sdk.CodeType = 441
+	CodeTooManyTags                          sdk.CodeType = 442
+	CodeInvalidPostAccessMode                sdk.CodeType = 443
+	CodeInvalidUnlockPrice                   sdk.CodeType = 444
+	CodePostNotUnlockable                    sdk.CodeType = 445
+	CodePostAlreadyUnlocked                  sdk.CodeType = 446
+	CodeUnlockPriceMismatch                  sdk.CodeType = 447
+	CodeCannotUnlockOwnPost                  sdk.CodeType = 448
+	CodeFailedToMarshalUnlockReceipt         sdk.CodeType = 449
+	CodeFailedToUnmarshalUnlockReceipt       sdk.CodeType = 450
+	CodeFailedToMarshalPostAccess            sdk.CodeType = 451
+	CodeFailedToUnmarshalPostAccess          sdk.CodeType = 452
+	CodeInvalidCoAuthors                     sdk.CodeType = 453
+	CodeCoAuthorNotFound                     sdk.CodeType = 454
+	CodeCoAuthorAlreadyApproved              sdk.CodeType = 455
+	CodeFailedToMarshalCoAuthors             sdk.CodeType = 456
+	CodeFailedToUnmarshalCoAuthors           sdk.CodeType = 457
+	CodeInvalidTipReference                  sdk.CodeType = 458
+	CodeInvalidReportReason                  sdk.CodeType = 459
+	CodeInvalidReportEvidence                sdk.CodeType = 460
+	CodeFailedToMarshalReporterStat          sdk.CodeType = 461
+	CodeFailedToUnmarshalReporterStat        sdk.CodeType = 462
+	CodeFailedToMarshalPenaltyScore          sdk.CodeType = 463
+	CodeFailedToUnmarshalPenaltyScore        sdk.CodeType = 464
+	CodeInvalidReplyMode                     sdk.CodeType = 465
+	CodeReplyNotAllowed                      sdk.CodeType = 466
+	CodeHideCommentNotAllowed                sdk.CodeType = 467
+	CodeInvalidViewReceipts                  sdk.CodeType = 468
+	CodePostCensored                         sdk.CodeType = 469
+	CodeFailedToMarshalRepostRoyalty         sdk.CodeType = 470
+	CodeFailedToUnmarshalRepostRoyalty       sdk.CodeType = 471
+	CodeFailedToMarshalPendingReward         sdk.CodeType = 472
+	CodeFailedToUnmarshalPendingReward       sdk.CodeType = 473
 
 	// Lino validator errors reserve 500 ~ 599
 	CodeValidatorNotFound              sdk.CodeType = 500
@@ -151,6 +190,29 @@ const (
 	CodeFailedToUnmarshalValidatorList sdk.CodeType = 505
 	CodeUnbalancedAccount              sdk.CodeType = 506
 	CodeValidatorPubKeyAlreadyExist    sdk.CodeType = 507
+	CodeValidatorNotJailed             sdk.CodeType = 508
+	CodeValidatorStillJailed           sdk.CodeType = 509
+	CodeValidatorTombstoned            sdk.CodeType = 510
+	CodeFailedToMarshalOncallHistory   sdk.CodeType = 511
+	CodeFailedToUnmarshalOncallHistory sdk.CodeType = 512
+	CodeFailedToMarshalEvidence        sdk.CodeType = 513
+	CodeFailedToUnmarshalEvidence      sdk.CodeType = 514
+	CodeFailedToMarshalTombstone       sdk.CodeType = 515
+	CodeFailedToUnmarshalTombstone     sdk.CodeType = 516
+	CodeInvalidCommissionRate          sdk.CodeType = 517
+	CodeCommissionRateTooHigh          sdk.CodeType = 518
+	CodeCommissionChangeTooLarge       sdk.CodeType = 519
+	CodeCommissionChangeTooFrequent    sdk.CodeType = 520
+	CodeInvalidMoniker                 sdk.CodeType = 521
+	CodeInvalidValidatorDescription    sdk.CodeType = 522
+	CodeInvalidContact                 sdk.CodeType = 523
+	CodeInvalidValidatorPubKey         sdk.CodeType = 524
+	CodeFailedToMarshalLedger          sdk.CodeType = 525
+	CodeFailedToUnmarshalLedger        sdk.CodeType = 526
+	CodeFailedToMarshalLedgerMeta      sdk.CodeType = 527
+	CodeFailedToUnmarshalLedgerMeta    sdk.CodeType = 528
+	CodeFailedToMarshalUnbondings      sdk.CodeType = 529
+	CodeFailedToUnmarshalUnbondings    sdk.CodeType = 530
 
 	// Lino global errors reserve 600 ~ 699
 	CodeInfraInflationCoinConversion     sdk.CodeType = 600
@@ -177,20 +239,31 @@ const (
 	CodeGlobalTimeNotFound               sdk.CodeType = 621
 
 	// Vote errors reserve 700 ~ 799
-	CodeVoterNotFound                  sdk.CodeType = 700
-	CodeVoteNotFound                   sdk.CodeType = 701
-	CodeReferenceListNotFound          sdk.CodeType = 702
-	CodeDelegationNotFound             sdk.CodeType = 703
-	CodeFailedToMarshalVoter           sdk.CodeType = 704
-	CodeFailedToMarshalVote            sdk.CodeType = 705
-	CodeFailedToMarshalDelegation      sdk.CodeType = 706
-	CodeFailedToMarshalReferenceList   sdk.CodeType = 707
-	CodeFailedToUnmarshalVoter         sdk.CodeType = 708
-	CodeFailedToUnmarshalVote          sdk.CodeType = 709
-	CodeFailedToUnmarshalDelegation    sdk.CodeType = 710
-	CodeFailedToUnmarshalReferenceList sdk.CodeType = 711
-	CodeValidatorCannotRevoke          sdk.CodeType = 712
-	CodeVoteAlreadyExist               sdk.CodeType = 713
+	CodeVoterNotFound                      sdk.CodeType = 700
+	CodeVoteNotFound                       sdk.CodeType = 701
+	CodeReferenceListNotFound              sdk.CodeType = 702
+	CodeDelegationNotFound                 sdk.CodeType = 703
+	CodeFailedToMarshalVoter               sdk.CodeType = 704
+	CodeFailedToMarshalVote                sdk.CodeType = 705
+	CodeFailedToMarshalDelegation          sdk.CodeType = 706
+	CodeFailedToMarshalReferenceList       sdk.CodeType = 707
+	CodeFailedToUnmarshalVoter             sdk.CodeType = 708
+	CodeFailedToUnmarshalVote              sdk.CodeType = 709
+	CodeFailedToUnmarshalDelegation        sdk.CodeType = 710
+	CodeFailedToUnmarshalReferenceList     sdk.CodeType = 711
+	CodeValidatorCannotRevoke              sdk.CodeType = 712
+	CodeVoteAlreadyExist                   sdk.CodeType = 713
+	CodeFailedToMarshalVoterReward         sdk.CodeType = 714
+	CodeFailedToUnmarshalVoterReward       sdk.CodeType = 715
+	CodeFailedToMarshalDelegatorReward     sdk.CodeType = 716
+	CodeFailedToUnmarshalDelegatorReward   sdk.CodeType = 717
+	CodeFailedToMarshalRedelegationList    sdk.CodeType = 718
+	CodeFailedToUnmarshalRedelegationList  sdk.CodeType = 719
+	CodeInvalidRedelegation                sdk.CodeType = 720
+	CodeRedelegationInCooldown             sdk.CodeType = 721
+	CodeTooManyRedelegations               sdk.CodeType = 722
+	CodeFailedToMarshalCountedDelegation   sdk.CodeType = 723
+	CodeFailedToUnmarshalCountedDelegation sdk.CodeType = 724
 
 	// Lino infra errors reserve 800 ~ 899
 	CodeInfraProviderNotFound              sdk.CodeType = 800
@@ -215,6 +288,13 @@ const (
 	CodeInvalidWebsite                 sdk.CodeType = 910
 	CodeInvalidDescription             sdk.CodeType = 911
 	CodeInvalidAppMetadata             sdk.CodeType = 912
+	CodeMatchingPoolNotFound           sdk.CodeType = 913
+	CodeFailedToMarshalMatchingPool    sdk.CodeType = 914
+	CodeFailedToUnmarshalMatchingPool  sdk.CodeType = 915
+	CodeFailedToMarshalMatchedAmount   sdk.CodeType = 916
+	CodeFailedToUnmarshalMatchedAmount sdk.CodeType = 917
+	CodeMatchingPoolAlreadyExist       sdk.CodeType = 918
+	CodeInvalidMatchingPool            sdk.CodeType = 919
 
 	// Param errors reserve 1000 ~ 1099
 	CodeParamHolderGenesisError                       sdk.CodeType = 1000
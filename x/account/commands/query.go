@@ -3,14 +3,18 @@ package commands
 import (
 	"encoding/json"
 	"fmt"
+	"sort"
 
 	"github.com/lino-network/lino/client"
+	"github.com/lino-network/lino/client/core"
 	"github.com/lino-network/lino/types"
 	"github.com/lino-network/lino/x/account/model"
+	globalModel "github.com/lino-network/lino/x/global/model"
 
 	"github.com/cosmos/cosmos-sdk/wire"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 )
 
 // GetBankCmd returns a query bank that will display the
@@ -55,6 +59,59 @@ func GetAccountsCmd(storeName string, cdc *wire.Codec) *cobra.Command {
 	}
 }
 
+// GetSubscribersCmd returns a query that will display
+// all active subscribers of a creator
+func GetSubscribersCmd(storeName string, cdc *wire.Codec) *cobra.Command {
+	cmdr := commander{
+		storeName,
+		cdc,
+	}
+	return &cobra.Command{
+		Use:   "subscribers <creator>",
+		Short: "Query active subscribers of a creator",
+		RunE:  cmdr.getSubscribersCmd,
+	}
+}
+
+// GetSubscriptionsCmd returns a query that will display
+// all active subscriptions of a user
+func GetSubscriptionsCmd(storeName string, cdc *wire.Codec) *cobra.Command {
+	cmdr := commander{
+		storeName,
+		cdc,
+	}
+	return &cobra.Command{
+		Use:   "subscriptions <username>",
+		Short: "Query active subscriptions of a user",
+		RunE:  cmdr.getSubscriptionsCmd,
+	}
+}
+
+// GetTopSupportersCmd returns a query that will display users who donated
+// most to a creator, aggregated across all posts and tips of the creator
+func GetTopSupportersCmd(storeName string, cdc *wire.Codec) *cobra.Command {
+	cmdr := commander{
+		storeName,
+		cdc,
+	}
+	cmd := &cobra.Command{
+		Use:   "supporters <creator>",
+		Short: "Query top supporters of a creator",
+		RunE:  cmdr.getTopSupportersCmd,
+	}
+	cmd.Flags().String(client.FlagSortBy, "amount", "sort supporters by amount or count")
+	cmd.Flags().Int(client.FlagOffset, 0, "number of supporters to skip")
+	cmd.Flags().Int(client.FlagLimit, 20, "maximum number of supporters to display")
+	return cmd
+}
+
+// Supporter - total donation from a supporter to a creator
+type Supporter struct {
+	Username       types.AccountKey `json:"username"`
+	DonationTimes  int64            `json:"donation_times"`
+	DonationAmount types.Coin       `json:"donation_amount"`
+}
+
 type commander struct {
 	storeName string
 	cdc       *wire.Codec
@@ -150,3 +207,147 @@ func (c commander) getAccountsCmd(cmd *cobra.Command, args []string) error {
 	}
 	return nil
 }
+
+func (c commander) getSubscribersCmd(cmd *cobra.Command, args []string) error {
+	ctx := client.NewCoreContextFromViper()
+	if len(args) != 1 || len(args[0]) == 0 {
+		return errors.New("You must provide a creator")
+	}
+	creator := types.AccountKey(args[0])
+
+	resKVs, err := ctx.QuerySubspace(c.cdc, model.GetSubscriberPrefix(creator), c.storeName)
+	if err != nil {
+		return err
+	}
+	lastBlockTime, err := c.getLastBlockTime(ctx)
+	if err != nil {
+		return err
+	}
+	subscriptions := []model.Subscription{}
+	for _, KV := range resKVs {
+		res, err := ctx.Query(model.GetSubscriptionKey(types.AccountKey(KV.Value), creator), c.storeName)
+		if err != nil {
+			return err
+		}
+		var subscription model.Subscription
+		if err := c.cdc.UnmarshalJSON(res, &subscription); err != nil {
+			return err
+		}
+		if subscription.ExpiresAt > lastBlockTime {
+			subscriptions = append(subscriptions, subscription)
+		}
+	}
+
+	if err := client.PrintIndent(subscriptions); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c commander) getSubscriptionsCmd(cmd *cobra.Command, args []string) error {
+	ctx := client.NewCoreContextFromViper()
+	if len(args) != 1 || len(args[0]) == 0 {
+		return errors.New("You must provide a username")
+	}
+	username := types.AccountKey(args[0])
+
+	resKVs, err := ctx.QuerySubspace(c.cdc, model.GetSubscriptionPrefix(username), c.storeName)
+	if err != nil {
+		return err
+	}
+	lastBlockTime, err := c.getLastBlockTime(ctx)
+	if err != nil {
+		return err
+	}
+	subscriptions := []model.Subscription{}
+	for _, KV := range resKVs {
+		var subscription model.Subscription
+		if err := c.cdc.UnmarshalJSON(KV.Value, &subscription); err != nil {
+			return err
+		}
+		if subscription.ExpiresAt > lastBlockTime {
+			subscriptions = append(subscriptions, subscription)
+		}
+	}
+
+	if err := client.PrintIndent(subscriptions); err != nil {
+		return err
+	}
+	return nil
+}
+
+// getLastBlockTime - returns the last block time recorded on chain
+func (c commander) getLastBlockTime(ctx core.CoreContext) (int64, error) {
+	res, err := ctx.Query(globalModel.GetTimeKey(), types.GlobalKVStoreKey)
+	if err != nil {
+		return 0, err
+	}
+	globalTime := new(globalModel.GlobalTime)
+	if err := c.cdc.UnmarshalJSON(res, globalTime); err != nil {
+		return 0, err
+	}
+	return globalTime.LastBlockTime, nil
+}
+
+func (c commander) getTopSupportersCmd(cmd *cobra.Command, args []string) error {
+	ctx := client.NewCoreContextFromViper()
+	if len(args) != 1 || len(args[0]) == 0 {
+		return errors.New("You must provide a creator")
+	}
+	sortBy := viper.GetString(client.FlagSortBy)
+	if sortBy != "amount" && sortBy != "count" {
+		return errors.New("sort-by must be amount or count")
+	}
+	creator := types.AccountKey(args[0])
+
+	prefix := model.GetRelationshipPrefix(creator)
+	resKVs, err := ctx.QuerySubspace(c.cdc, prefix, c.storeName)
+	if err != nil {
+		return err
+	}
+	supporters := []Supporter{}
+	for _, KV := range resKVs {
+		relationship, err := model.DecodeRelationship(c.cdc, KV.Value)
+		if err != nil {
+			return err
+		}
+		// relationship key ends with the username of supporter
+		supporters = append(supporters, Supporter{
+			Username:       types.AccountKey(KV.Key[len(prefix):]),
+			DonationTimes:  relationship.DonationTimes,
+			DonationAmount: relationship.DonationAmount,
+		})
+	}
+	// sort by the given key first, tie is broken by the other key and then by username
+	sort.SliceStable(supporters, func(i, j int) bool {
+		if !supporters[i].DonationAmount.IsEqual(supporters[j].DonationAmount) && sortBy == "amount" {
+			return supporters[i].DonationAmount.IsGT(supporters[j].DonationAmount)
+		}
+		if supporters[i].DonationTimes != supporters[j].DonationTimes {
+			return supporters[i].DonationTimes > supporters[j].DonationTimes
+		}
+		if !supporters[i].DonationAmount.IsEqual(supporters[j].DonationAmount) {
+			return supporters[i].DonationAmount.IsGT(supporters[j].DonationAmount)
+		}
+		return supporters[i].Username < supporters[j].Username
+	})
+
+	offset := viper.GetInt(client.FlagOffset)
+	limit := viper.GetInt(client.FlagLimit)
+	if offset < 0 || limit < 0 {
+		return errors.New("offset and limit can't be negative")
+	}
+	if offset > len(supporters) {
+		offset = len(supporters)
+	}
+	if offset+limit < len(supporters) {
+		supporters = supporters[offset : offset+limit]
+	} else {
+		supporters = supporters[offset:]
+	}
+
+	if err := client.PrintIndent(supporters); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/lino-network/lino/client"
+	"github.com/lino-network/lino/types"
+
+	"github.com/cosmos/cosmos-sdk/wire"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	acc "github.com/lino-network/lino/x/account"
+)
+
+// SubscribeTxCmd will create a subscribe tx and sign it with the given key
+func SubscribeTxCmd(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "subscribe",
+		Short: "Create and sign a subscribe/unsubscribe tx",
+		RunE:  sendSubscribeTx(cdc),
+	}
+	cmd.Flags().String(client.FlagSubscriber, "", "signer of this transaction")
+	cmd.Flags().Bool(client.FlagIsSubscribe, true, "false if this is unsubscribe")
+	cmd.Flags().String(client.FlagCreator, "", "target to subscribe or unsubscribe")
+	cmd.Flags().String(client.FlagAmount, "", "amount paid to creator each period")
+	cmd.Flags().Int64(client.FlagPeriods, 1, "number of periods to subscribe")
+	return cmd
+}
+
+// send subscribe transaction to the blockchain
+func sendSubscribeTx(cdc *wire.Codec) client.CommandTxCallback {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := client.NewCoreContextFromViper()
+		subscriber := viper.GetString(client.FlagSubscriber)
+		creator := viper.GetString(client.FlagCreator)
+
+		var msg sdk.Msg
+		isSubscribe := viper.GetBool(client.FlagIsSubscribe)
+		if isSubscribe {
+			msg = acc.NewSubscribeMsg(
+				subscriber, creator, types.LNO(viper.GetString(client.FlagAmount)),
+				viper.GetInt64(client.FlagPeriods))
+		} else {
+			msg = acc.NewUnsubscribeMsg(subscriber, creator)
+		}
+
+		// build and sign the transaction, then broadcast to Tendermint
+		res, err := ctx.SignBuildBroadcast([]sdk.Msg{msg}, cdc)
+
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Committed at block %d. Hash: %s\n", res.Height, res.Hash.String())
+		return nil
+	}
+}
@@ -199,3 +199,23 @@ func ErrInvalidMemo() sdk.Error {
 func ErrInvalidJSONMeta() sdk.Error {
 	return types.NewError(types.CodeInvalidJSONMeta, fmt.Sprintf("invalid account JSON meta"))
 }
+
+// ErrCannotSubscribeToSelf - error when user subscribes to self
+func ErrCannotSubscribeToSelf(username types.AccountKey) sdk.Error {
+	return types.NewError(types.CodeCannotSubscribeToSelf, fmt.Sprintf("%v can't subscribe to self", username))
+}
+
+// ErrSubscriptionAlreadyExist - error when subscriber already subscribes to creator
+func ErrSubscriptionAlreadyExist(subscriber, creator types.AccountKey) sdk.Error {
+	return types.NewError(types.CodeSubscriptionAlreadyExist, fmt.Sprintf("%v already subscribes to %v", subscriber, creator))
+}
+
+// ErrSubscriptionNotFound - error when subscription is not found
+func ErrSubscriptionNotFound(subscriber, creator types.AccountKey) sdk.Error {
+	return types.NewError(types.CodeSubscriptionNotFound, fmt.Sprintf("subscription from %v to %v is not found", subscriber, creator))
+}
+
+// ErrInvalidSubscriptionPeriods - error when number of subscription periods is invalid
+func ErrInvalidSubscriptionPeriods() sdk.Error {
+	return types.NewError(types.CodeInvalidSubscriptionPeriods, fmt.Sprintf("invalid subscription periods"))
+}
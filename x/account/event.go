@@ -45,3 +45,33 @@ func CreateCoinReturnEvents(
 	}
 	return events, nil
 }
+
+// SubscriptionEvent - pay one period of subscription to creator
+type SubscriptionEvent struct {
+	Subscriber     types.AccountKey `json:"subscriber"`
+	Creator        types.AccountKey `json:"creator"`
+	SubscriptionID int64            `json:"subscription_id"`
+}
+
+// Execute - execute subscription events
+func (event SubscriptionEvent) Execute(ctx sdk.Context, am AccountManager) sdk.Error {
+	if !am.DoesAccountExist(ctx, event.Creator) {
+		return ErrAccountNotFound(event.Creator)
+	}
+	return am.PaySubscription(ctx, event.Subscriber, event.Creator, event.SubscriptionID)
+}
+
+// CreateSubscriptionEvents - create subscription events, one for each period
+func CreateSubscriptionEvents(
+	subscriber, creator types.AccountKey, subscriptionID, periods int64) []types.Event {
+	events := []types.Event{}
+	for i := int64(0); i < periods; i++ {
+		event := SubscriptionEvent{
+			Subscriber:     subscriber,
+			Creator:        creator,
+			SubscriptionID: subscriptionID,
+		}
+		events = append(events, event)
+	}
+	return events
+}
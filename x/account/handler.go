@@ -28,6 +28,10 @@ func NewHandler(am AccountManager, gm global.GlobalManager) sdk.Handler {
 			return handleRegisterMsg(ctx, am, gm, msg)
 		case UpdateAccountMsg:
 			return handleUpdateAccountMsg(ctx, am, msg)
+		case SubscribeMsg:
+			return handleSubscribeMsg(ctx, am, gm, msg)
+		case UnsubscribeMsg:
+			return handleUnsubscribeMsg(ctx, am, msg)
 		default:
 			errMsg := fmt.Sprintf("Unrecognized account msg type: %v", reflect.TypeOf(msg).Name())
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -168,3 +172,45 @@ func handleUpdateAccountMsg(ctx sdk.Context, am AccountManager, msg UpdateAccoun
 	}
 	return sdk.Result{}
 }
+
+func handleSubscribeMsg(ctx sdk.Context, am AccountManager, gm global.GlobalManager, msg SubscribeMsg) sdk.Result {
+	if !am.DoesAccountExist(ctx, msg.Subscriber) {
+		return ErrAccountNotFound(msg.Subscriber).Result()
+	}
+	if !am.DoesAccountExist(ctx, msg.Creator) {
+		return ErrAccountNotFound(msg.Creator).Result()
+	}
+	if msg.Subscriber == msg.Creator {
+		return ErrCannotSubscribeToSelf(msg.Subscriber).Result()
+	}
+	coin, err := types.LinoToCoin(msg.AmountPerPeriod)
+	if err != nil {
+		return err.Result()
+	}
+	// lock coins of all periods, each period is paid to creator by time event
+	subscriptionID, err := am.AddSubscription(
+		ctx, msg.Subscriber, msg.Creator, coin, msg.Periods, types.SubscriptionPeriodSec)
+	if err != nil {
+		return err.Result()
+	}
+	events := CreateSubscriptionEvents(msg.Subscriber, msg.Creator, subscriptionID, msg.Periods)
+	if err := gm.RegisterCoinReturnEvent(
+		ctx, events, msg.Periods, types.SubscriptionPeriodSec); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{}
+}
+
+func handleUnsubscribeMsg(ctx sdk.Context, am AccountManager, msg UnsubscribeMsg) sdk.Result {
+	if !am.DoesAccountExist(ctx, msg.Subscriber) {
+		return ErrAccountNotFound(msg.Subscriber).Result()
+	}
+	if !am.DoesAccountExist(ctx, msg.Creator) {
+		return ErrAccountNotFound(msg.Creator).Result()
+	}
+	if err := am.CancelSubscription(
+		ctx, msg.Subscriber, msg.Creator, types.SubscriptionPeriodSec); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{}
+}
@@ -2,6 +2,7 @@ package account
 
 import (
 	"testing"
+	"time"
 
 	"github.com/lino-network/lino/types"
 	"github.com/lino-network/lino/x/account/model"
@@ -9,6 +10,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
 	crypto "github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
 )
@@ -486,3 +488,87 @@ func TestHandleUpdateAccountMsg(t *testing.T) {
 		}
 	}
 }
+
+func TestSubscribeAndUnsubscribe(t *testing.T) {
+	ctx, am, gm := setupTest(t, 1)
+	handler := NewHandler(am, gm)
+
+	accParam, _ := am.paramHolder.GetAccountParam(ctx)
+	createTestAccount(ctx, am, "user1")
+	createTestAccount(ctx, am, "user2")
+	am.AddSavingCoin(ctx, user1, c2000, "", "", types.TransferIn)
+
+	baseTime := time.Now().Unix()
+	ctx = ctx.WithBlockHeader(abci.Header{ChainID: "Lino", Time: time.Unix(baseTime, 0)})
+
+	// subscribe to self
+	result := handler(ctx, NewSubscribeMsg("user1", "user1", l100, 3))
+	assert.Equal(t, ErrCannotSubscribeToSelf(user1).Result(), result)
+
+	// subscribe 100 LNO per period for 3 periods
+	result = handler(ctx, NewSubscribeMsg("user1", "user2", l100, 3))
+	assert.Equal(t, sdk.Result{}, result)
+	saving, _ := am.GetSavingFromBank(ctx, user1)
+	assert.True(t, saving.IsEqual(c1700.Plus(accParam.RegisterFee)))
+	isActive, err := am.IsActiveSubscriber(ctx, user1, user2)
+	assert.Nil(t, err)
+	assert.True(t, isActive)
+	frozenMoneyList, err := am.GetFrozenMoneyList(ctx, user1)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(frozenMoneyList))
+
+	// subscribe again
+	result = handler(ctx, NewSubscribeMsg("user1", "user2", l100, 3))
+	assert.Equal(t, ErrSubscriptionAlreadyExist(user1, user2).Result(), result)
+
+	// first period is paid by time event
+	ctx = ctx.WithBlockHeader(
+		abci.Header{ChainID: "Lino", Time: time.Unix(baseTime+types.SubscriptionPeriodSec, 0)})
+	err = SubscriptionEvent{Subscriber: user1, Creator: user2, SubscriptionID: 1}.Execute(ctx, am)
+	assert.Nil(t, err)
+	saving, _ = am.GetSavingFromBank(ctx, user2)
+	assert.True(t, saving.IsEqual(c100.Plus(accParam.RegisterFee)))
+
+	// unsubscribe in the middle of second period
+	ctx = ctx.WithBlockHeader(
+		abci.Header{ChainID: "Lino", Time: time.Unix(baseTime+types.SubscriptionPeriodSec*3/2, 0)})
+	result = handler(ctx, NewUnsubscribeMsg("user1", "user2"))
+	assert.Equal(t, sdk.Result{}, result)
+	saving, _ = am.GetSavingFromBank(ctx, user1)
+	assert.True(t, saving.IsEqual(c1850.Plus(accParam.RegisterFee)))
+	saving, _ = am.GetSavingFromBank(ctx, user2)
+	assert.True(t, saving.IsEqual(c150.Plus(accParam.RegisterFee)))
+	isActive, err = am.IsActiveSubscriber(ctx, user1, user2)
+	assert.Nil(t, err)
+	assert.False(t, isActive)
+	frozenMoneyList, err = am.GetFrozenMoneyList(ctx, user1)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(frozenMoneyList))
+
+	// remaining time event is ignored after unsubscribe
+	err = SubscriptionEvent{Subscriber: user1, Creator: user2, SubscriptionID: 1}.Execute(ctx, am)
+	assert.Nil(t, err)
+	saving, _ = am.GetSavingFromBank(ctx, user2)
+	assert.True(t, saving.IsEqual(c150.Plus(accParam.RegisterFee)))
+
+	// unsubscribe again
+	result = handler(ctx, NewUnsubscribeMsg("user1", "user2"))
+	assert.Equal(t, ErrSubscriptionNotFound(user1, user2).Result(), result)
+
+	// resubscribe in the same block, time event of cancelled subscription doesn't pay
+	result = handler(ctx, NewSubscribeMsg("user1", "user2", l100, 3))
+	assert.Equal(t, sdk.Result{}, result)
+	subscription, err := am.storage.GetSubscription(ctx, user1, user2)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), subscription.ID)
+	err = SubscriptionEvent{Subscriber: user1, Creator: user2, SubscriptionID: 1}.Execute(ctx, am)
+	assert.Nil(t, err)
+	saving, _ = am.GetSavingFromBank(ctx, user2)
+	assert.True(t, saving.IsEqual(c150.Plus(accParam.RegisterFee)))
+
+	// time event of new subscription pays
+	err = SubscriptionEvent{Subscriber: user1, Creator: user2, SubscriptionID: 2}.Execute(ctx, am)
+	assert.Nil(t, err)
+	saving, _ = am.GetSavingFromBank(ctx, user2)
+	assert.True(t, saving.IsEqual(c150.Plus(c100).Plus(accParam.RegisterFee)))
+}
@@ -1,6 +1,7 @@
 package account
 
 import (
+	"fmt"
 	"reflect"
 	"time"
 
@@ -480,6 +481,35 @@ func (accManager AccountManager) AddIncomeAndReward(
 	ctx sdk.Context, username types.AccountKey,
 	originalDonation, friction, actualReward types.Coin,
 	consumer, postAuthor types.AccountKey, postID string) sdk.Error {
+	rewardDetail := model.RewardDetail{
+		OriginalDonation: originalDonation,
+		FrictionDonation: friction,
+		ActualReward:     actualReward,
+		Consumer:         consumer,
+		PostAuthor:       postAuthor,
+		PostID:           postID,
+	}
+	return accManager.addIncomeAndReward(ctx, username, friction, actualReward, rewardDetail)
+}
+
+// AddTipIncomeAndReward - after tip reward event executed, add income and reward to user
+func (accManager AccountManager) AddTipIncomeAndReward(
+	ctx sdk.Context, username types.AccountKey,
+	originalDonation, friction, actualReward types.Coin,
+	consumer types.AccountKey, reference string) sdk.Error {
+	rewardDetail := model.RewardDetail{
+		OriginalDonation: originalDonation,
+		FrictionDonation: friction,
+		ActualReward:     actualReward,
+		Consumer:         consumer,
+		Reference:        reference,
+	}
+	return accManager.addIncomeAndReward(ctx, username, friction, actualReward, rewardDetail)
+}
+
+func (accManager AccountManager) addIncomeAndReward(
+	ctx sdk.Context, username types.AccountKey, friction, actualReward types.Coin,
+	rewardDetail model.RewardDetail) sdk.Error {
 	reward, err := accManager.storage.GetReward(ctx, username)
 	if err != nil {
 		return err
@@ -499,14 +529,6 @@ func (accManager AccountManager) AddIncomeAndReward(
 		return err
 	}
 
-	rewardDetail := model.RewardDetail{
-		OriginalDonation: originalDonation,
-		FrictionDonation: friction,
-		ActualReward:     actualReward,
-		Consumer:         consumer,
-		PostAuthor:       postAuthor,
-		PostID:           postID,
-	}
 	if err := accManager.AddRewardHistory(ctx, username, bank.NumOfReward,
 		rewardDetail); err != nil {
 		return err
@@ -700,19 +722,21 @@ func (accManager AccountManager) CheckUserTPSCapacity(
 	return nil
 }
 
-// UpdateDonationRelationship - increase donation relationship times by 1
+// UpdateDonationRelationship - increase donation relationship times by 1 and add donated amount
 func (accManager AccountManager) UpdateDonationRelationship(
-	ctx sdk.Context, me, other types.AccountKey) sdk.Error {
+	ctx sdk.Context, me, other types.AccountKey, amount types.Coin) sdk.Error {
 	relationship, err := accManager.storage.GetRelationship(ctx, me, other)
 	if err != nil {
 		return err
 	}
 	if relationship == nil {
 		relationship = &model.Relationship{
-			DonationTimes: 0,
+			DonationTimes:  0,
+			DonationAmount: types.NewCoinFromInt64(0),
 		}
 	}
 	relationship.DonationTimes++
+	relationship.DonationAmount = relationship.DonationAmount.Plus(amount)
 	if err := accManager.storage.SetRelationship(ctx, me, other, relationship); err != nil {
 		return err
 	}
@@ -752,6 +776,21 @@ func (accManager AccountManager) AuthorizePermission(
 	return ErrUnsupportGrantLevel()
 }
 
+// HasAppPermission - check if user has an unexpired app permission granted to the app
+func (accManager AccountManager) HasAppPermission(
+	ctx sdk.Context, me types.AccountKey, app types.AccountKey) bool {
+	appKey, err := accManager.GetAppKey(ctx, app)
+	if err != nil {
+		return false
+	}
+	grantPubKey, err := accManager.storage.GetGrantPubKey(ctx, me, appKey)
+	if err != nil {
+		return false
+	}
+	return grantPubKey.Username == app && grantPubKey.Permission == types.AppPermission &&
+		grantPubKey.ExpiresAt > ctx.BlockHeader().Time.Unix()
+}
+
 // RevokePermission - revoke permission from a developer
 func (accManager AccountManager) RevokePermission(
 	ctx sdk.Context, me types.AccountKey, pubKey crypto.PubKey) sdk.Error {
@@ -857,6 +896,146 @@ func (accManager AccountManager) CheckSigningPubKeyOwner(
 	return "", ErrCheckAuthenticatePubKeyOwner(me)
 }
 
+// IsActiveSubscriber - check if subscriber has an unexpired subscription to creator
+func (accManager AccountManager) IsActiveSubscriber(
+	ctx sdk.Context, subscriber, creator types.AccountKey) (bool, sdk.Error) {
+	subscription, err := accManager.storage.GetSubscription(ctx, subscriber, creator)
+	if err != nil {
+		return false, err
+	}
+	if subscription == nil {
+		return false, nil
+	}
+	return subscription.ExpiresAt > ctx.BlockHeader().Time.Unix(), nil
+}
+
+// AddSubscription - lock coins of all periods and start subscription from subscriber to creator,
+// returns the id of the new subscription
+func (accManager AccountManager) AddSubscription(
+	ctx sdk.Context, subscriber, creator types.AccountKey,
+	amountPerPeriod types.Coin, periods, periodSec int64) (int64, sdk.Error) {
+	if accManager.storage.IsMySubscriber(ctx, creator, subscriber) {
+		return 0, ErrSubscriptionAlreadyExist(subscriber, creator)
+	}
+	total := types.RatToCoin(amountPerPeriod.ToRat().Mul(sdk.NewRat(periods)))
+	if err := accManager.MinusSavingCoin(
+		ctx, subscriber, total, creator,
+		fmt.Sprintf("subscribe to %v", creator), types.SubscriptionDeposit); err != nil {
+		return 0, err
+	}
+	now := ctx.BlockHeader().Time.Unix()
+	if err := accManager.AddFrozenMoney(ctx, subscriber, total, now, periodSec, periods); err != nil {
+		return 0, err
+	}
+	id, err := accManager.storage.GetNextSubscriptionID(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if err := accManager.storage.SetNextSubscriptionID(ctx, id+1); err != nil {
+		return 0, err
+	}
+	subscription := &model.Subscription{
+		ID:              id,
+		Subscriber:      subscriber,
+		Creator:         creator,
+		AmountPerPeriod: amountPerPeriod,
+		TotalPeriods:    periods,
+		PaidPeriods:     0,
+		CreatedAt:       now,
+		ExpiresAt:       now + periods*periodSec,
+	}
+	if err := accManager.storage.SetSubscription(ctx, subscription); err != nil {
+		return 0, err
+	}
+	accManager.storage.SetSubscriber(ctx, creator, subscriber)
+	return id, nil
+}
+
+// PaySubscription - transfer one period of subscription to creator as donation income,
+// the payment is ignored if the subscription has been cancelled or renewed
+func (accManager AccountManager) PaySubscription(
+	ctx sdk.Context, subscriber, creator types.AccountKey, subscriptionID int64) sdk.Error {
+	subscription, err := accManager.storage.GetSubscription(ctx, subscriber, creator)
+	if err != nil {
+		return err
+	}
+	if subscription == nil || subscription.ID != subscriptionID ||
+		subscription.PaidPeriods >= subscription.TotalPeriods {
+		return nil
+	}
+	if err := accManager.paySubscriptionToCreator(
+		ctx, subscription, subscription.AmountPerPeriod); err != nil {
+		return err
+	}
+	subscription.PaidPeriods++
+	if subscription.PaidPeriods == subscription.TotalPeriods {
+		accManager.storage.DeleteSubscription(ctx, subscriber, creator)
+		accManager.storage.RemoveSubscriber(ctx, creator, subscriber)
+		return nil
+	}
+	return accManager.storage.SetSubscription(ctx, subscription)
+}
+
+// CancelSubscription - stop subscription, creator gets the consumed part of current period
+// and the rest locked coins are returned to subscriber
+func (accManager AccountManager) CancelSubscription(
+	ctx sdk.Context, subscriber, creator types.AccountKey, periodSec int64) sdk.Error {
+	subscription, err := accManager.storage.GetSubscription(ctx, subscriber, creator)
+	if err != nil {
+		return err
+	}
+	if subscription == nil {
+		return ErrSubscriptionNotFound(subscriber, creator)
+	}
+	total := types.RatToCoin(
+		subscription.AmountPerPeriod.ToRat().Mul(sdk.NewRat(subscription.TotalPeriods)))
+	paid := types.RatToCoin(
+		subscription.AmountPerPeriod.ToRat().Mul(sdk.NewRat(subscription.PaidPeriods)))
+
+	elapsed := ctx.BlockHeader().Time.Unix() - subscription.CreatedAt
+	if elapsed > subscription.TotalPeriods*periodSec {
+		elapsed = subscription.TotalPeriods * periodSec
+	}
+	consumed := types.RatToCoin(
+		subscription.AmountPerPeriod.ToRat().Mul(sdk.NewRat(elapsed, periodSec)))
+	if consumed.IsGT(paid) {
+		if err := accManager.paySubscriptionToCreator(ctx, subscription, consumed.Minus(paid)); err != nil {
+			return err
+		}
+	} else {
+		consumed = paid
+	}
+
+	refund := total.Minus(consumed)
+	if refund.IsPositive() {
+		if err := accManager.AddSavingCoin(
+			ctx, subscriber, refund, creator,
+			fmt.Sprintf("unsubscribe from %v", creator), types.SubscriptionReturnCoin); err != nil {
+			return err
+		}
+	}
+	if err := accManager.removeFrozenMoney(
+		ctx, subscriber, total, subscription.CreatedAt, periodSec, subscription.TotalPeriods); err != nil {
+		return err
+	}
+	accManager.storage.DeleteSubscription(ctx, subscriber, creator)
+	accManager.storage.RemoveSubscriber(ctx, creator, subscriber)
+	return nil
+}
+
+func (accManager AccountManager) paySubscriptionToCreator(
+	ctx sdk.Context, subscription *model.Subscription, amount types.Coin) sdk.Error {
+	if err := accManager.AddSavingCoin(
+		ctx, subscription.Creator, amount, subscription.Subscriber,
+		fmt.Sprintf("subscription from %v", subscription.Subscriber), types.DonationIn); err != nil {
+		return err
+	}
+	if err := accManager.AddDirectDeposit(ctx, subscription.Creator, amount); err != nil {
+		return err
+	}
+	return nil
+}
+
 // GetDonationRelationship - get donation relationship between two user
 func (accManager AccountManager) GetDonationRelationship(
 	ctx sdk.Context, me, other types.AccountKey) (int64, sdk.Error) {
@@ -976,6 +1155,28 @@ func (accManager AccountManager) AddFrozenMoney(
 	return nil
 }
 
+// removeFrozenMoney - remove the first matched frozen money from user's frozen money list
+func (accManager AccountManager) removeFrozenMoney(
+	ctx sdk.Context, username types.AccountKey,
+	amount types.Coin, start, interval, times int64) sdk.Error {
+	accountBank, err := accManager.storage.GetBankFromAccountKey(ctx, username)
+	if err != nil {
+		return err
+	}
+	for i, frozenMoney := range accountBank.FrozenMoneyList {
+		if frozenMoney.Amount.IsEqual(amount) && frozenMoney.StartAt == start &&
+			frozenMoney.Interval == interval && frozenMoney.Times == times {
+			accountBank.FrozenMoneyList = append(
+				accountBank.FrozenMoneyList[:i], accountBank.FrozenMoneyList[i+1:]...)
+			break
+		}
+	}
+	if err := accManager.storage.SetBankFromAccountKey(ctx, username, accountBank); err != nil {
+		return err
+	}
+	return nil
+}
+
 func (accManager AccountManager) cleanExpiredFrozenMoney(ctx sdk.Context, bank *model.AccountBank) {
 	idx := 0
 	for idx < len(bank.FrozenMoneyList) {
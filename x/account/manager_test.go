@@ -1313,6 +1313,42 @@ func TestAddIncomeAndReward(t *testing.T) {
 	checkAccountReward(t, ctx, testName, accKey, reward)
 }
 
+func TestAddTipIncomeAndReward(t *testing.T) {
+	testName := "TestAddTipIncomeAndReward"
+
+	ctx, am, _ := setupTest(t, 1)
+	accKey := types.AccountKey("accKey")
+
+	createTestAccount(ctx, am, string(accKey))
+
+	err := am.AddTipIncomeAndReward(ctx, accKey, c500, c200, c300, "donor1", "live session")
+	if err != nil {
+		t.Errorf("%s: failed to add tip income and reward, got err %v", testName, err)
+	}
+
+	reward := model.Reward{
+		TotalIncome:     c300,
+		OriginalIncome:  c200,
+		FrictionIncome:  c200,
+		InflationIncome: c300,
+		UnclaimReward:   c300,
+	}
+	checkAccountReward(t, ctx, testName, accKey, reward)
+	checkRewardHistory(t, ctx, testName, accKey, 0, 1)
+
+	rewardHistory, err := am.storage.GetRewardHistory(ctx, accKey, 0)
+	if err != nil {
+		t.Errorf("%s: failed to get reward history, got err %v", testName, err)
+	}
+	assert.Equal(t, model.RewardDetail{
+		OriginalDonation: c500,
+		FrictionDonation: c200,
+		ActualReward:     c300,
+		Consumer:         "donor1",
+		Reference:        "live session",
+	}, rewardHistory.Details[0])
+}
+
 func TestCheckUserTPSCapacity(t *testing.T) {
 	ctx, am, _ := setupTest(t, 1)
 	accKey := types.AccountKey("accKey")
@@ -1978,46 +2014,70 @@ func TestDonationRelationship(t *testing.T) {
 	createTestAccount(ctx, am, string(user2))
 	createTestAccount(ctx, am, string(user3))
 
+	// relationship stored before donation amount was recorded
+	ctx.KVStore(testAccountKVStoreKey).Set(
+		model.GetRelationshipKey(user2, user3), []byte(`{"donation_times":"4"}`))
+
 	testCases := []struct {
-		testName         string
-		user             types.AccountKey
-		donateTo         types.AccountKey
-		expectDonateTime int64
+		testName           string
+		user               types.AccountKey
+		donateTo           types.AccountKey
+		amount             types.Coin
+		expectDonateTime   int64
+		expectDonateAmount types.Coin
 	}{
 		{
-			testName:         "user1 donates to user2",
-			user:             user1,
-			donateTo:         user2,
-			expectDonateTime: 1,
+			testName:           "user1 donates to user2",
+			user:               user1,
+			donateTo:           user2,
+			amount:             types.NewCoinFromInt64(10),
+			expectDonateTime:   1,
+			expectDonateAmount: types.NewCoinFromInt64(10),
 		},
 		{
-			testName:         "user1 donates to user2 again",
-			user:             user1,
-			donateTo:         user2,
-			expectDonateTime: 2,
+			testName:           "user1 donates to user2 again",
+			user:               user1,
+			donateTo:           user2,
+			amount:             types.NewCoinFromInt64(20),
+			expectDonateTime:   2,
+			expectDonateAmount: types.NewCoinFromInt64(30),
 		},
 		{
-			testName:         "user1 donates to user3",
-			user:             user1,
-			donateTo:         user3,
-			expectDonateTime: 1,
+			testName:           "user1 donates to user3",
+			user:               user1,
+			donateTo:           user3,
+			amount:             types.NewCoinFromInt64(5),
+			expectDonateTime:   1,
+			expectDonateAmount: types.NewCoinFromInt64(5),
 		},
 		{
-			testName:         "user3 donates to user1",
-			user:             user3,
-			donateTo:         user1,
-			expectDonateTime: 1,
+			testName:           "user3 donates to user1",
+			user:               user3,
+			donateTo:           user1,
+			amount:             types.NewCoinFromInt64(7),
+			expectDonateTime:   1,
+			expectDonateAmount: types.NewCoinFromInt64(7),
 		},
 		{
-			testName:         "user2 donates to user1",
-			user:             user2,
-			donateTo:         user1,
-			expectDonateTime: 1,
+			testName:           "user2 donates to user1",
+			user:               user2,
+			donateTo:           user1,
+			amount:             types.NewCoinFromInt64(1),
+			expectDonateTime:   1,
+			expectDonateAmount: types.NewCoinFromInt64(1),
+		},
+		{
+			testName:           "user2 donates to user3 with legacy relationship",
+			user:               user2,
+			donateTo:           user3,
+			amount:             types.NewCoinFromInt64(3),
+			expectDonateTime:   5,
+			expectDonateAmount: types.NewCoinFromInt64(3),
 		},
 	}
 
 	for _, tc := range testCases {
-		err := am.UpdateDonationRelationship(ctx, tc.user, tc.donateTo)
+		err := am.UpdateDonationRelationship(ctx, tc.user, tc.donateTo, tc.amount)
 		if err != nil {
 			t.Errorf("%s: failed to update donation relationship, got err %v", tc.testName, err)
 		}
@@ -2029,6 +2089,14 @@ func TestDonationRelationship(t *testing.T) {
 		if donateTime != tc.expectDonateTime {
 			t.Errorf("%s: diff donate time, got %v, want %v", tc.testName, donateTime, tc.expectDonateTime)
 		}
+		relationship, err := am.storage.GetRelationship(ctx, tc.user, tc.donateTo)
+		if err != nil {
+			t.Errorf("%s: failed to get relationship, got err %v", tc.testName, err)
+		}
+		if !relationship.DonationAmount.IsEqual(tc.expectDonateAmount) {
+			t.Errorf("%s: diff donate amount, got %v, want %v",
+				tc.testName, relationship.DonationAmount, tc.expectDonateAmount)
+		}
 	}
 }
 
@@ -2279,3 +2347,95 @@ func TestAddFrozenMoney(t *testing.T) {
 		}
 	}
 }
+
+func TestIsActiveSubscriber(t *testing.T) {
+	ctx, am, _ := setupTest(t, 1)
+	user1 := types.AccountKey("user1")
+	user2 := types.AccountKey("user2")
+
+	createTestAccount(ctx, am, string(user1))
+	createTestAccount(ctx, am, string(user2))
+
+	testCases := []struct {
+		testName     string
+		subscription *model.Subscription
+		atWhen       int64
+		expectResult bool
+	}{
+		{
+			testName:     "no subscription",
+			subscription: nil,
+			atWhen:       0,
+			expectResult: false,
+		},
+		{
+			testName: "active subscription",
+			subscription: &model.Subscription{
+				Subscriber:      user1,
+				Creator:         user2,
+				AmountPerPeriod: coin100,
+				TotalPeriods:    1,
+				CreatedAt:       0,
+				ExpiresAt:       100,
+			},
+			atWhen:       99,
+			expectResult: true,
+		},
+		{
+			testName: "expired subscription",
+			subscription: &model.Subscription{
+				Subscriber:      user1,
+				Creator:         user2,
+				AmountPerPeriod: coin100,
+				TotalPeriods:    1,
+				CreatedAt:       0,
+				ExpiresAt:       100,
+			},
+			atWhen:       100,
+			expectResult: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		if tc.subscription != nil {
+			if err := am.storage.SetSubscription(ctx, tc.subscription); err != nil {
+				t.Errorf("%s: failed to set subscription, got err %v", tc.testName, err)
+			}
+		}
+		newCtx := ctx.WithBlockHeader(abci.Header{ChainID: "Lino", Time: time.Unix(tc.atWhen, 0)})
+		isActive, err := am.IsActiveSubscriber(newCtx, user1, user2)
+		if err != nil {
+			t.Errorf("%s: failed to check subscription, got err %v", tc.testName, err)
+		}
+		if isActive != tc.expectResult {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, isActive, tc.expectResult)
+		}
+	}
+}
+
+func TestHasAppPermission(t *testing.T) {
+	ctx, am, _ := setupTest(t, 1)
+	user1 := types.AccountKey("user1")
+	app := types.AccountKey("app")
+	otherApp := types.AccountKey("otherapp")
+
+	createTestAccount(ctx, am, string(user1))
+	createTestAccount(ctx, am, string(app))
+	createTestAccount(ctx, am, string(otherApp))
+	assert.False(t, am.HasAppPermission(ctx, user1, app))
+
+	err := am.AuthorizePermission(ctx, user1, app, 100, types.AppPermission, types.NewCoinFromInt64(0))
+	assert.Nil(t, err)
+	assert.True(t, am.HasAppPermission(ctx, user1, app))
+	assert.False(t, am.HasAppPermission(ctx, user1, otherApp))
+
+	// preauthorization permission doesn't authorize the app
+	err = am.AuthorizePermission(
+		ctx, user1, otherApp, 100, types.PreAuthorizationPermission, types.NewCoinFromInt64(100))
+	assert.Nil(t, err)
+	assert.False(t, am.HasAppPermission(ctx, user1, otherApp))
+
+	// expired permission
+	ctx = ctx.WithBlockHeader(abci.Header{ChainID: "Lino", Time: ctx.BlockHeader().Time.Add(100 * time.Second)})
+	assert.False(t, am.HasAppPermission(ctx, user1, app))
+}
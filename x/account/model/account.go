@@ -103,6 +103,7 @@ type RewardDetail struct {
 	Consumer         types.AccountKey `json:"consumer"`
 	PostAuthor       types.AccountKey `json:"post_author"`
 	PostID           string           `json:"post_id`
+	Reference        string           `json:"reference"`
 }
 
 // RewardHistory - reward history
@@ -110,9 +111,23 @@ type RewardHistory struct {
 	Details []RewardDetail `json:"details"`
 }
 
-// Relationship - relation between two users
+// Relationship - relation between two users, donation times and amount
+// are accumulated over all donations and tips from other to me
 type Relationship struct {
-	DonationTimes int64 `json:"donation_times"`
+	DonationTimes  int64      `json:"donation_times"`
+	DonationAmount types.Coin `json:"donation_amount"`
+}
+
+// Subscription - subscription from a subscriber to a content creator
+type Subscription struct {
+	ID              int64            `json:"id"`
+	Subscriber      types.AccountKey `json:"subscriber"`
+	Creator         types.AccountKey `json:"creator"`
+	AmountPerPeriod types.Coin       `json:"amount_per_period"`
+	TotalPeriods    int64            `json:"total_periods"`
+	PaidPeriods     int64            `json:"paid_periods"`
+	CreatedAt       int64            `json:"created_at"`
+	ExpiresAt       int64            `json:"expires_at"`
 }
 
 // BalanceHistory - records all transactions belong to the user
@@ -83,6 +83,11 @@ func ErrFailedToMarshalRelationship(err error) sdk.Error {
 	return types.NewError(types.CodeFailedToMarshalRelationship, fmt.Sprintf("failed to marshal relationship: %s", err.Error()))
 }
 
+// ErrFailedToMarshalSubscription - error if marshal subscription failed
+func ErrFailedToMarshalSubscription(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalSubscription, fmt.Sprintf("failed to marshal subscription: %s", err.Error()))
+}
+
 // ErrFailedToMarshalBalanceHistory - error if marshal balance history failed
 func ErrFailedToMarshalBalanceHistory(err error) sdk.Error {
 	return types.NewError(types.CodeFailedToMarshalBalanceHistory, fmt.Sprintf("failed to marshal balance history: %s", err.Error()))
@@ -128,6 +133,11 @@ func ErrFailedToUnmarshalRelationship(err error) sdk.Error {
 	return types.NewError(types.CodeFailedToUnmarshalRelationship, fmt.Sprintf("failed to unmarshal relationship: %s", err.Error()))
 }
 
+// ErrFailedToUnmarshalSubscription - error if unmarshal subscription failed
+func ErrFailedToUnmarshalSubscription(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalSubscription, fmt.Sprintf("failed to unmarshal subscription: %s", err.Error()))
+}
+
 // ErrFailedToUnmarshalBalanceHistory - error if unmarshal balance history failed
 func ErrFailedToUnmarshalBalanceHistory(err error) sdk.Error {
 	return types.NewError(types.CodeFailedToUnmarshalBalanceHistory, fmt.Sprintf("failed to unmarshal balance history: %s", err.Error()))
@@ -23,6 +23,9 @@ var (
 	accountBalanceHistorySubstore    = []byte{0x08}
 	accountGrantPubKeySubstore       = []byte{0x09}
 	accountRewardHistorySubstore     = []byte{0x0a}
+	accountSubscriptionSubstore      = []byte{0x0b}
+	accountSubscriberSubstore        = []byte{0x0c}
+	accountSubscriptionIDSubstore    = []byte{0x0d}
 )
 
 // AccountStorage - account storage
@@ -266,15 +269,35 @@ func (as AccountStorage) SetGrantPubKey(ctx sdk.Context, me types.AccountKey, pu
 // GetRelationship - returns the relationship between two accounts
 func (as AccountStorage) GetRelationship(ctx sdk.Context, me types.AccountKey, other types.AccountKey) (*Relationship, sdk.Error) {
 	store := ctx.KVStore(as.key)
-	relationshipByte := store.Get(getRelationshipKey(me, other))
+	relationshipByte := store.Get(GetRelationshipKey(me, other))
 	if relationshipByte == nil {
 		return nil, nil
 	}
-	queue := new(Relationship)
-	if err := as.cdc.UnmarshalJSON(relationshipByte, queue); err != nil {
+	relationship, err := DecodeRelationship(as.cdc, relationshipByte)
+	if err != nil {
 		return nil, ErrFailedToUnmarshalRelationship(err)
 	}
-	return queue, nil
+	return relationship, nil
+}
+
+// DecodeRelationship - decodes relationship, donation amount of relationship
+// stored before the amount was recorded defaults to zero
+func DecodeRelationship(cdc *wire.Codec, relationshipByte []byte) (*Relationship, error) {
+	legacy := new(struct {
+		DonationTimes  int64       `json:"donation_times"`
+		DonationAmount *types.Coin `json:"donation_amount"`
+	})
+	if err := cdc.UnmarshalJSON(relationshipByte, legacy); err != nil {
+		return nil, err
+	}
+	relationship := &Relationship{
+		DonationTimes:  legacy.DonationTimes,
+		DonationAmount: types.NewCoinFromInt64(0),
+	}
+	if legacy.DonationAmount != nil {
+		relationship.DonationAmount = *legacy.DonationAmount
+	}
+	return relationship, nil
 }
 
 // SetRelationship - sets relationship for two accounts
@@ -284,10 +307,89 @@ func (as AccountStorage) SetRelationship(ctx sdk.Context, me types.AccountKey, o
 	if err != nil {
 		return ErrFailedToMarshalRelationship(err)
 	}
-	store.Set(getRelationshipKey(me, other), relationshipByte)
+	store.Set(GetRelationshipKey(me, other), relationshipByte)
+	return nil
+}
+
+// GetSubscription - returns the subscription from subscriber to creator
+func (as AccountStorage) GetSubscription(
+	ctx sdk.Context, subscriber types.AccountKey, creator types.AccountKey) (*Subscription, sdk.Error) {
+	store := ctx.KVStore(as.key)
+	subscriptionByte := store.Get(GetSubscriptionKey(subscriber, creator))
+	if subscriptionByte == nil {
+		return nil, nil
+	}
+	subscription := new(Subscription)
+	if err := as.cdc.UnmarshalJSON(subscriptionByte, subscription); err != nil {
+		return nil, ErrFailedToUnmarshalSubscription(err)
+	}
+	return subscription, nil
+}
+
+// SetSubscription - sets subscription from subscriber to creator
+func (as AccountStorage) SetSubscription(ctx sdk.Context, subscription *Subscription) sdk.Error {
+	store := ctx.KVStore(as.key)
+	subscriptionByte, err := as.cdc.MarshalJSON(*subscription)
+	if err != nil {
+		return ErrFailedToMarshalSubscription(err)
+	}
+	store.Set(GetSubscriptionKey(subscription.Subscriber, subscription.Creator), subscriptionByte)
+	return nil
+}
+
+// DeleteSubscription - removes subscription from subscriber to creator
+func (as AccountStorage) DeleteSubscription(
+	ctx sdk.Context, subscriber types.AccountKey, creator types.AccountKey) {
+	store := ctx.KVStore(as.key)
+	store.Delete(GetSubscriptionKey(subscriber, creator))
+}
+
+// GetNextSubscriptionID - returns the id assigned to the next subscription
+func (as AccountStorage) GetNextSubscriptionID(ctx sdk.Context) (int64, sdk.Error) {
+	store := ctx.KVStore(as.key)
+	idByte := store.Get(GetSubscriptionIDKey())
+	if idByte == nil {
+		return 1, nil
+	}
+	var id int64
+	if err := as.cdc.UnmarshalJSON(idByte, &id); err != nil {
+		return 0, ErrFailedToUnmarshalSubscription(err)
+	}
+	return id, nil
+}
+
+// SetNextSubscriptionID - sets the id assigned to the next subscription
+func (as AccountStorage) SetNextSubscriptionID(ctx sdk.Context, id int64) sdk.Error {
+	store := ctx.KVStore(as.key)
+	idByte, err := as.cdc.MarshalJSON(id)
+	if err != nil {
+		return ErrFailedToMarshalSubscription(err)
+	}
+	store.Set(GetSubscriptionIDKey(), idByte)
 	return nil
 }
 
+// SetSubscriber - add subscriber to creator's subscriber list
+func (as AccountStorage) SetSubscriber(
+	ctx sdk.Context, creator types.AccountKey, subscriber types.AccountKey) {
+	store := ctx.KVStore(as.key)
+	store.Set(GetSubscriberKey(creator, subscriber), []byte(subscriber))
+}
+
+// RemoveSubscriber - remove subscriber from creator's subscriber list
+func (as AccountStorage) RemoveSubscriber(
+	ctx sdk.Context, creator types.AccountKey, subscriber types.AccountKey) {
+	store := ctx.KVStore(as.key)
+	store.Delete(GetSubscriberKey(creator, subscriber))
+}
+
+// IsMySubscriber - check if subscriber is in creator's subscriber list
+func (as AccountStorage) IsMySubscriber(
+	ctx sdk.Context, creator types.AccountKey, subscriber types.AccountKey) bool {
+	store := ctx.KVStore(as.key)
+	return store.Has(GetSubscriberKey(creator, subscriber))
+}
+
 // GetRelationship - returns the relationship between two accounts
 func (as AccountStorage) GetBalanceHistory(
 	ctx sdk.Context, me types.AccountKey, bucketSlot int64) (*BalanceHistory, sdk.Error) {
@@ -390,14 +492,42 @@ func getRewardKey(accKey types.AccountKey) []byte {
 	return append(accountRewardSubstore, accKey...)
 }
 
-func getRelationshipKey(me types.AccountKey, other types.AccountKey) []byte {
-	return append(getRelationshipPrefix(me), other...)
+// GetRelationshipKey - "relationship substore" + "me" + "other"
+func GetRelationshipKey(me types.AccountKey, other types.AccountKey) []byte {
+	return append(GetRelationshipPrefix(me), other...)
 }
 
-func getRelationshipPrefix(me types.AccountKey) []byte {
+// GetRelationshipPrefix - "relationship substore" + "me" + "/"
+// which can be used to access all users donated to me
+func GetRelationshipPrefix(me types.AccountKey) []byte {
 	return append(append(accountRelationshipSubstore, me...), types.KeySeparator...)
 }
 
+// GetSubscriptionPrefix - "subscription substore" + "subscriber"
+func GetSubscriptionPrefix(subscriber types.AccountKey) []byte {
+	return append(append(accountSubscriptionSubstore, subscriber...), types.KeySeparator...)
+}
+
+// GetSubscriptionKey - "subscription substore" + "subscriber" + "creator"
+func GetSubscriptionKey(subscriber types.AccountKey, creator types.AccountKey) []byte {
+	return append(GetSubscriptionPrefix(subscriber), creator...)
+}
+
+// GetSubscriptionIDKey - "subscription id substore"
+func GetSubscriptionIDKey() []byte {
+	return accountSubscriptionIDSubstore
+}
+
+// GetSubscriberPrefix - "subscriber substore" + "creator"
+func GetSubscriberPrefix(creator types.AccountKey) []byte {
+	return append(append(accountSubscriberSubstore, creator...), types.KeySeparator...)
+}
+
+// GetSubscriberKey - "subscriber substore" + "creator" + "subscriber"
+func GetSubscriberKey(creator types.AccountKey, subscriber types.AccountKey) []byte {
+	return append(GetSubscriberPrefix(creator), subscriber...)
+}
+
 func getPendingStakeQueueKey(accKey types.AccountKey) []byte {
 	return append(accountPendingStakeQueueSubstore, accKey...)
 }
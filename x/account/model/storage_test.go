@@ -108,7 +108,10 @@ func TestAccountRelationShip(t *testing.T) {
 	as := NewAccountStorage(TestKVStoreKey)
 	ctx := getContext()
 
-	relationship := Relationship{}
+	relationship := Relationship{
+		DonationTimes:  2,
+		DonationAmount: types.NewCoinFromInt64(100),
+	}
 	err := as.SetRelationship(
 		ctx, types.AccountKey("me"), types.AccountKey("other"), &relationship)
 	assert.Nil(t, err)
@@ -118,6 +121,67 @@ func TestAccountRelationShip(t *testing.T) {
 	assert.Equal(t, relationship, *resultPtr, "Account relationship should be equal")
 }
 
+func TestLegacyAccountRelationShip(t *testing.T) {
+	as := NewAccountStorage(TestKVStoreKey)
+	ctx := getContext()
+
+	// relationship stored before donation amount was recorded
+	legacyByte, err := as.cdc.MarshalJSON(struct {
+		DonationTimes int64 `json:"donation_times"`
+	}{DonationTimes: 3})
+	assert.Nil(t, err)
+	ctx.KVStore(TestKVStoreKey).Set(
+		GetRelationshipKey(types.AccountKey("me"), types.AccountKey("other")), legacyByte)
+
+	resultPtr, err := as.GetRelationship(ctx, types.AccountKey("me"), types.AccountKey("other"))
+	assert.Nil(t, err)
+	assert.Equal(t, Relationship{
+		DonationTimes:  3,
+		DonationAmount: types.NewCoinFromInt64(0),
+	}, *resultPtr, "Legacy relationship should have zero donation amount")
+}
+
+func TestAccountSubscription(t *testing.T) {
+	as := NewAccountStorage(TestKVStoreKey)
+	ctx := getContext()
+
+	subscription := Subscription{
+		ID:              1,
+		Subscriber:      types.AccountKey("me"),
+		Creator:         types.AccountKey("other"),
+		AmountPerPeriod: types.NewCoinFromInt64(10),
+		TotalPeriods:    2,
+		PaidPeriods:     1,
+		CreatedAt:       1,
+		ExpiresAt:       100,
+	}
+	err := as.SetSubscription(ctx, &subscription)
+	assert.Nil(t, err)
+
+	resultPtr, err := as.GetSubscription(ctx, types.AccountKey("me"), types.AccountKey("other"))
+	assert.Nil(t, err)
+	assert.Equal(t, subscription, *resultPtr, "Account subscription should be equal")
+
+	as.DeleteSubscription(ctx, types.AccountKey("me"), types.AccountKey("other"))
+	resultPtr, err = as.GetSubscription(ctx, types.AccountKey("me"), types.AccountKey("other"))
+	assert.Nil(t, err)
+	assert.Nil(t, resultPtr)
+
+	as.SetSubscriber(ctx, types.AccountKey("other"), types.AccountKey("me"))
+	assert.True(t, as.IsMySubscriber(ctx, types.AccountKey("other"), types.AccountKey("me")))
+	as.RemoveSubscriber(ctx, types.AccountKey("other"), types.AccountKey("me"))
+	assert.False(t, as.IsMySubscriber(ctx, types.AccountKey("other"), types.AccountKey("me")))
+
+	id, err := as.GetNextSubscriptionID(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), id)
+	err = as.SetNextSubscriptionID(ctx, 2)
+	assert.Nil(t, err)
+	id, err = as.GetNextSubscriptionID(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), id)
+}
+
 func TestAccountBalanceHistory(t *testing.T) {
 	as := NewAccountStorage(TestKVStoreKey)
 	ctx := getContext()
@@ -18,6 +18,8 @@ var _ types.Msg = TransferMsg{}
 var _ types.Msg = RecoverMsg{}
 var _ types.Msg = RegisterMsg{}
 var _ types.Msg = UpdateAccountMsg{}
+var _ types.Msg = SubscribeMsg{}
+var _ types.Msg = UnsubscribeMsg{}
 
 // RegisterMsg - bind username with public key, need to be referred by others (pay for it)
 type RegisterMsg struct {
@@ -62,6 +64,20 @@ type TransferMsg struct {
 	Memo     string           `json:"memo"`
 }
 
+// SubscribeMsg - subscriber pays creator a fixed amount each period for a number of periods
+type SubscribeMsg struct {
+	Subscriber      types.AccountKey `json:"subscriber"`
+	Creator         types.AccountKey `json:"creator"`
+	AmountPerPeriod types.LNO        `json:"amount_per_period"`
+	Periods         int64            `json:"periods"`
+}
+
+// UnsubscribeMsg - subscriber cancels subscription to creator
+type UnsubscribeMsg struct {
+	Subscriber types.AccountKey `json:"subscriber"`
+	Creator    types.AccountKey `json:"creator"`
+}
+
 // UpdateAccountMsg - update account JSON meta info
 type UpdateAccountMsg struct {
 	Username types.AccountKey `json:"username"`
@@ -460,3 +476,113 @@ func (msg UpdateAccountMsg) GetSigners() []sdk.AccAddress {
 func (msg UpdateAccountMsg) GetConsumeAmount() types.Coin {
 	return types.NewCoinFromInt64(0)
 }
+
+// NewSubscribeMsg - return a SubscribeMsg
+func NewSubscribeMsg(subscriber, creator string, amountPerPeriod types.LNO, periods int64) SubscribeMsg {
+	return SubscribeMsg{
+		Subscriber:      types.AccountKey(subscriber),
+		Creator:         types.AccountKey(creator),
+		AmountPerPeriod: amountPerPeriod,
+		Periods:         periods,
+	}
+}
+
+// Type - implements sdk.Msg
+func (msg SubscribeMsg) Type() string { return types.AccountRouterName }
+
+// ValidateBasic - implements sdk.Msg
+func (msg SubscribeMsg) ValidateBasic() sdk.Error {
+	if len(msg.Subscriber) < types.MinimumUsernameLength ||
+		len(msg.Subscriber) > types.MaximumUsernameLength ||
+		len(msg.Creator) < types.MinimumUsernameLength ||
+		len(msg.Creator) > types.MaximumUsernameLength {
+		return ErrInvalidUsername("illegal length")
+	}
+	_, err := types.LinoToCoin(msg.AmountPerPeriod)
+	if err != nil {
+		return err
+	}
+	if msg.Periods <= 0 || msg.Periods > types.MaximumSubscriptionPeriods {
+		return ErrInvalidSubscriptionPeriods()
+	}
+	return nil
+}
+
+func (msg SubscribeMsg) String() string {
+	return fmt.Sprintf("SubscribeMsg{Subscriber:%v, Creator:%v, AmountPerPeriod:%v, Periods:%v}",
+		msg.Subscriber, msg.Creator, msg.AmountPerPeriod, msg.Periods)
+}
+
+// GetPermission - implements types.Msg
+func (msg SubscribeMsg) GetPermission() types.Permission {
+	return types.TransactionPermission
+}
+
+// GetSignBytes - implements sdk.Msg
+func (msg SubscribeMsg) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg) // XXX: ensure some canonical form
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners - implements sdk.Msg
+func (msg SubscribeMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Subscriber)}
+}
+
+// GetConsumeAmount - implements types.Msg
+func (msg SubscribeMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
+
+// NewUnsubscribeMsg - return a UnsubscribeMsg
+func NewUnsubscribeMsg(subscriber, creator string) UnsubscribeMsg {
+	return UnsubscribeMsg{
+		Subscriber: types.AccountKey(subscriber),
+		Creator:    types.AccountKey(creator),
+	}
+}
+
+// Type - implements sdk.Msg
+func (msg UnsubscribeMsg) Type() string { return types.AccountRouterName }
+
+// ValidateBasic - implements sdk.Msg
+func (msg UnsubscribeMsg) ValidateBasic() sdk.Error {
+	if len(msg.Subscriber) < types.MinimumUsernameLength ||
+		len(msg.Subscriber) > types.MaximumUsernameLength ||
+		len(msg.Creator) < types.MinimumUsernameLength ||
+		len(msg.Creator) > types.MaximumUsernameLength {
+		return ErrInvalidUsername("illegal length")
+	}
+	return nil
+}
+
+func (msg UnsubscribeMsg) String() string {
+	return fmt.Sprintf("UnsubscribeMsg{Subscriber:%v, Creator:%v}", msg.Subscriber, msg.Creator)
+}
+
+// GetPermission - implements types.Msg
+func (msg UnsubscribeMsg) GetPermission() types.Permission {
+	return types.TransactionPermission
+}
+
+// GetSignBytes - implements sdk.Msg
+func (msg UnsubscribeMsg) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg) // XXX: ensure some canonical form
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners - implements sdk.Msg
+func (msg UnsubscribeMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Subscriber)}
+}
+
+// GetConsumeAmount - implements types.Msg
+func (msg UnsubscribeMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
@@ -196,6 +196,78 @@ func TestTransferMsg(t *testing.T) {
 	}
 }
 
+func TestSubscribeMsg(t *testing.T) {
+	testCases := map[string]struct {
+		msg      SubscribeMsg
+		wantCode sdk.CodeType
+	}{
+		"normal case": {
+			msg:      NewSubscribeMsg("userA", "userB", types.LNO("10"), 3),
+			wantCode: sdk.CodeOK,
+		},
+		"invalid subscribe - no creator provided": {
+			msg:      NewSubscribeMsg("userA", "", types.LNO("10"), 3),
+			wantCode: types.CodeInvalidUsername,
+		},
+		"invalid subscribe - amount is invalid": {
+			msg:      NewSubscribeMsg("userA", "userB", types.LNO("-10"), 3),
+			wantCode: types.CodeInvalidCoins,
+		},
+		"invalid subscribe - zero period": {
+			msg:      NewSubscribeMsg("userA", "userB", types.LNO("10"), 0),
+			wantCode: types.CodeInvalidSubscriptionPeriods,
+		},
+		"invalid subscribe - too many periods": {
+			msg:      NewSubscribeMsg("userA", "userB", types.LNO("10"), types.MaximumSubscriptionPeriods+1),
+			wantCode: types.CodeInvalidSubscriptionPeriods,
+		},
+	}
+
+	for testName, tc := range testCases {
+		got := tc.msg.ValidateBasic()
+
+		if got == nil {
+			if tc.wantCode != sdk.CodeOK {
+				t.Errorf("%s: diff error: got %v, want %v", testName, sdk.CodeOK, tc.wantCode)
+			}
+			continue
+		}
+		if got.Code() != tc.wantCode {
+			t.Errorf("%s: diff error code: got %v, want %v", testName, got.Code(), tc.wantCode)
+		}
+	}
+}
+
+func TestUnsubscribeMsg(t *testing.T) {
+	testCases := map[string]struct {
+		msg      UnsubscribeMsg
+		wantCode sdk.CodeType
+	}{
+		"normal case": {
+			msg:      NewUnsubscribeMsg("userA", "userB"),
+			wantCode: sdk.CodeOK,
+		},
+		"invalid unsubscribe - no subscriber provided": {
+			msg:      NewUnsubscribeMsg("", "userB"),
+			wantCode: types.CodeInvalidUsername,
+		},
+	}
+
+	for testName, tc := range testCases {
+		got := tc.msg.ValidateBasic()
+
+		if got == nil {
+			if tc.wantCode != sdk.CodeOK {
+				t.Errorf("%s: diff error: got %v, want %v", testName, sdk.CodeOK, tc.wantCode)
+			}
+			continue
+		}
+		if got.Code() != tc.wantCode {
+			t.Errorf("%s: diff error code: got %v, want %v", testName, got.Code(), tc.wantCode)
+		}
+	}
+}
+
 func TestRecoverMsg(t *testing.T) {
 	testCases := map[string]struct {
 		msg      RecoverMsg
@@ -430,6 +502,14 @@ func TestMsgPermission(t *testing.T) {
 			msg:              NewUpdateAccountMsg("user", "{'test':'test'}"),
 			expectPermission: types.AppPermission,
 		},
+		"subscribe": {
+			msg:              NewSubscribeMsg("userA", "userB", types.LNO("1"), 1),
+			expectPermission: types.TransactionPermission,
+		},
+		"unsubscribe": {
+			msg:              NewUnsubscribeMsg("userA", "userB"),
+			expectPermission: types.TransactionPermission,
+		},
 	}
 
 	for testName, tc := range cases {
@@ -480,6 +560,12 @@ func TestGetSignBytes(t *testing.T) {
 		"update msg": {
 			msg: NewUpdateAccountMsg("user", "{'test':'test'}"),
 		},
+		"subscribe": {
+			msg: NewSubscribeMsg("userA", "userB", types.LNO("1"), 1),
+		},
+		"unsubscribe": {
+			msg: NewUnsubscribeMsg("userA", "userB"),
+		},
 	}
 
 	for testName, tc := range cases {
@@ -538,6 +624,14 @@ func TestGetSigners(t *testing.T) {
 			msg:           NewUpdateAccountMsg("user", "{'test':'test'}"),
 			expectSigners: []types.AccountKey{"user"},
 		},
+		"subscribe": {
+			msg:           NewSubscribeMsg("userA", "userB", types.LNO("1"), 1),
+			expectSigners: []types.AccountKey{"userA"},
+		},
+		"unsubscribe": {
+			msg:           NewUnsubscribeMsg("userA", "userB"),
+			expectSigners: []types.AccountKey{"userA"},
+		},
 	}
 
 	for testName, tc := range cases {
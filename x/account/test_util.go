@@ -36,6 +36,7 @@ var (
 	l2000 = types.LNO("2000")
 	c0    = types.NewCoinFromInt64(0)
 	c100  = types.NewCoinFromInt64(100 * types.Decimals)
+	c150  = types.NewCoinFromInt64(150 * types.Decimals)
 	c200  = types.NewCoinFromInt64(200 * types.Decimals)
 	c300  = types.NewCoinFromInt64(300 * types.Decimals)
 	c400  = types.NewCoinFromInt64(400 * types.Decimals)
@@ -44,7 +45,9 @@ var (
 	c1000 = types.NewCoinFromInt64(1000 * types.Decimals)
 	c1500 = types.NewCoinFromInt64(1500 * types.Decimals)
 	c1600 = types.NewCoinFromInt64(1600 * types.Decimals)
+	c1700 = types.NewCoinFromInt64(1700 * types.Decimals)
 	c1800 = types.NewCoinFromInt64(1800 * types.Decimals)
+	c1850 = types.NewCoinFromInt64(1850 * types.Decimals)
 	c1900 = types.NewCoinFromInt64(1900 * types.Decimals)
 	c2000 = types.NewCoinFromInt64(2000 * types.Decimals)
 
@@ -74,6 +77,7 @@ func setupTest(t *testing.T, height int64) (sdk.Context, AccountManager, global.
 	cdc := globalManager.WireCodec()
 	cdc.RegisterInterface((*types.Event)(nil), nil)
 	cdc.RegisterConcrete(ReturnCoinEvent{}, "event/return", nil)
+	cdc.RegisterConcrete(SubscriptionEvent{}, "event/subscription", nil)
 
 	err := initGlobalManager(ctx, globalManager)
 	assert.Nil(t, err)
@@ -13,6 +13,8 @@ func RegisterWire(cdc *wire.Codec) {
 	cdc.RegisterConcrete(ClaimMsg{}, "lino/claim", nil)
 	cdc.RegisterConcrete(RecoverMsg{}, "lino/recover", nil)
 	cdc.RegisterConcrete(UpdateAccountMsg{}, "lino/updateAcc", nil)
+	cdc.RegisterConcrete(SubscribeMsg{}, "lino/subscribe", nil)
+	cdc.RegisterConcrete(UnsubscribeMsg{}, "lino/unsubscribe", nil)
 }
 
 var msgCdc = wire.NewCodec()
@@ -0,0 +1,47 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/wire"
+	"github.com/lino-network/lino/client"
+	"github.com/lino-network/lino/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	dev "github.com/lino-network/lino/x/developer"
+)
+
+// OpenMatchingPoolTxCmd - developer opens a pool to match donations made through the app
+func OpenMatchingPoolTxCmd(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "open-matching-pool",
+		Short: "lock budget to match donations made through the app",
+		RunE:  sendOpenMatchingPoolTx(cdc),
+	}
+	cmd.Flags().String(client.FlagDeveloper, "", "developer name of this transaction")
+	cmd.Flags().String(client.FlagBudget, "", "total budget of the matching pool")
+	cmd.Flags().String(client.FlagPerUserCap, "", "maximum amount matched for one user")
+	cmd.Flags().Int64(client.FlagSeconds, 7*24*3600, "seconds till the pool expires")
+	return cmd
+}
+
+// send open matching pool transaction to the blockchain
+func sendOpenMatchingPoolTx(cdc *wire.Codec) client.CommandTxCallback {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := client.NewCoreContextFromViper()
+		msg := dev.NewOpenMatchingPoolMsg(
+			viper.GetString(client.FlagDeveloper), types.LNO(viper.GetString(client.FlagBudget)),
+			types.LNO(viper.GetString(client.FlagPerUserCap)), viper.GetInt64(client.FlagSeconds))
+
+		// build and sign the transaction, then broadcast to Tendermint
+		res, signErr := ctx.SignBuildBroadcast([]sdk.Msg{msg}, cdc)
+		if signErr != nil {
+			return signErr
+		}
+
+		fmt.Printf("Committed at block %d. Hash: %s\n", res.Height, res.Hash.String())
+		return nil
+	}
+}
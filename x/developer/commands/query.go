@@ -40,6 +40,19 @@ func GetDevelopersCmd(storeName string, cdc *wire.Codec) *cobra.Command {
 	}
 }
 
+// GetMatchingPoolCmd - returns open matching pool and remaining budget of an app
+func GetMatchingPoolCmd(storeName string, cdc *wire.Codec) *cobra.Command {
+	cmdr := commander{
+		storeName,
+		cdc,
+	}
+	return &cobra.Command{
+		Use:   "matching-pool",
+		Short: "Query matching pool of an app",
+		RunE:  cmdr.getMatchingPoolCmd,
+	}
+}
+
 type commander struct {
 	storeName string
 	cdc       *wire.Codec
@@ -69,6 +82,30 @@ func (c commander) getDeveloperCmd(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+func (c commander) getMatchingPoolCmd(cmd *cobra.Command, args []string) error {
+	ctx := client.NewCoreContextFromViper()
+	if len(args) != 1 || len(args[0]) == 0 {
+		return errors.New("You must provide an app name")
+	}
+
+	app := types.AccountKey(args[0])
+	res, err := ctx.Query(model.GetMatchingPoolKey(app), c.storeName)
+	if err != nil {
+		return err
+	}
+	pool := new(model.MatchingPool)
+	if err := c.cdc.UnmarshalJSON(res, pool); err != nil {
+		return err
+	}
+
+	output, err := json.MarshalIndent(pool, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(output))
+	return nil
+}
+
 func (c commander) getDevelopersCmd(cmd *cobra.Command, args []string) error {
 	ctx := client.NewCoreContextFromViper()
 	res, err := ctx.Query(model.GetDeveloperListKey(), c.storeName)
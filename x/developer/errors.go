@@ -61,3 +61,13 @@ func ErrInvalidValidityPeriod() sdk.Error {
 func ErrGrantPermissionTooHigh() sdk.Error {
 	return types.NewError(types.CodeGrantPermissionTooHigh, fmt.Sprintf("grant permission is too high"))
 }
+
+// ErrMatchingPoolAlreadyExist - error if app already has an open matching pool
+func ErrMatchingPoolAlreadyExist(app types.AccountKey) sdk.Error {
+	return types.NewError(types.CodeMatchingPoolAlreadyExist, fmt.Sprintf("matching pool of %v already exist", app))
+}
+
+// ErrInvalidMatchingPool - error if matching pool setting is invalid
+func ErrInvalidMatchingPool(reason string) sdk.Error {
+	return types.NewError(types.CodeInvalidMatchingPool, fmt.Sprintf("invalid matching pool: %v", reason))
+}
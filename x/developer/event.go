@@ -0,0 +1,30 @@
+package developer
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lino-network/lino/types"
+
+	acc "github.com/lino-network/lino/x/account"
+)
+
+// MatchingPoolExpireEvent - close expired matching pool and refund unused budget to app
+type MatchingPoolExpireEvent struct {
+	App types.AccountKey `json:"app"`
+}
+
+// Execute - execute matching pool expire event
+func (event MatchingPoolExpireEvent) Execute(
+	ctx sdk.Context, dm DeveloperManager, am acc.AccountManager) sdk.Error {
+	remaining, err := dm.CloseMatchingPool(ctx, event.App)
+	if err != nil {
+		return err
+	}
+	if remaining.IsZero() {
+		return nil
+	}
+	if err := am.AddSavingCoin(
+		ctx, event.App, remaining, "", "", types.MatchingPoolReturnCoin); err != nil {
+		return err
+	}
+	return nil
+}
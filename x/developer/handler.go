@@ -27,6 +27,8 @@ func NewHandler(dm DeveloperManager, am acc.AccountManager, gm global.GlobalMana
 			return handleDeveloperRevokeMsg(ctx, dm, am, gm, msg)
 		case RevokePermissionMsg:
 			return handleRevokePermissionMsg(ctx, dm, am, msg)
+		case OpenMatchingPoolMsg:
+			return handleOpenMatchingPoolMsg(ctx, dm, am, gm, msg)
 		default:
 			errMsg := fmt.Sprintf("Unrecognized developer msg type: %v", reflect.TypeOf(msg).Name())
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -155,6 +157,38 @@ func handlePreAuthorizationMsg(
 	return sdk.Result{}
 }
 
+func handleOpenMatchingPoolMsg(
+	ctx sdk.Context, dm DeveloperManager, am acc.AccountManager,
+	gm global.GlobalManager, msg OpenMatchingPoolMsg) sdk.Result {
+	if !dm.DoesDeveloperExist(ctx, msg.Username) {
+		return ErrDeveloperNotFound().Result()
+	}
+	budget, err := types.LinoToCoin(msg.Budget)
+	if err != nil {
+		return err.Result()
+	}
+	perUserCap, err := types.LinoToCoin(msg.PerUserCap)
+	if err != nil {
+		return err.Result()
+	}
+
+	if err := dm.OpenMatchingPool(
+		ctx, msg.Username, budget, perUserCap,
+		ctx.BlockHeader().Time.Unix()+msg.DurationSec); err != nil {
+		return err.Result()
+	}
+	// lock budget from app's bank, unused part is refunded when pool expires
+	if err := am.MinusSavingCoin(
+		ctx, msg.Username, budget, "", "", types.MatchingPoolDeposit); err != nil {
+		return err.Result()
+	}
+	if err := gm.RegisterMatchingPoolExpireEvent(
+		ctx, msg.DurationSec, MatchingPoolExpireEvent{App: msg.Username}); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{}
+}
+
 func returnCoinTo(
 	ctx sdk.Context, name types.AccountKey, gm global.GlobalManager,
 	am acc.AccountManager, times int64, interval int64, coin types.Coin) sdk.Error {
@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	acc "github.com/lino-network/lino/x/account"
 	accstore "github.com/lino-network/lino/x/account/model"
 )
 
@@ -203,6 +204,73 @@ func TestAddFrozenMoney(t *testing.T) {
 	}
 }
 
+func TestOpenMatchingPoolAndExpire(t *testing.T) {
+	ctx, am, dm, gm := setupTest(t, 0)
+	handler := NewHandler(dm, am, gm)
+	dm.InitGenesis(ctx)
+
+	devParam, _ := dm.paramHolder.GetDeveloperParam(ctx)
+	minBalance := types.NewCoinFromInt64(100 * types.Decimals)
+	createTestAccount(ctx, am, "developer1", devParam.DeveloperMinDeposit.Plus(minBalance))
+	createTestAccount(ctx, am, "developer2", devParam.DeveloperMinDeposit.Plus(minBalance))
+	createTestAccount(ctx, am, "user1", minBalance)
+	deposit := strconv.FormatInt(devParam.DeveloperMinDeposit.ToInt64()/types.Decimals, 10)
+	handler(ctx, NewDeveloperRegisterMsg("developer1", deposit, "", "", ""))
+	handler(ctx, NewDeveloperRegisterMsg("developer2", deposit, "", "", ""))
+
+	testCases := []struct {
+		testName     string
+		msg          OpenMatchingPoolMsg
+		expectResult sdk.Result
+	}{
+		{
+			testName:     "user is not developer",
+			msg:          NewOpenMatchingPoolMsg("user1", "10", "1", 3600),
+			expectResult: ErrDeveloperNotFound().Result(),
+		},
+		{
+			testName:     "budget is more than saving",
+			msg:          NewOpenMatchingPoolMsg("developer2", "101", "1", 3600),
+			expectResult: acc.ErrAccountSavingCoinNotEnough().Result(),
+		},
+		{
+			testName:     "open matching pool",
+			msg:          NewOpenMatchingPoolMsg("developer1", "60", "1", 3600),
+			expectResult: sdk.Result{},
+		},
+		{
+			testName:     "matching pool already exist",
+			msg:          NewOpenMatchingPoolMsg("developer1", "10", "1", 3600),
+			expectResult: ErrMatchingPoolAlreadyExist("developer1").Result(),
+		},
+	}
+	for _, tc := range testCases {
+		result := handler(ctx, tc.msg)
+		if !assert.Equal(t, tc.expectResult, result) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectResult)
+		}
+	}
+
+	// budget is locked from developer's saving
+	saving, _ := am.GetSavingFromBank(ctx, "developer1")
+	assert.Equal(t, types.NewCoinFromInt64(40*types.Decimals), saving)
+	matched, err := dm.MatchDonation(ctx, "developer1", "user1", types.NewCoinFromInt64(5*types.Decimals))
+	assert.Nil(t, err)
+	assert.Equal(t, types.NewCoinFromInt64(1*types.Decimals), matched)
+
+	// unused budget is refunded when pool expires
+	eventList := gm.GetTimeEventListAtTime(ctx, ctx.BlockHeader().Time.Unix()+3600)
+	assert.Equal(t, 1, len(eventList.Events))
+	event, ok := eventList.Events[0].(MatchingPoolExpireEvent)
+	assert.True(t, ok)
+	err = event.Execute(ctx, dm, am)
+	assert.Nil(t, err)
+	saving, _ = am.GetSavingFromBank(ctx, "developer1")
+	assert.Equal(t, types.NewCoinFromInt64(99*types.Decimals), saving)
+	_, err = dm.GetMatchingPool(ctx, "developer1")
+	assert.NotNil(t, err)
+}
+
 func TestGrantPermissionMsg(t *testing.T) {
 	ctx, am, dm, gm := setupTest(t, 0)
 	param, err := dm.paramHolder.GetDeveloperParam(ctx)
@@ -175,6 +175,83 @@ func (dm DeveloperManager) ClearConsumption(ctx sdk.Context) sdk.Error {
 	return nil
 }
 
+// OpenMatchingPool - lock budget to match donations made through app until expiry,
+// an app can only have one open matching pool at a time
+func (dm DeveloperManager) OpenMatchingPool(
+	ctx sdk.Context, app types.AccountKey, budget, perUserCap types.Coin, expiresAt int64) sdk.Error {
+	if dm.storage.DoesMatchingPoolExist(ctx, app) {
+		return ErrMatchingPoolAlreadyExist(app)
+	}
+	pool := &model.MatchingPool{
+		App:        app,
+		Budget:     budget,
+		Remaining:  budget,
+		PerUserCap: perUserCap,
+		CreatedAt:  ctx.BlockHeader().Time.Unix(),
+		ExpiresAt:  expiresAt,
+	}
+	return dm.storage.SetMatchingPool(ctx, app, pool)
+}
+
+// GetMatchingPool - get open matching pool of app
+func (dm DeveloperManager) GetMatchingPool(
+	ctx sdk.Context, app types.AccountKey) (*model.MatchingPool, sdk.Error) {
+	return dm.storage.GetMatchingPool(ctx, app)
+}
+
+// MatchDonation - match donation of user from app's matching pool, matched amount is
+// limited by the pool's remaining budget and what is left of the user's cap.
+// Returns zero if app has no open matching pool or the pool is expired.
+func (dm DeveloperManager) MatchDonation(
+	ctx sdk.Context, app, username types.AccountKey, donation types.Coin) (types.Coin, sdk.Error) {
+	if !dm.storage.DoesMatchingPoolExist(ctx, app) {
+		return types.NewCoinFromInt64(0), nil
+	}
+	pool, err := dm.storage.GetMatchingPool(ctx, app)
+	if err != nil {
+		return types.NewCoinFromInt64(0), err
+	}
+	if ctx.BlockHeader().Time.Unix() >= pool.ExpiresAt {
+		return types.NewCoinFromInt64(0), nil
+	}
+	matchedAmount, err := dm.storage.GetMatchedAmount(ctx, app, username)
+	if err != nil {
+		return types.NewCoinFromInt64(0), err
+	}
+	if matchedAmount.IsGTE(pool.PerUserCap) {
+		return types.NewCoinFromInt64(0), nil
+	}
+	match := donation
+	if userQuota := pool.PerUserCap.Minus(matchedAmount); match.IsGT(userQuota) {
+		match = userQuota
+	}
+	if match.IsGT(pool.Remaining) {
+		match = pool.Remaining
+	}
+	if match.IsZero() {
+		return match, nil
+	}
+	pool.Remaining = pool.Remaining.Minus(match)
+	if err := dm.storage.SetMatchingPool(ctx, app, pool); err != nil {
+		return types.NewCoinFromInt64(0), err
+	}
+	if err := dm.storage.SetMatchedAmount(ctx, app, username, matchedAmount.Plus(match)); err != nil {
+		return types.NewCoinFromInt64(0), err
+	}
+	return match, nil
+}
+
+// CloseMatchingPool - close app's matching pool and return unused budget
+func (dm DeveloperManager) CloseMatchingPool(
+	ctx sdk.Context, app types.AccountKey) (types.Coin, sdk.Error) {
+	pool, err := dm.storage.GetMatchingPool(ctx, app)
+	if err != nil {
+		return types.NewCoinFromInt64(0), err
+	}
+	dm.storage.DeleteMatchingPool(ctx, app)
+	return pool.Remaining, nil
+}
+
 // this method won't check if it is a legal withdraw, caller should check by itself
 func (dm DeveloperManager) Withdraw(
 	ctx sdk.Context, username types.AccountKey, coin types.Coin) sdk.Error {
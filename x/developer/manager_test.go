@@ -3,10 +3,13 @@ package developer
 import (
 	"math/big"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lino-network/lino/types"
 	"github.com/stretchr/testify/assert"
+
+	abci "github.com/tendermint/tendermint/abci/types"
 )
 
 func TestReportConsumption(t *testing.T) {
@@ -117,3 +120,99 @@ func TestUpdateDeveloper(t *testing.T) {
 		}
 	}
 }
+
+func TestMatchDonation(t *testing.T) {
+	ctx, _, dm, _ := setupTest(t, 0)
+	dm.InitGenesis(ctx)
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(100, 0)})
+
+	// no matching pool
+	matched, err := dm.MatchDonation(ctx, "app", "user1", types.NewCoinFromInt64(10))
+	assert.Nil(t, err)
+	assert.True(t, matched.IsZero())
+
+	err = dm.OpenMatchingPool(
+		ctx, "app", types.NewCoinFromInt64(100), types.NewCoinFromInt64(60), 200)
+	assert.Nil(t, err)
+	err = dm.OpenMatchingPool(
+		ctx, "app", types.NewCoinFromInt64(100), types.NewCoinFromInt64(60), 200)
+	assert.Equal(t, ErrMatchingPoolAlreadyExist("app"), err)
+
+	testCases := []struct {
+		testName        string
+		username        types.AccountKey
+		donation        types.Coin
+		blockTime       int64
+		expectMatched   types.Coin
+		expectRemaining types.Coin
+	}{
+		{
+			testName:        "match full donation",
+			username:        "user1",
+			donation:        types.NewCoinFromInt64(40),
+			blockTime:       100,
+			expectMatched:   types.NewCoinFromInt64(40),
+			expectRemaining: types.NewCoinFromInt64(60),
+		},
+		{
+			testName:        "match up to per user cap",
+			username:        "user1",
+			donation:        types.NewCoinFromInt64(40),
+			blockTime:       100,
+			expectMatched:   types.NewCoinFromInt64(20),
+			expectRemaining: types.NewCoinFromInt64(40),
+		},
+		{
+			testName:        "user reaches cap",
+			username:        "user1",
+			donation:        types.NewCoinFromInt64(40),
+			blockTime:       100,
+			expectMatched:   types.NewCoinFromInt64(0),
+			expectRemaining: types.NewCoinFromInt64(40),
+		},
+		{
+			testName:        "match up to remaining budget",
+			username:        "user2",
+			donation:        types.NewCoinFromInt64(50),
+			blockTime:       150,
+			expectMatched:   types.NewCoinFromInt64(40),
+			expectRemaining: types.NewCoinFromInt64(0),
+		},
+		{
+			testName:        "budget is used up",
+			username:        "user3",
+			donation:        types.NewCoinFromInt64(50),
+			blockTime:       150,
+			expectMatched:   types.NewCoinFromInt64(0),
+			expectRemaining: types.NewCoinFromInt64(0),
+		},
+	}
+	for _, tc := range testCases {
+		ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(tc.blockTime, 0)})
+		matched, err := dm.MatchDonation(ctx, "app", tc.username, tc.donation)
+		assert.Nil(t, err)
+		if !tc.expectMatched.IsEqual(matched) {
+			t.Errorf("%s: diff matched, got %v, want %v", tc.testName, matched, tc.expectMatched)
+		}
+		pool, err := dm.GetMatchingPool(ctx, "app")
+		assert.Nil(t, err)
+		if !tc.expectRemaining.IsEqual(pool.Remaining) {
+			t.Errorf("%s: diff remaining, got %v, want %v", tc.testName, pool.Remaining, tc.expectRemaining)
+		}
+	}
+
+	// expired pool doesn't match
+	remaining, err := dm.CloseMatchingPool(ctx, "app")
+	assert.Nil(t, err)
+	assert.True(t, remaining.IsZero())
+	err = dm.OpenMatchingPool(
+		ctx, "app", types.NewCoinFromInt64(100), types.NewCoinFromInt64(60), 200)
+	assert.Nil(t, err)
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(200, 0)})
+	matched, err = dm.MatchDonation(ctx, "app", "user1", types.NewCoinFromInt64(10))
+	assert.Nil(t, err)
+	assert.True(t, matched.IsZero())
+	remaining, err = dm.CloseMatchingPool(ctx, "app")
+	assert.Nil(t, err)
+	assert.Equal(t, types.NewCoinFromInt64(100), remaining)
+}
@@ -14,6 +14,17 @@ type Developer struct {
 	AppMetaData    string           `json:"app_meta_data"`
 }
 
+// MatchingPool - budget locked by an app to match donations made through the app,
+// unused budget is refunded to the app when the pool expires
+type MatchingPool struct {
+	App        types.AccountKey `json:"app"`
+	Budget     types.Coin       `json:"budget"`
+	Remaining  types.Coin       `json:"remaining"`
+	PerUserCap types.Coin       `json:"per_user_cap"`
+	CreatedAt  int64            `json:"created_at"`
+	ExpiresAt  int64            `json:"expires_at"`
+}
+
 // DeveloperList - list of developers
 type DeveloperList struct {
 	AllDevelopers []types.AccountKey `json:"all_developers"`
@@ -18,6 +18,11 @@ func ErrDeveloperListNotFound() sdk.Error {
 	return types.NewError(types.CodeDeveloperListNotFound, fmt.Sprintf("developer list is not found"))
 }
 
+// ErrMatchingPoolNotFound - error if matching pool is not found in KVStore
+func ErrMatchingPoolNotFound(app types.AccountKey) sdk.Error {
+	return types.NewError(types.CodeMatchingPoolNotFound, fmt.Sprintf("matching pool of %v is not found", app))
+}
+
 // ErrFailedToMarshalDeveloper - error if marshal developer failed
 func ErrFailedToMarshalDeveloper(err error) sdk.Error {
 	return types.NewError(types.CodeFailedToMarshalDeveloper, fmt.Sprintf("failed to marshal developer: %s", err.Error()))
@@ -37,3 +42,23 @@ func ErrFailedToUnmarshalDeveloper(err error) sdk.Error {
 func ErrFailedToUnmarshalDeveloperList(err error) sdk.Error {
 	return types.NewError(types.CodeFailedToUnmarshalDeveloperList, fmt.Sprintf("failed to unmarshal developer list: %s", err.Error()))
 }
+
+// ErrFailedToMarshalMatchingPool - error if marshal matching pool failed
+func ErrFailedToMarshalMatchingPool(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalMatchingPool, fmt.Sprintf("failed to marshal matching pool: %s", err.Error()))
+}
+
+// ErrFailedToUnmarshalMatchingPool - error if unmarshal matching pool failed
+func ErrFailedToUnmarshalMatchingPool(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalMatchingPool, fmt.Sprintf("failed to unmarshal matching pool: %s", err.Error()))
+}
+
+// ErrFailedToMarshalMatchedAmount - error if marshal matched amount failed
+func ErrFailedToMarshalMatchedAmount(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalMatchedAmount, fmt.Sprintf("failed to marshal matched amount: %s", err.Error()))
+}
+
+// ErrFailedToUnmarshalMatchedAmount - error if unmarshal matched amount failed
+func ErrFailedToUnmarshalMatchedAmount(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalMatchedAmount, fmt.Sprintf("failed to unmarshal matched amount: %s", err.Error()))
+}
@@ -10,6 +10,8 @@ import (
 var (
 	developerSubstore     = []byte{0x00}
 	developerListSubstore = []byte{0x01}
+	matchingPoolSubstore  = []byte{0x02}
+	matchedAmountSubstore = []byte{0x03}
 )
 
 // DeveloperStorage - developer storage
@@ -101,6 +103,81 @@ func (ds DeveloperStorage) SetDeveloperList(ctx sdk.Context, lst *DeveloperList)
 	return nil
 }
 
+// DoesMatchingPoolExist - check if app has an open matching pool
+func (ds DeveloperStorage) DoesMatchingPoolExist(ctx sdk.Context, app types.AccountKey) bool {
+	store := ctx.KVStore(ds.key)
+	return store.Has(GetMatchingPoolKey(app))
+}
+
+// GetMatchingPool - get matching pool of app from KVStore
+func (ds DeveloperStorage) GetMatchingPool(
+	ctx sdk.Context, app types.AccountKey) (*MatchingPool, sdk.Error) {
+	store := ctx.KVStore(ds.key)
+	poolByte := store.Get(GetMatchingPoolKey(app))
+	if poolByte == nil {
+		return nil, ErrMatchingPoolNotFound(app)
+	}
+	pool := new(MatchingPool)
+	if err := ds.cdc.UnmarshalJSON(poolByte, pool); err != nil {
+		return nil, ErrFailedToUnmarshalMatchingPool(err)
+	}
+	return pool, nil
+}
+
+// SetMatchingPool - set matching pool of app to KVStore
+func (ds DeveloperStorage) SetMatchingPool(
+	ctx sdk.Context, app types.AccountKey, pool *MatchingPool) sdk.Error {
+	store := ctx.KVStore(ds.key)
+	poolByte, err := ds.cdc.MarshalJSON(*pool)
+	if err != nil {
+		return ErrFailedToMarshalMatchingPool(err)
+	}
+	store.Set(GetMatchingPoolKey(app), poolByte)
+	return nil
+}
+
+// DeleteMatchingPool - delete matching pool and all matched amount of app from KVStore
+func (ds DeveloperStorage) DeleteMatchingPool(ctx sdk.Context, app types.AccountKey) {
+	store := ctx.KVStore(ds.key)
+	store.Delete(GetMatchingPoolKey(app))
+	iter := sdk.KVStorePrefixIterator(store, getMatchedAmountPrefix(app))
+	keys := [][]byte{}
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// GetMatchedAmount - get amount matched for user by app's matching pool, zero if never matched
+func (ds DeveloperStorage) GetMatchedAmount(
+	ctx sdk.Context, app, username types.AccountKey) (types.Coin, sdk.Error) {
+	store := ctx.KVStore(ds.key)
+	amountByte := store.Get(GetMatchedAmountKey(app, username))
+	if amountByte == nil {
+		return types.NewCoinFromInt64(0), nil
+	}
+	amount := new(types.Coin)
+	if err := ds.cdc.UnmarshalJSON(amountByte, amount); err != nil {
+		return types.NewCoinFromInt64(0), ErrFailedToUnmarshalMatchedAmount(err)
+	}
+	return *amount, nil
+}
+
+// SetMatchedAmount - set amount matched for user by app's matching pool
+func (ds DeveloperStorage) SetMatchedAmount(
+	ctx sdk.Context, app, username types.AccountKey, amount types.Coin) sdk.Error {
+	store := ctx.KVStore(ds.key)
+	amountByte, err := ds.cdc.MarshalJSON(amount)
+	if err != nil {
+		return ErrFailedToMarshalMatchedAmount(err)
+	}
+	store.Set(GetMatchedAmountKey(app, username), amountByte)
+	return nil
+}
+
 // GetDeveloperKey - "developer substore" + "developer"
 func GetDeveloperKey(accKey types.AccountKey) []byte {
 	return append(developerSubstore, accKey...)
@@ -110,3 +187,17 @@ func GetDeveloperKey(accKey types.AccountKey) []byte {
 func GetDeveloperListKey() []byte {
 	return developerListSubstore
 }
+
+// GetMatchingPoolKey - "matching pool substore" + "app"
+func GetMatchingPoolKey(app types.AccountKey) []byte {
+	return append(matchingPoolSubstore, app...)
+}
+
+// GetMatchedAmountKey - "matched amount substore" + "app" + "/" + "username"
+func GetMatchedAmountKey(app, username types.AccountKey) []byte {
+	return append(getMatchedAmountPrefix(app), username...)
+}
+
+func getMatchedAmountPrefix(app types.AccountKey) []byte {
+	return append(append(matchedAmountSubstore, app...), types.KeySeparator...)
+}
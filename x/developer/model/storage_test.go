@@ -50,6 +50,50 @@ func TestDeveloperList(t *testing.T) {
 
 }
 
+func TestMatchingPool(t *testing.T) {
+	pool := MatchingPool{
+		App:        "app",
+		Budget:     types.NewCoinFromInt64(1000),
+		Remaining:  types.NewCoinFromInt64(1000),
+		PerUserCap: types.NewCoinFromInt64(100),
+		CreatedAt:  1,
+		ExpiresAt:  100,
+	}
+
+	runTest(t, func(env TestEnv) {
+		assert.False(t, env.ds.DoesMatchingPoolExist(env.ctx, pool.App))
+		_, err := env.ds.GetMatchingPool(env.ctx, pool.App)
+		assert.Equal(t, ErrMatchingPoolNotFound(pool.App), err)
+
+		err = env.ds.SetMatchingPool(env.ctx, pool.App, &pool)
+		assert.Nil(t, err)
+		resultPtr, err := env.ds.GetMatchingPool(env.ctx, pool.App)
+		assert.Nil(t, err)
+		assert.Equal(t, pool, *resultPtr, "matching pool should be equal")
+
+		amount, err := env.ds.GetMatchedAmount(env.ctx, pool.App, "user1")
+		assert.Nil(t, err)
+		assert.True(t, amount.IsZero())
+		err = env.ds.SetMatchedAmount(env.ctx, pool.App, "user1", types.NewCoinFromInt64(10))
+		assert.Nil(t, err)
+		err = env.ds.SetMatchedAmount(env.ctx, "app2", "user1", types.NewCoinFromInt64(20))
+		assert.Nil(t, err)
+		amount, err = env.ds.GetMatchedAmount(env.ctx, pool.App, "user1")
+		assert.Nil(t, err)
+		assert.Equal(t, types.NewCoinFromInt64(10), amount)
+
+		// delete pool also clears matched amount of this app only
+		env.ds.DeleteMatchingPool(env.ctx, pool.App)
+		assert.False(t, env.ds.DoesMatchingPoolExist(env.ctx, pool.App))
+		amount, err = env.ds.GetMatchedAmount(env.ctx, pool.App, "user1")
+		assert.Nil(t, err)
+		assert.True(t, amount.IsZero())
+		amount, err = env.ds.GetMatchedAmount(env.ctx, "app2", "user1")
+		assert.Nil(t, err)
+		assert.Equal(t, types.NewCoinFromInt64(20), amount)
+	})
+}
+
 //
 // Test Environment setup
 //
@@ -17,6 +17,7 @@ var _ types.Msg = DeveloperRevokeMsg{}
 var _ types.Msg = GrantPermissionMsg{}
 var _ types.Msg = RevokePermissionMsg{}
 var _ types.Msg = PreAuthorizationMsg{}
+var _ types.Msg = OpenMatchingPoolMsg{}
 
 // DeveloperRegisterMsg - register developer on blockchain
 type DeveloperRegisterMsg struct {
@@ -62,6 +63,14 @@ type PreAuthorizationMsg struct {
 	Amount            types.LNO        `json:"amount"`
 }
 
+// OpenMatchingPoolMsg - app locks budget to match donations made through the app
+type OpenMatchingPoolMsg struct {
+	Username    types.AccountKey `json:"username"`
+	Budget      types.LNO        `json:"budget"`
+	PerUserCap  types.LNO        `json:"per_user_cap"`
+	DurationSec int64            `json:"duration_second"`
+}
+
 // DeveloperRegisterMsg Msg Implementations
 func NewDeveloperRegisterMsg(developer string, deposit types.LNO, website string, description string, appMetaData string) DeveloperRegisterMsg {
 	return DeveloperRegisterMsg{
@@ -412,3 +421,63 @@ func (msg PreAuthorizationMsg) GetSigners() []sdk.AccAddress {
 func (msg PreAuthorizationMsg) GetConsumeAmount() types.Coin {
 	return types.NewCoinFromInt64(0)
 }
+
+// NewOpenMatchingPoolMsg - new OpenMatchingPoolMsg
+func NewOpenMatchingPoolMsg(
+	app string, budget types.LNO, perUserCap types.LNO, durationSec int64) OpenMatchingPoolMsg {
+	return OpenMatchingPoolMsg{
+		Username:    types.AccountKey(app),
+		Budget:      budget,
+		PerUserCap:  perUserCap,
+		DurationSec: durationSec,
+	}
+}
+
+// Type - implements sdk.Msg
+func (msg OpenMatchingPoolMsg) Type() string { return types.DeveloperRouterName }
+
+// ValidateBasic - implements sdk.Msg
+func (msg OpenMatchingPoolMsg) ValidateBasic() sdk.Error {
+	if len(msg.Username) < types.MinimumUsernameLength ||
+		len(msg.Username) > types.MaximumUsernameLength {
+		return ErrInvalidUsername()
+	}
+	if _, err := types.LinoToCoin(msg.Budget); err != nil {
+		return err
+	}
+	if _, err := types.LinoToCoin(msg.PerUserCap); err != nil {
+		return err
+	}
+	if msg.DurationSec <= 0 {
+		return ErrInvalidMatchingPool("duration must be positive")
+	}
+	return nil
+}
+
+func (msg OpenMatchingPoolMsg) String() string {
+	return fmt.Sprintf("OpenMatchingPoolMsg{App:%v, Budget:%v, PerUserCap:%v, DurationSec:%v}",
+		msg.Username, msg.Budget, msg.PerUserCap, msg.DurationSec)
+}
+
+func (msg OpenMatchingPoolMsg) GetPermission() types.Permission {
+	return types.TransactionPermission
+}
+
+// GetSignBytes - implements sdk.Msg
+func (msg OpenMatchingPoolMsg) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg) // XXX: ensure some canonical form
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners - implements sdk.Msg
+func (msg OpenMatchingPoolMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Username)}
+}
+
+// GetConsumeAmount - implements types.Msg
+func (msg OpenMatchingPoolMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
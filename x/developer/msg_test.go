@@ -349,6 +349,57 @@ func TestPreAuthorizationMsgMsg(t *testing.T) {
 	}
 }
 
+func TestOpenMatchingPoolMsg(t *testing.T) {
+	testCases := []struct {
+		testName            string
+		openMatchingPoolMsg OpenMatchingPoolMsg
+		expectError         sdk.Error
+	}{
+		{
+			testName:            "normal open matching pool",
+			openMatchingPoolMsg: NewOpenMatchingPoolMsg("app", "100", "1", 3600),
+			expectError:         nil,
+		},
+		{
+			testName:            "app name is too short",
+			openMatchingPoolMsg: NewOpenMatchingPoolMsg("ap", "100", "1", 3600),
+			expectError:         ErrInvalidUsername(),
+		},
+		{
+			testName:            "illegal budget",
+			openMatchingPoolMsg: NewOpenMatchingPoolMsg("app", "*", "1", 3600),
+			expectError:         types.ErrInvalidCoins("Illegal LNO"),
+		},
+		{
+			testName:            "illegal per user cap",
+			openMatchingPoolMsg: NewOpenMatchingPoolMsg("app", "100", "*", 3600),
+			expectError:         types.ErrInvalidCoins("Illegal LNO"),
+		},
+		{
+			testName:            "zero budget",
+			openMatchingPoolMsg: NewOpenMatchingPoolMsg("app", "0", "1", 3600),
+			expectError:         types.ErrInvalidCoins("LNO can't be less than lower bound"),
+		},
+		{
+			testName:            "zero per user cap",
+			openMatchingPoolMsg: NewOpenMatchingPoolMsg("app", "100", "0", 3600),
+			expectError:         types.ErrInvalidCoins("LNO can't be less than lower bound"),
+		},
+		{
+			testName:            "invalid duration",
+			openMatchingPoolMsg: NewOpenMatchingPoolMsg("app", "100", "1", 0),
+			expectError:         ErrInvalidMatchingPool("duration must be positive"),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := tc.openMatchingPoolMsg.ValidateBasic()
+		if !assert.Equal(t, result, tc.expectError) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectError)
+		}
+	}
+}
+
 func TestMsgPermission(t *testing.T) {
 	testCases := []struct {
 		testName         string
@@ -385,6 +436,11 @@ func TestMsgPermission(t *testing.T) {
 			msg:              NewPreAuthorizationMsg("test", "app", 1000, "1"),
 			expectPermission: types.TransactionPermission,
 		},
+		{
+			testName:         "open matching pool msg",
+			msg:              NewOpenMatchingPoolMsg("app", "100", "1", 3600),
+			expectPermission: types.TransactionPermission,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -433,6 +489,11 @@ func TestGetSigners(t *testing.T) {
 			msg:           NewPreAuthorizationMsg("test", "app", 1000, "1"),
 			expectSigners: []types.AccountKey{"test"},
 		},
+		{
+			testName:      "open matching pool msg",
+			msg:           NewOpenMatchingPoolMsg("app", "100", "1", 3600),
+			expectSigners: []types.AccountKey{"app"},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -478,6 +539,10 @@ func TestGetSignBytes(t *testing.T) {
 			testName: "preauth msg",
 			msg:      NewPreAuthorizationMsg("test", "app", 1000, "1"),
 		},
+		{
+			testName: "open matching pool msg",
+			msg:      NewOpenMatchingPoolMsg("app", "100", "1", 3600),
+		},
 	}
 
 	for _, tc := range testCases {
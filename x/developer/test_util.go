@@ -42,6 +42,7 @@ func setupTest(t *testing.T, height int64) (
 	assert.Nil(t, err)
 	cdc.RegisterInterface((*types.Event)(nil), nil)
 	cdc.RegisterConcrete(acc.ReturnCoinEvent{}, "event/return", nil)
+	cdc.RegisterConcrete(MatchingPoolExpireEvent{}, "event/matchingPoolExpire", nil)
 	return ctx, am, dm, gm
 }
 
@@ -12,6 +12,7 @@ func RegisterWire(cdc *wire.Codec) {
 	cdc.RegisterConcrete(GrantPermissionMsg{}, "lino/grantPermission", nil)
 	cdc.RegisterConcrete(RevokePermissionMsg{}, "lino/revokePermission", nil)
 	cdc.RegisterConcrete(PreAuthorizationMsg{}, "lino/preAuthorizationPermission", nil)
+	cdc.RegisterConcrete(OpenMatchingPoolMsg{}, "lino/openMatchingPool", nil)
 }
 
 var msgCdc = wire.NewCodec()
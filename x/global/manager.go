@@ -147,22 +147,22 @@ func (gm GlobalManager) GetConsumption(ctx sdk.Context) (types.Coin, sdk.Error)
 
 // AddFrictionAndRegisterContentRewardEvent - register reward calculation event at 7 days later
 func (gm GlobalManager) AddFrictionAndRegisterContentRewardEvent(
-	ctx sdk.Context, event types.Event, friction types.Coin, evaluate types.Coin) sdk.Error {
+	ctx sdk.Context, event types.Event, friction types.Coin, evaluate types.Coin) (int64, sdk.Error) {
 	consumptionMeta, err := gm.storage.GetConsumptionMeta(ctx)
 	if err != nil {
-		return err
+		return 0, err
 	}
 	consumptionMeta.ConsumptionRewardPool = consumptionMeta.ConsumptionRewardPool.Plus(friction)
 	consumptionMeta.ConsumptionWindow = consumptionMeta.ConsumptionWindow.Plus(evaluate)
 
-	if err := gm.registerEventAtTime(
-		ctx, ctx.BlockHeader().Time.Unix()+consumptionMeta.ConsumptionFreezingPeriodSec, event); err != nil {
-		return err
+	rewardAt := ctx.BlockHeader().Time.Unix() + consumptionMeta.ConsumptionFreezingPeriodSec
+	if err := gm.registerEventAtTime(ctx, rewardAt, event); err != nil {
+		return 0, err
 	}
 	if err := gm.storage.SetConsumptionMeta(ctx, consumptionMeta); err != nil {
-		return err
+		return 0, err
 	}
-	return nil
+	return rewardAt, nil
 }
 
 // RegisterCoinReturnEvent - register coin return event with time interval
@@ -177,6 +177,12 @@ func (gm GlobalManager) RegisterCoinReturnEvent(
 	return nil
 }
 
+// RegisterUnbondingReturnEvent - register event returning a piece of validator unbonding deposit
+func (gm GlobalManager) RegisterUnbondingReturnEvent(
+	ctx sdk.Context, returnAt int64, event types.Event) sdk.Error {
+	return gm.registerEventAtTime(ctx, returnAt, event)
+}
+
 // RegisterProposalDecideEvent - register proposal decide event
 func (gm GlobalManager) RegisterProposalDecideEvent(
 	ctx sdk.Context, decideSec int64, event types.Event) sdk.Error {
@@ -187,6 +193,26 @@ func (gm GlobalManager) RegisterProposalDecideEvent(
 	return nil
 }
 
+// RegisterMatchingPoolExpireEvent - register matching pool expire event
+func (gm GlobalManager) RegisterMatchingPoolExpireEvent(
+	ctx sdk.Context, expireSec int64, event types.Event) sdk.Error {
+	if err := gm.registerEventAtTime(
+		ctx, ctx.BlockHeader().Time.Unix()+expireSec, event); err != nil {
+		return err
+	}
+	return nil
+}
+
+// RegisterRedelegationCompleteEvent - register redelegation complete event
+func (gm GlobalManager) RegisterRedelegationCompleteEvent(
+	ctx sdk.Context, cooldownSec int64, event types.Event) sdk.Error {
+	if err := gm.registerEventAtTime(
+		ctx, ctx.BlockHeader().Time.Unix()+cooldownSec, event); err != nil {
+		return err
+	}
+	return nil
+}
+
 // RegisterParamChangeEvent - register parameter change event
 func (gm GlobalManager) RegisterParamChangeEvent(ctx sdk.Context, event types.Event) sdk.Error {
 	// param will be changed in one day
@@ -297,14 +323,7 @@ func (gm GlobalManager) GetRewardAndPopFromWindow(
 	if err != nil {
 		return types.NewCoinFromInt64(0), err
 	}
-
-	// consumptionRatio = (this consumption * penalty score) / (total consumption in 7 days window)
-	consumptionRatio :=
-		evaluate.ToRat().Mul(sdk.OneRat().Sub(penaltyScore)).Quo(
-			consumptionMeta.ConsumptionWindow.ToRat()).Round(types.PrecisionFactor)
-	// reward = (consumption reward pool) * (consumptionRatio)
-	reward := types.RatToCoin(
-		consumptionMeta.ConsumptionRewardPool.ToRat().Mul(consumptionRatio))
+	reward := calculateConsumptionReward(evaluate, penaltyScore, *consumptionMeta)
 	consumptionMeta.ConsumptionRewardPool = consumptionMeta.ConsumptionRewardPool.Minus(reward)
 	consumptionMeta.ConsumptionWindow = consumptionMeta.ConsumptionWindow.Minus(evaluate)
 	if err := gm.addTotalLinoCoin(ctx, reward); err != nil {
@@ -316,6 +335,48 @@ func (gm GlobalManager) GetRewardAndPopFromWindow(
 	return reward, nil
 }
 
+// GetRewardFromWindow - get reward a consumption could claim from consumption reward
+// pool now, consumption meta is not changed
+func (gm GlobalManager) GetRewardFromWindow(
+	ctx sdk.Context, evaluate types.Coin, penaltyScore sdk.Rat) (types.Coin, sdk.Error) {
+	consumptionMeta, err := gm.storage.GetConsumptionMeta(ctx)
+	if err != nil {
+		return types.NewCoinFromInt64(0), err
+	}
+	return calculateConsumptionReward(evaluate, penaltyScore, *consumptionMeta), nil
+}
+
+// GetRewardOfNewConsumption - get reward a consumption not yet added to consumption
+// window could claim from consumption reward pool now, and when its reward event
+// would be executed, consumption meta is not changed
+func (gm GlobalManager) GetRewardOfNewConsumption(
+	ctx sdk.Context, friction, evaluate types.Coin, penaltyScore sdk.Rat) (types.Coin, int64, sdk.Error) {
+	consumptionMeta, err := gm.storage.GetConsumptionMeta(ctx)
+	if err != nil {
+		return types.NewCoinFromInt64(0), 0, err
+	}
+	consumptionMeta.ConsumptionRewardPool = consumptionMeta.ConsumptionRewardPool.Plus(friction)
+	consumptionMeta.ConsumptionWindow = consumptionMeta.ConsumptionWindow.Plus(evaluate)
+	rewardAt := ctx.BlockHeader().Time.Unix() + consumptionMeta.ConsumptionFreezingPeriodSec
+	return calculateConsumptionReward(evaluate, penaltyScore, *consumptionMeta), rewardAt, nil
+}
+
+// calculateConsumptionReward - calculate reward of a consumption from consumption window
+// and consumption reward pool
+func calculateConsumptionReward(
+	evaluate types.Coin, penaltyScore sdk.Rat, consumptionMeta model.ConsumptionMeta) types.Coin {
+	if evaluate.IsZero() || consumptionMeta.ConsumptionWindow.IsZero() {
+		return types.NewCoinFromInt64(0)
+	}
+	// consumptionRatio = (this consumption * penalty score) / (total consumption in 7 days window)
+	consumptionRatio :=
+		evaluate.ToRat().Mul(sdk.OneRat().Sub(penaltyScore)).Quo(
+			consumptionMeta.ConsumptionWindow.ToRat()).Round(types.PrecisionFactor)
+	// reward = (consumption reward pool) * (consumptionRatio)
+	return types.RatToCoin(
+		consumptionMeta.ConsumptionRewardPool.ToRat().Mul(consumptionRatio))
+}
+
 // AddConsumption - add consumption to global meta, which is used to compute GDP
 func (gm GlobalManager) AddConsumption(ctx sdk.Context, coin types.Coin) sdk.Error {
 	globalMeta, err := gm.storage.GetGlobalMeta(ctx)
@@ -466,14 +527,23 @@ func (gm GlobalManager) EvaluateConsumption(
 	if err != nil {
 		return types.NewCoinFromInt64(0), err
 	}
+	return calculateEvaluate(
+		coin, numOfConsumptionOnAuthor, ctx.BlockHeader().Time.Unix()-created, totalReward, paras), nil
+}
+
+// calculateEvaluate - calculate evaluate result of a consumption to a post
+// which was created pastSec seconds ago
+func calculateEvaluate(
+	coin types.Coin, numOfConsumptionOnAuthor int64, pastSec int64,
+	totalReward types.Coin, paras *param.EvaluateOfContentValueParam) types.Coin {
 	// evaluate result coin^0.8 * total consumption adjustment *
 	// post time adjustment * consumption times adjustment
 	expPara, _ := paras.AmountOfConsumptionExponent.Float64()
 	return types.NewCoinFromInt64(
 		int64(math.Pow(float64(coin.ToInt64()), expPara) *
 			PostTotalConsumptionAdjustment(totalReward, paras) *
-			PostTimeAdjustment(ctx.BlockHeader().Time.Unix()-created, paras) *
-			PostConsumptionTimesAdjustment(numOfConsumptionOnAuthor, paras))), nil
+			PostTimeAdjustment(pastSec, paras) *
+			PostConsumptionTimesAdjustment(numOfConsumptionOnAuthor, paras)))
 }
 
 // get and set params
@@ -338,11 +338,12 @@ func TestAddFrictionAndRegisterContentRewardEvent(t *testing.T) {
 
 	for _, tc := range testCases {
 		ctx = ctx.WithBlockHeader(abci.Header{ChainID: "Lino", Time: time.Unix(tc.registerBaseTime, 0)})
-		err := gm.AddFrictionAndRegisterContentRewardEvent(
+		rewardAt, err := gm.AddFrictionAndRegisterContentRewardEvent(
 			ctx, testEvent{}, tc.frictionCoin, tc.evaluateCoin)
 		if err != nil {
 			t.Errorf("%s: failed to add friction and register event, got err %v", tc.testName, err)
 		}
+		assert.Equal(t, tc.registerBaseTime+24*7*3600, rewardAt)
 
 		consumptionMeta, err := gm.storage.GetConsumptionMeta(ctx)
 		if err != nil {
@@ -491,6 +492,17 @@ func TestGetRewardAndPopFromWindow(t *testing.T) {
 			return
 		}
 
+		// estimate doesn't change consumption meta
+		estimate, err := gm.GetRewardFromWindow(ctx, tc.evaluate, tc.penaltyScore)
+		if err != nil {
+			t.Errorf("%s: failed to get reward from window, got err %v", tc.testName, err)
+			return
+		}
+		if !estimate.IsEqual(tc.expectReward) {
+			t.Errorf("%s: diff estimate reward, got %v, want %v", tc.testName, estimate, tc.expectReward)
+			return
+		}
+
 		reward, err := gm.GetRewardAndPopFromWindow(ctx, tc.evaluate, tc.penaltyScore)
 		if err != nil {
 			t.Errorf("%s: failed to get reward and pop from window, got err %v", tc.testName, err)
@@ -530,6 +542,28 @@ func TestGetRewardAndPopFromWindow(t *testing.T) {
 	}
 }
 
+func TestGetRewardOfNewConsumption(t *testing.T) {
+	ctx, gm := setupTest(t)
+	consumptionMeta, err := gm.storage.GetConsumptionMeta(ctx)
+	assert.Nil(t, err)
+	consumptionMeta.ConsumptionRewardPool = types.NewCoinFromInt64(1000)
+	consumptionMeta.ConsumptionWindow = types.NewCoinFromInt64(10)
+	err = gm.storage.SetConsumptionMeta(ctx, consumptionMeta)
+	assert.Nil(t, err)
+
+	// friction and evaluate of the new consumption are added to pool and window
+	reward, rewardAt, err := gm.GetRewardOfNewConsumption(
+		ctx, types.NewCoinFromInt64(10), types.NewCoinFromInt64(10), sdk.ZeroRat())
+	assert.Nil(t, err)
+	assert.True(t, reward.IsEqual(types.NewCoinFromInt64(505)))
+	assert.Equal(t, ctx.BlockHeader().Time.Unix()+consumptionMeta.ConsumptionFreezingPeriodSec, rewardAt)
+
+	// consumption meta is not changed
+	newConsumptionMeta, err := gm.storage.GetConsumptionMeta(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, consumptionMeta, newConsumptionMeta)
+}
+
 func TestTimeEventList(t *testing.T) {
 	ctx, gm := setupTest(t)
 	baseTime := ctx.BlockHeader().Time.Unix()
@@ -0,0 +1,48 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/wire"
+	"github.com/lino-network/lino/client"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	post "github.com/lino-network/lino/x/post"
+)
+
+// HideCommentTxCmd will create a hide comment tx and sign it with the given key
+func HideCommentTxCmd(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "hide-comment",
+		Short: "hide or unhide a comment on your post",
+		RunE:  sendHideCommentTx(cdc),
+	}
+	cmd.Flags().String(client.FlagUser, "", "author of the post being commented")
+	cmd.Flags().String(client.FlagCommentAuthor, "", "author of the comment")
+	cmd.Flags().String(client.FlagCommentPostID, "", "post id of the comment")
+	cmd.Flags().Bool(client.FlagIsHidden, true, "false if this is unhide")
+	return cmd
+}
+
+// send hide comment transaction to the blockchain
+func sendHideCommentTx(cdc *wire.Codec) client.CommandTxCallback {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := client.NewCoreContextFromViper()
+		username := viper.GetString(client.FlagUser)
+		commentAuthor := viper.GetString(client.FlagCommentAuthor)
+		commentPostID := viper.GetString(client.FlagCommentPostID)
+		isHidden := viper.GetBool(client.FlagIsHidden)
+		msg := post.NewHideCommentMsg(username, commentAuthor, commentPostID, isHidden)
+
+		// build and sign the transaction, then broadcast to Tendermint
+		res, signErr := ctx.SignBuildBroadcast([]sdk.Msg{msg}, cdc)
+		if signErr != nil {
+			return signErr
+		}
+
+		fmt.Printf("Committed at block %d. Hash: %s\n", res.Height, res.Hash.String())
+		return nil
+	}
+}
@@ -1,7 +1,9 @@
 package commands
 
 import (
+	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/wire"
 	"github.com/lino-network/lino/client"
@@ -29,6 +31,11 @@ func PostTxCmd(cdc *wire.Codec) *cobra.Command {
 	cmd.Flags().String(client.FlagSourceAuthor, "", "source post author name")
 	cmd.Flags().String(client.FlagSourcePostID, "", "source post id")
 	cmd.Flags().String(client.FlagRedistributionSplitRate, "0", "redistribution split rate")
+	cmd.Flags().StringSlice(client.FlagTags, nil, "comma separated tags of the post")
+	cmd.Flags().Int(client.FlagAccessMode, 0, "access mode of the post, 0: public, 1: unlock payment, 2: subscriber only")
+	cmd.Flags().String(client.FlagUnlockPrice, "", "price to unlock the post, only for access mode 1")
+	cmd.Flags().StringSlice(client.FlagCoAuthors, nil, "comma separated co-authors with revenue weight, e.g. alice:0.6,bob:0.4")
+	cmd.Flags().Int(client.FlagReplyMode, 0, "who can reply to the post, 0: everyone, 1: followers only, 2: nobody")
 	return cmd
 }
 
@@ -37,6 +44,17 @@ func sendPostTx(cdc *wire.Codec) client.CommandTxCallback {
 	return func(cmd *cobra.Command, args []string) error {
 		ctx := client.NewCoreContextFromViper()
 		author := viper.GetString(client.FlagAuthor)
+		coAuthors := []post.CoAuthorWeight{}
+		for _, coAuthor := range viper.GetStringSlice(client.FlagCoAuthors) {
+			pair := strings.Split(coAuthor, ":")
+			if len(pair) != 2 {
+				return errors.New("co-author must be in format username:weight")
+			}
+			coAuthors = append(coAuthors, post.CoAuthorWeight{
+				Username: types.AccountKey(pair[0]),
+				Weight:   pair[1],
+			})
+		}
 		msg := post.CreatePostMsg{
 			Author:                  types.AccountKey(author),
 			PostID:                  viper.GetString(client.FlagPostID),
@@ -47,6 +65,11 @@ func sendPostTx(cdc *wire.Codec) client.CommandTxCallback {
 			SourceAuthor:            types.AccountKey(viper.GetString(client.FlagSourceAuthor)),
 			SourcePostID:            viper.GetString(client.FlagSourcePostID),
 			RedistributionSplitRate: viper.GetString(client.FlagRedistributionSplitRate),
+			Tags:                    post.NormalizeTags(viper.GetStringSlice(client.FlagTags)),
+			AccessMode:              types.PostAccessMode(viper.GetInt(client.FlagAccessMode)),
+			UnlockPrice:             types.LNO(viper.GetString(client.FlagUnlockPrice)),
+			CoAuthors:               coAuthors,
+			ReplyMode:               types.ReplyMode(viper.GetInt(client.FlagReplyMode)),
 		}
 
 		// build and sign the transaction, then broadcast to Tendermint
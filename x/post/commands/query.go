@@ -1,13 +1,18 @@
 package commands
 
 import (
+	"sort"
+
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/wire"
 	"github.com/lino-network/lino/client"
 	"github.com/lino-network/lino/types"
 	"github.com/lino-network/lino/x/post/model"
+
+	post "github.com/lino-network/lino/x/post"
 )
 
 // GetPostCmd returns a query post that will display the
@@ -24,6 +29,129 @@ func GetPostCmd(storeName string, cdc *wire.Codec) *cobra.Command {
 	}
 }
 
+// GetPostsByTagCmd returns a query that will display the
+// most recent posts under a given tag
+func GetPostsByTagCmd(storeName string, cdc *wire.Codec) *cobra.Command {
+	cmdr := commander{
+		storeName,
+		cdc,
+	}
+	cmd := &cobra.Command{
+		Use:   "tag <tag>",
+		Short: "Query recent posts under a tag",
+		RunE:  cmdr.getPostsByTagCmd,
+	}
+	cmd.Flags().Int(client.FlagLimit, 20, "maximum number of posts to display")
+	return cmd
+}
+
+// GetPostAccessCmd returns a query that will display whether
+// a user can access the full content of a post
+func GetPostAccessCmd(storeName string, cdc *wire.Codec) *cobra.Command {
+	cmdr := commander{
+		storeName,
+		cdc,
+	}
+	return &cobra.Command{
+		Use:   "access <user> <author> <postID>",
+		Short: "Query if a user can access the full content of a post",
+		RunE:  cmdr.getPostAccessCmd,
+	}
+}
+
+// GetPostReportsCmd returns a query that will display
+// all reports with reason and evidence of a post
+func GetPostReportsCmd(storeName string, cdc *wire.Codec) *cobra.Command {
+	cmdr := commander{
+		storeName,
+		cdc,
+	}
+	return &cobra.Command{
+		Use:   "reports <author> <postID>",
+		Short: "Query reports of a post",
+		RunE:  cmdr.getPostReportsCmd,
+	}
+}
+
+// GetPostCommentsCmd returns a query that will display comments of a post,
+// comments hidden by the post author are omitted unless requested
+func GetPostCommentsCmd(storeName string, cdc *wire.Codec) *cobra.Command {
+	cmdr := commander{
+		storeName,
+		cdc,
+	}
+	cmd := &cobra.Command{
+		Use:   "comments <author> <postID>",
+		Short: "Query comments of a post",
+		RunE:  cmdr.getPostCommentsCmd,
+	}
+	cmd.Flags().Bool(client.FlagIncludeHidden, false, "include comments hidden by the post author")
+	return cmd
+}
+
+// GetPostPenaltyScoreCmd returns a query that will display the cached
+// penalty score of a post and the score recomputed on demand
+func GetPostPenaltyScoreCmd(storeName string, cdc *wire.Codec) *cobra.Command {
+	cmdr := commander{
+		storeName,
+		cdc,
+	}
+	return &cobra.Command{
+		Use:   "penalty-score <author> <postID>",
+		Short: "Query and recompute penalty score of a post",
+		RunE:  cmdr.getPostPenaltyScoreCmd,
+	}
+}
+
+// GetPostDonorsCmd returns a query that will display donors
+// of a post sorted by donation amount or times
+func GetPostDonorsCmd(storeName string, cdc *wire.Codec) *cobra.Command {
+	cmdr := commander{
+		storeName,
+		cdc,
+	}
+	cmd := &cobra.Command{
+		Use:   "donors <author> <postID>",
+		Short: "Query donors of a post",
+		RunE:  cmdr.getPostDonorsCmd,
+	}
+	cmd.Flags().String(client.FlagSortBy, "amount", "sort donors by amount or count")
+	cmd.Flags().Int(client.FlagOffset, 0, "number of donors to skip")
+	cmd.Flags().Int(client.FlagLimit, 20, "maximum number of donors to display")
+	return cmd
+}
+
+// GetPostRoyaltiesCmd returns a query of royalties a post received from its reposts
+func GetPostRoyaltiesCmd(storeName string, cdc *wire.Codec) *cobra.Command {
+	cmdr := commander{
+		storeName,
+		cdc,
+	}
+	return &cobra.Command{
+		Use:   "royalties <author> <postID>",
+		Short: "Query repost royalties received by a post",
+		RunE:  cmdr.getPostRoyaltiesCmd,
+	}
+}
+
+// GetRewardEstimateCmd returns a query of expected inflation reward of a post,
+// for a hypothetical donation or for pending donations of the post
+func GetRewardEstimateCmd(storeName string, cdc *wire.Codec) *cobra.Command {
+	cmdr := commander{
+		storeName,
+		cdc,
+	}
+	cmd := &cobra.Command{
+		Use:   "reward-estimate <author> <postID>",
+		Short: "Estimate inflation reward of a donation or pending donations to a post",
+		RunE:  cmdr.getRewardEstimateCmd,
+	}
+	cmd.Flags().String(client.FlagDonator, "", "donator of the hypothetical donation")
+	cmd.Flags().String(client.FlagAmount, "",
+		"amount of the hypothetical donation, pending donations are estimated if not set")
+	return cmd
+}
+
 type commander struct {
 	storeName string
 	cdc       *wire.Codec
@@ -64,3 +192,276 @@ func (c commander) getPostCmd(cmd *cobra.Command, args []string) error {
 
 	return nil
 }
+
+func (c commander) getPostsByTagCmd(cmd *cobra.Command, args []string) error {
+	ctx := client.NewCoreContextFromViper()
+	if len(args) != 1 || len(args[0]) == 0 {
+		return errors.New("You must provide a tag")
+	}
+	tags := post.NormalizeTags(args)
+	if len(tags) != 1 {
+		return errors.New("You must provide a valid tag")
+	}
+
+	params, err := c.cdc.MarshalJSON(post.QueryPostsByTagParams{
+		Tag:   tags[0],
+		Limit: viper.GetInt(client.FlagLimit),
+	})
+	if err != nil {
+		return err
+	}
+	res, err := ctx.QueryCustom(types.PostRouterName, post.QueryPostsByTag, params)
+	if err != nil {
+		return err
+	}
+	permlinks := []types.Permlink{}
+	if err := c.cdc.UnmarshalJSON(res, &permlinks); err != nil {
+		return err
+	}
+
+	if err := client.PrintIndent(permlinks); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c commander) getPostReportsCmd(cmd *cobra.Command, args []string) error {
+	ctx := client.NewCoreContextFromViper()
+	if len(args) != 2 || len(args[0]) == 0 || len(args[1]) == 0 {
+		return errors.New("You must provide an valid author and post id")
+	}
+
+	postKey := types.GetPermlink(types.AccountKey(args[0]), args[1])
+	resKVs, err := ctx.QuerySubspace(c.cdc, model.GetPostReportOrUpvotePrefix(postKey), c.storeName)
+	if err != nil {
+		return err
+	}
+	reports := []model.ReportOrUpvote{}
+	for _, KV := range resKVs {
+		var reportOrUpvote model.ReportOrUpvote
+		if err := c.cdc.UnmarshalJSON(KV.Value, &reportOrUpvote); err != nil {
+			return err
+		}
+		if reportOrUpvote.IsReport {
+			reports = append(reports, reportOrUpvote)
+		}
+	}
+
+	if err := client.PrintIndent(reports); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c commander) getPostDonorsCmd(cmd *cobra.Command, args []string) error {
+	ctx := client.NewCoreContextFromViper()
+	if len(args) != 2 || len(args[0]) == 0 || len(args[1]) == 0 {
+		return errors.New("You must provide an valid author and post id")
+	}
+	sortBy := viper.GetString(client.FlagSortBy)
+	if sortBy != "amount" && sortBy != "count" {
+		return errors.New("sort-by must be amount or count")
+	}
+
+	postKey := types.GetPermlink(types.AccountKey(args[0]), args[1])
+	resKVs, err := ctx.QuerySubspace(c.cdc, model.GetPostDonationsPrefix(postKey), c.storeName)
+	if err != nil {
+		return err
+	}
+	donors := []model.Donations{}
+	for _, KV := range resKVs {
+		var donations model.Donations
+		if err := c.cdc.UnmarshalJSON(KV.Value, &donations); err != nil {
+			return err
+		}
+		donors = append(donors, donations)
+	}
+	// sort by the given key first, tie is broken by the other key and then by username
+	sort.SliceStable(donors, func(i, j int) bool {
+		if !donors[i].Amount.IsEqual(donors[j].Amount) && sortBy == "amount" {
+			return donors[i].Amount.IsGT(donors[j].Amount)
+		}
+		if donors[i].Times != donors[j].Times {
+			return donors[i].Times > donors[j].Times
+		}
+		if !donors[i].Amount.IsEqual(donors[j].Amount) {
+			return donors[i].Amount.IsGT(donors[j].Amount)
+		}
+		return donors[i].Username < donors[j].Username
+	})
+
+	offset := viper.GetInt(client.FlagOffset)
+	limit := viper.GetInt(client.FlagLimit)
+	if offset < 0 || limit < 0 {
+		return errors.New("offset and limit can't be negative")
+	}
+	if offset > len(donors) {
+		offset = len(donors)
+	}
+	if offset+limit < len(donors) {
+		donors = donors[offset : offset+limit]
+	} else {
+		donors = donors[offset:]
+	}
+
+	if err := client.PrintIndent(donors); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c commander) getPostRoyaltiesCmd(cmd *cobra.Command, args []string) error {
+	ctx := client.NewCoreContextFromViper()
+	if len(args) != 2 || len(args[0]) == 0 || len(args[1]) == 0 {
+		return errors.New("You must provide an valid author and post id")
+	}
+
+	postKey := types.GetPermlink(types.AccountKey(args[0]), args[1])
+	resKVs, err := ctx.QuerySubspace(c.cdc, model.GetPostRepostRoyaltyPrefix(postKey), c.storeName)
+	if err != nil {
+		return err
+	}
+	royalties := []model.RepostRoyalty{}
+	for _, KV := range resKVs {
+		var royalty model.RepostRoyalty
+		if err := c.cdc.UnmarshalJSON(KV.Value, &royalty); err != nil {
+			return err
+		}
+		royalties = append(royalties, royalty)
+	}
+
+	if err := client.PrintIndent(royalties); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c commander) getPostCommentsCmd(cmd *cobra.Command, args []string) error {
+	ctx := client.NewCoreContextFromViper()
+	if len(args) != 2 || len(args[0]) == 0 || len(args[1]) == 0 {
+		return errors.New("You must provide an valid author and post id")
+	}
+	data, err := c.cdc.MarshalJSON(post.QueryPostCommentsParams{
+		Author:        types.AccountKey(args[0]),
+		PostID:        args[1],
+		IncludeHidden: viper.GetBool(client.FlagIncludeHidden),
+	})
+	if err != nil {
+		return err
+	}
+	res, err := ctx.QueryCustom(types.PostRouterName, post.QueryPostComments, data)
+	if err != nil {
+		return err
+	}
+	comments := []model.Comment{}
+	if err := c.cdc.UnmarshalJSON(res, &comments); err != nil {
+		return err
+	}
+
+	if err := client.PrintIndent(comments); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c commander) getPostPenaltyScoreCmd(cmd *cobra.Command, args []string) error {
+	ctx := client.NewCoreContextFromViper()
+	if len(args) != 2 || len(args[0]) == 0 || len(args[1]) == 0 {
+		return errors.New("You must provide an valid author and post id")
+	}
+
+	data, err := c.cdc.MarshalJSON(post.QueryPenaltyScoreParams{
+		Author: types.AccountKey(args[0]),
+		PostID: args[1],
+	})
+	if err != nil {
+		return err
+	}
+	res, err := ctx.QueryCustom(types.PostRouterName, post.QueryPenaltyScore, data)
+	if err != nil {
+		return err
+	}
+	result := new(model.PenaltyScoreAudit)
+	if err := c.cdc.UnmarshalJSON(res, result); err != nil {
+		return err
+	}
+
+	if err := client.PrintIndent(result); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c commander) getRewardEstimateCmd(cmd *cobra.Command, args []string) error {
+	ctx := client.NewCoreContextFromViper()
+	if len(args) != 2 || len(args[0]) == 0 || len(args[1]) == 0 {
+		return errors.New("You must provide an valid author and post id")
+	}
+	params := post.QueryRewardEstimateParams{
+		Author:  types.AccountKey(args[0]),
+		PostID:  args[1],
+		Donator: types.AccountKey(viper.GetString(client.FlagDonator)),
+		Amount:  viper.GetString(client.FlagAmount),
+	}
+	data, err := c.cdc.MarshalJSON(params)
+	if err != nil {
+		return err
+	}
+
+	if params.Amount != "" {
+		res, err := ctx.QueryCustom(types.PostRouterName, post.QueryDonationRewardEstimate, data)
+		if err != nil {
+			return err
+		}
+		estimate := new(model.RewardEstimate)
+		if err := c.cdc.UnmarshalJSON(res, estimate); err != nil {
+			return err
+		}
+		if err := client.PrintIndent(estimate); err != nil {
+			return err
+		}
+		return nil
+	}
+
+	res, err := ctx.QueryCustom(types.PostRouterName, post.QueryRewardEstimate, data)
+	if err != nil {
+		return err
+	}
+	estimates := []model.RewardEstimate{}
+	if err := c.cdc.UnmarshalJSON(res, &estimates); err != nil {
+		return err
+	}
+	if err := client.PrintIndent(estimates); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c commander) getPostAccessCmd(cmd *cobra.Command, args []string) error {
+	ctx := client.NewCoreContextFromViper()
+	if len(args) != 3 || len(args[0]) == 0 || len(args[1]) == 0 || len(args[2]) == 0 {
+		return errors.New("You must provide an valid user, author and post id")
+	}
+
+	params, err := c.cdc.MarshalJSON(post.QueryPostAccessParams{
+		Username: types.AccountKey(args[0]),
+		Author:   types.AccountKey(args[1]),
+		PostID:   args[2],
+	})
+	if err != nil {
+		return err
+	}
+	res, err := ctx.QueryCustom(types.PostRouterName, post.QueryPostAccess, params)
+	if err != nil {
+		return err
+	}
+	result := new(model.PostAccessResult)
+	if err := c.cdc.UnmarshalJSON(res, result); err != nil {
+		return err
+	}
+
+	if err := client.PrintIndent(result); err != nil {
+		return err
+	}
+	return nil
+}
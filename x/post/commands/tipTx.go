@@ -0,0 +1,49 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/wire"
+	"github.com/lino-network/lino/client"
+	"github.com/lino-network/lino/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	post "github.com/lino-network/lino/x/post"
+)
+
+// TipTxCmd will create a tip tx and sign it with the given key
+func TipTxCmd(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tip",
+		Short: "tip to an account without a post",
+		RunE:  sendTipTx(cdc),
+	}
+	cmd.Flags().String(client.FlagDonator, "", "donator of this transaction")
+	cmd.Flags().String(client.FlagReceiver, "", "receiver of the tip")
+	cmd.Flags().String(client.FlagAmount, "", "amount of the tip")
+	cmd.Flags().String(client.FlagReference, "", "reference of this tip, such as a live session")
+	return cmd
+}
+
+// send tip transaction to the blockchain
+func sendTipTx(cdc *wire.Codec) client.CommandTxCallback {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := client.NewCoreContextFromViper()
+		username := viper.GetString(client.FlagDonator)
+		receiver := viper.GetString(client.FlagReceiver)
+		msg := post.NewTipMsg(
+			username, types.LNO(viper.GetString(client.FlagAmount)),
+			receiver, viper.GetString(client.FlagReference), "")
+
+		// build and sign the transaction, then broadcast to Tendermint
+		res, signErr := ctx.SignBuildBroadcast([]sdk.Msg{msg}, cdc)
+		if signErr != nil {
+			return signErr
+		}
+
+		fmt.Printf("Committed at block %d. Hash: %s\n", res.Height, res.Hash.String())
+		return nil
+	}
+}
@@ -24,6 +24,9 @@ func UpdatePostTxCmd(cdc *wire.Codec) *cobra.Command {
 	cmd.Flags().String(client.FlagPostID, "", "post id to identify this post for the author")
 	cmd.Flags().String(client.FlagTitle, "", "title for the post")
 	cmd.Flags().String(client.FlagContent, "", "content for the post")
+	cmd.Flags().StringSlice(client.FlagTags, nil, "comma separated tags of the post")
+	cmd.Flags().Int(client.FlagReplyMode, 0,
+		"who can reply to the post, 0: everyone, 1: followers only, 2: nobody, unchanged if not set")
 	return cmd
 }
 
@@ -35,7 +38,11 @@ func sendUpdatePostTx(cdc *wire.Codec) client.CommandTxCallback {
 		msg := post.NewUpdatePostMsg(
 			viper.GetString(client.FlagAuthor), viper.GetString(client.FlagPostID),
 			viper.GetString(client.FlagTitle), viper.GetString(client.FlagContent),
-			[]types.IDToURLMapping(nil))
+			[]types.IDToURLMapping(nil), viper.GetStringSlice(client.FlagTags))
+		if cmd.Flags().Changed(client.FlagReplyMode) {
+			replyMode := types.ReplyMode(viper.GetInt(client.FlagReplyMode))
+			msg.ReplyMode = &replyMode
+		}
 
 		// build and sign the transaction, then broadcast to Tendermint
 		res, err := ctx.SignBuildBroadcast([]sdk.Msg{msg}, cdc)
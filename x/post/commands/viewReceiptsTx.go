@@ -0,0 +1,64 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/wire"
+	"github.com/lino-network/lino/client"
+	"github.com/lino-network/lino/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	post "github.com/lino-network/lino/x/post"
+)
+
+// ViewReceiptsTxCmd will create a view receipts tx and sign it with the app key
+func ViewReceiptsTxCmd(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "view-receipts",
+		Short: "submit views of many users to posts in one batch",
+		RunE:  sendViewReceiptsTx(cdc),
+	}
+	cmd.Flags().String(client.FlagApp, "", "app which submits the view receipts")
+	cmd.Flags().StringSlice(client.FlagReceipts, nil,
+		"comma separated view receipts, e.g. alice:author:postID:infra,bob:author:postID")
+	return cmd
+}
+
+// send view receipts transaction to the blockchain
+func sendViewReceiptsTx(cdc *wire.Codec) client.CommandTxCallback {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := client.NewCoreContextFromViper()
+		app := viper.GetString(client.FlagApp)
+		receipts := []post.ViewReceipt{}
+		for _, receipt := range viper.GetStringSlice(client.FlagReceipts) {
+			fields := strings.Split(receipt, ":")
+			if len(fields) != 3 && len(fields) != 4 {
+				return errors.New("view receipt must be in format username:author:postID[:infra]")
+			}
+			viewReceipt := post.ViewReceipt{
+				Username: types.AccountKey(fields[0]),
+				Author:   types.AccountKey(fields[1]),
+				PostID:   fields[2],
+			}
+			if len(fields) == 4 {
+				viewReceipt.InfraProvider = types.AccountKey(fields[3])
+			}
+			receipts = append(receipts, viewReceipt)
+		}
+		msg := post.NewViewReceiptsMsg(app, receipts)
+
+		// build and sign the transaction, then broadcast to Tendermint
+		res, err := ctx.SignBuildBroadcast([]sdk.Msg{msg}, cdc)
+
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Committed at block %d. Hash: %s\n", res.Height, res.Hash.String())
+		return nil
+	}
+}
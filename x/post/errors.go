@@ -33,6 +33,11 @@ func ErrInvalidPostRedistributionSplitRate() sdk.Error {
 	return types.NewError(types.CodeInvalidPostRedistributionSplitRate, fmt.Sprintf("invalid post redistribution split rate"))
 }
 
+// ErrPostCensored - error when recreating a post censored by governance
+func ErrPostCensored(permlink types.Permlink) sdk.Error {
+	return types.NewError(types.CodePostCensored, fmt.Sprintf("post %v is censored and can't be published again", permlink))
+}
+
 // ErrDonatePostIsDeleted - error when donate to a deleted post
 func ErrDonatePostIsDeleted(permlink types.Permlink) sdk.Error {
 	return types.NewError(types.CodeDonatePostIsDeleted, fmt.Sprintf("donate to post %s failed, post is deleted", permlink))
@@ -48,6 +53,11 @@ func ErrDeveloperNotFound(fromApp types.AccountKey) sdk.Error {
 	return types.NewError(types.CodeDeveloperNotFound, fmt.Sprintf("developer %s is not found", fromApp))
 }
 
+// ErrInfraProviderNotFound - error when infra provider is not found
+func ErrInfraProviderNotFound(provider types.AccountKey) sdk.Error {
+	return types.NewError(types.CodeInfraProviderNotFound, fmt.Sprintf("infra provider %s is not found", provider))
+}
+
 // ErrCannotDonateToSelf - error when donate to self
 func ErrCannotDonateToSelf(user types.AccountKey) sdk.Error {
 	return types.NewError(types.CodeCannotDonateToSelf, fmt.Sprintf("donate failed, user %v donate to self", user))
@@ -147,3 +157,93 @@ func ErrNoUsername() sdk.Error {
 func ErrInvalidMemo() sdk.Error {
 	return types.NewError(types.CodeInvalidMemo, fmt.Sprintf("invalid memo"))
 }
+
+// ErrInvalidTag - error when post tag is invalid
+func ErrInvalidTag(tag string) sdk.Error {
+	return types.NewError(types.CodeInvalidTag, fmt.Sprintf("invalid tag: %v", tag))
+}
+
+// ErrTooManyTags - error when posting with too many tags
+func ErrTooManyTags() sdk.Error {
+	return types.NewError(types.CodeTooManyTags, fmt.Sprintf("too many tags"))
+}
+
+// ErrInvalidReplyMode - error when post reply mode is invalid
+func ErrInvalidReplyMode() sdk.Error {
+	return types.NewError(types.CodeInvalidReplyMode, fmt.Sprintf("invalid post reply mode"))
+}
+
+// ErrReplyNotAllowed - error when user is not allowed to reply to a post
+func ErrReplyNotAllowed(user types.AccountKey, permlink types.Permlink) sdk.Error {
+	return types.NewError(types.CodeReplyNotAllowed, fmt.Sprintf("user %v is not allowed to reply to post %v", user, permlink))
+}
+
+// ErrHideCommentNotAllowed - error when user hides a comment which is not on user's post
+func ErrHideCommentNotAllowed(user types.AccountKey, permlink types.Permlink) sdk.Error {
+	return types.NewError(types.CodeHideCommentNotAllowed, fmt.Sprintf("user %v is not allowed to hide comment %v", user, permlink))
+}
+
+// ErrInvalidPostAccessMode - error when post access mode is invalid
+func ErrInvalidPostAccessMode() sdk.Error {
+	return types.NewError(types.CodeInvalidPostAccessMode, fmt.Sprintf("invalid post access mode"))
+}
+
+// ErrInvalidUnlockPrice - error when unlock price is invalid
+func ErrInvalidUnlockPrice() sdk.Error {
+	return types.NewError(types.CodeInvalidUnlockPrice, fmt.Sprintf("invalid unlock price"))
+}
+
+// ErrPostNotUnlockable - error when unlock a post which doesn't require unlock payment
+func ErrPostNotUnlockable(permlink types.Permlink) sdk.Error {
+	return types.NewError(types.CodePostNotUnlockable, fmt.Sprintf("post %v can't be unlocked", permlink))
+}
+
+// ErrPostAlreadyUnlocked - error when user has unlocked the post
+func ErrPostAlreadyUnlocked(user types.AccountKey, permlink types.Permlink) sdk.Error {
+	return types.NewError(types.CodePostAlreadyUnlocked, fmt.Sprintf("%v already unlocked post %v", user, permlink))
+}
+
+// ErrUnlockPriceMismatch - error when unlock amount doesn't match the post unlock price
+func ErrUnlockPriceMismatch(permlink types.Permlink) sdk.Error {
+	return types.NewError(types.CodeUnlockPriceMismatch, fmt.Sprintf("unlock amount doesn't match post %v unlock price", permlink))
+}
+
+// ErrCannotUnlockOwnPost - error when user unlock own post
+func ErrCannotUnlockOwnPost(user types.AccountKey) sdk.Error {
+	return types.NewError(types.CodeCannotUnlockOwnPost, fmt.Sprintf("unlock failed, user %v unlock own post", user))
+}
+
+// ErrInvalidCoAuthors - error when post co-authors are invalid
+func ErrInvalidCoAuthors(reason string) sdk.Error {
+	return types.NewError(types.CodeInvalidCoAuthors, fmt.Sprintf("invalid co-authors: %v", reason))
+}
+
+// ErrInvalidViewReceipts - error when view receipts are invalid
+func ErrInvalidViewReceipts(reason string) sdk.Error {
+	return types.NewError(types.CodeInvalidViewReceipts, fmt.Sprintf("invalid view receipts: %v", reason))
+}
+
+// ErrCoAuthorNotFound - error when user is not a co-author of the post
+func ErrCoAuthorNotFound(user types.AccountKey, permlink types.Permlink) sdk.Error {
+	return types.NewError(types.CodeCoAuthorNotFound, fmt.Sprintf("%v is not a co-author of post %v", user, permlink))
+}
+
+// ErrCoAuthorAlreadyApproved - error when co-author has approved the post
+func ErrCoAuthorAlreadyApproved(user types.AccountKey, permlink types.Permlink) sdk.Error {
+	return types.NewError(types.CodeCoAuthorAlreadyApproved, fmt.Sprintf("%v already approved post %v", user, permlink))
+}
+
+// ErrInvalidTipReference - error when tip reference is too long
+func ErrInvalidTipReference() sdk.Error {
+	return types.NewError(types.CodeInvalidTipReference, fmt.Sprintf("invalid tip reference"))
+}
+
+// ErrInvalidReportReason - error when report reason is invalid
+func ErrInvalidReportReason() sdk.Error {
+	return types.NewError(types.CodeInvalidReportReason, fmt.Sprintf("invalid report reason"))
+}
+
+// ErrInvalidReportEvidence - error when report evidence is invalid
+func ErrInvalidReportEvidence() sdk.Error {
+	return types.NewError(types.CodeInvalidReportEvidence, fmt.Sprintf("invalid report evidence"))
+}
@@ -16,11 +16,13 @@ func init() {
 
 	cdc.RegisterInterface((*types.Event)(nil), nil)
 	cdc.RegisterConcrete(RewardEvent{}, "event/reward", nil)
+	cdc.RegisterConcrete(TipRewardEvent{}, "event/tipReward", nil)
 }
 
 // RewardEvent - when donation occurred, a reward event will be register
 // at 7 days later. After 7 days reward event will be executed and send
-// inflation to author.
+// inflation to author. Repost and hop are set when the donation is a royalty
+// paid to an upstream post from a donation to the repost.
 type RewardEvent struct {
 	PostAuthor types.AccountKey `json:"post_author"`
 	PostID     string           `json:"post_id"`
@@ -29,6 +31,9 @@ type RewardEvent struct {
 	Original   types.Coin       `json:"original"`
 	Friction   types.Coin       `json:"friction"`
 	FromApp    types.AccountKey `json:"from_app"`
+	ConsumedAt int64            `json:"consumed_at"`
+	Repost     types.Permlink   `json:"repost"`
+	Hop        int64            `json:"hop"`
 }
 
 // Execute - execute reward event after 7 days
@@ -37,14 +42,21 @@ func (event RewardEvent) Execute(
 	gm global.GlobalManager, dm dev.DeveloperManager) sdk.Error {
 
 	permlink := types.GetPermlink(event.PostAuthor, event.PostID)
-	paneltyScore, err := pm.GetPenaltyScore(ctx, permlink)
+	if err := pm.RemoveExecutedPendingRewards(ctx, permlink); err != nil {
+		return err
+	}
+	paneltyScore, err := pm.RefreshPenaltyScore(ctx, permlink, am)
 	if err != nil {
 		return err
 	}
-	// check if post is deleted
+	// deleted or censored post gets no inflation, the reward stays in content reward pool
+	// while the donation is still settled to the author income
 	if isDeleted, err := pm.IsDeleted(ctx, permlink); isDeleted || err != nil {
 		paneltyScore = sdk.OneRat()
 	}
+	if isCensored, _ := pm.IsCensored(ctx, permlink); isCensored {
+		paneltyScore = sdk.OneRat()
+	}
 	reward, err := gm.GetRewardAndPopFromWindow(ctx, event.Evaluate, paneltyScore)
 	if err != nil {
 		return err
@@ -63,9 +75,83 @@ func (event RewardEvent) Execute(
 	if err := pm.AddDonation(ctx, permlink, event.Consumer, reward, types.Inflation); err != nil {
 		return err
 	}
+	if event.Repost != "" {
+		if err := pm.AddRepostRoyalty(
+			ctx, permlink, event.Repost, event.Hop, reward, types.Inflation); err != nil {
+			return err
+		}
+	}
+	// curation reward is carved from inflation and paid to prior upvoters and donors
+	postParam, err := pm.paramHolder.GetPostParam(ctx)
+	if err != nil {
+		return err
+	}
+	curators, curationRewards, err := pm.GetCurationRewards(
+		ctx, permlink, event.Consumer, event.ConsumedAt,
+		types.RatToCoin(reward.ToRat().Mul(postParam.CurationRewardRatio)))
+	if err != nil {
+		return err
+	}
+	for i, curator := range curators {
+		if err := am.AddIncomeAndReward(
+			ctx, curator, types.NewCoinFromInt64(0), types.NewCoinFromInt64(0), curationRewards[i],
+			event.Consumer, event.PostAuthor, event.PostID); err != nil {
+			return err
+		}
+		reward = reward.Minus(curationRewards[i])
+	}
+	// add reward to all co-authors in proportion
+	authors, originals, err := pm.SplitRevenue(ctx, permlink, event.PostAuthor, event.Original)
+	if err != nil {
+		return err
+	}
+	_, frictions, err := pm.SplitRevenue(ctx, permlink, event.PostAuthor, event.Friction)
+	if err != nil {
+		return err
+	}
+	_, rewards, err := pm.SplitRevenue(ctx, permlink, event.PostAuthor, reward)
+	if err != nil {
+		return err
+	}
+	for i, author := range authors {
+		if err := am.AddIncomeAndReward(
+			ctx, author, originals[i], frictions[i], rewards[i], event.Consumer, event.PostAuthor, event.PostID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// TipRewardEvent - when tip occurred, a tip reward event will be register
+// at 7 days later. After 7 days tip reward event will be executed and send
+// inflation to tip target.
+type TipRewardEvent struct {
+	Target    types.AccountKey `json:"target"`
+	Reference string           `json:"reference"`
+	Consumer  types.AccountKey `json:"consumer"`
+	Evaluate  types.Coin       `json:"evaluate"`
+	Original  types.Coin       `json:"original"`
+	Friction  types.Coin       `json:"friction"`
+	FromApp   types.AccountKey `json:"from_app"`
+}
+
+// Execute - execute tip reward event after 7 days
+func (event TipRewardEvent) Execute(
+	ctx sdk.Context, am acc.AccountManager, gm global.GlobalManager, dm dev.DeveloperManager) sdk.Error {
+	reward, err := gm.GetRewardAndPopFromWindow(ctx, event.Evaluate, sdk.ZeroRat())
+	if err != nil {
+		return err
+	}
+	// if developer exist, add to developer consumption
+	if dm.DoesDeveloperExist(ctx, event.FromApp) {
+		dm.ReportConsumption(ctx, event.FromApp, reward)
+	}
+	if !am.DoesAccountExist(ctx, event.Target) {
+		return ErrAccountNotFound(event.Target)
+	}
 	// add reward to user
-	if err := am.AddIncomeAndReward(
-		ctx, event.PostAuthor, event.Original, event.Friction, reward, event.Consumer, event.PostAuthor, event.PostID); err != nil {
+	if err := am.AddTipIncomeAndReward(
+		ctx, event.Target, event.Original, event.Friction, reward, event.Consumer, event.Reference); err != nil {
 		return err
 	}
 	return nil
@@ -3,6 +3,7 @@ package post
 import (
 	"math/big"
 	"testing"
+	"time"
 
 	"github.com/lino-network/lino/types"
 	"github.com/stretchr/testify/assert"
@@ -11,6 +12,7 @@ import (
 	accModel "github.com/lino-network/lino/x/account/model"
 	globalModel "github.com/lino-network/lino/x/global/model"
 	postModel "github.com/lino-network/lino/x/post/model"
+	abci "github.com/tendermint/tendermint/abci/types"
 )
 
 func TestRewardEvent(t *testing.T) {
@@ -340,3 +342,275 @@ func TestRewardEvent(t *testing.T) {
 		}
 	}
 }
+
+func TestCoAuthoredRewardEvent(t *testing.T) {
+	ctx, am, _, pm, gm, dm := setupTest(t, 1)
+	gs := globalModel.NewGlobalStorage(testGlobalKVStoreKey)
+	as := accModel.NewAccountStorage(testAccountKVStoreKey)
+
+	user, postID := createTestPost(t, ctx, "user", "postID", am, pm, "0")
+	permlink := types.GetPermlink(user, postID)
+	user1 := createTestAccount(t, ctx, am, "user1")
+	coAuthor := createTestAccount(t, ctx, am, "coauthor")
+	err := pm.SetCoAuthors(ctx, permlink, []postModel.CoAuthor{
+		{Username: user, Weight: sdk.NewRat(3, 5), Approved: true},
+		{Username: coAuthor, Weight: sdk.NewRat(2, 5), Approved: true},
+	})
+	assert.Nil(t, err)
+
+	gs.SetConsumptionMeta(ctx, &globalModel.ConsumptionMeta{
+		ConsumptionRewardPool: types.NewCoinFromInt64(100),
+		ConsumptionWindow:     types.NewCoinFromInt64(100),
+	})
+	as.SetReward(ctx, user, &accModel.Reward{})
+	as.SetReward(ctx, coAuthor, &accModel.Reward{})
+
+	rewardEvent := RewardEvent{
+		PostAuthor: user,
+		PostID:     postID,
+		Consumer:   user1,
+		Evaluate:   types.NewCoinFromInt64(100),
+		Original:   types.NewCoinFromInt64(100),
+		Friction:   types.NewCoinFromInt64(15),
+		FromApp:    "",
+	}
+	err = rewardEvent.Execute(ctx, pm, am, gm, dm)
+	assert.Nil(t, err)
+
+	testCases := []struct {
+		testName     string
+		username     types.AccountKey
+		expectReward accModel.Reward
+	}{
+		{
+			testName: "post author gets 60% reward",
+			username: user,
+			expectReward: accModel.Reward{
+				TotalIncome:     types.NewCoinFromInt64(60),
+				OriginalIncome:  types.NewCoinFromInt64(9),
+				FrictionIncome:  types.NewCoinFromInt64(9),
+				InflationIncome: types.NewCoinFromInt64(60),
+				UnclaimReward:   types.NewCoinFromInt64(60),
+			},
+		},
+		{
+			testName: "co-author gets 40% reward",
+			username: coAuthor,
+			expectReward: accModel.Reward{
+				TotalIncome:     types.NewCoinFromInt64(40),
+				OriginalIncome:  types.NewCoinFromInt64(6),
+				FrictionIncome:  types.NewCoinFromInt64(6),
+				InflationIncome: types.NewCoinFromInt64(40),
+				UnclaimReward:   types.NewCoinFromInt64(40),
+			},
+		},
+	}
+	for _, tc := range testCases {
+		reward, err := as.GetReward(ctx, tc.username)
+		if err != nil {
+			t.Errorf("%s: failed to get reward, got err %v", tc.testName, err)
+		}
+		if !assert.Equal(t, tc.expectReward, *reward) {
+			t.Errorf("%s: diff reward, got %v, want %v", tc.testName, *reward, tc.expectReward)
+		}
+	}
+}
+
+func TestCensoredPostRewardEvent(t *testing.T) {
+	ctx, am, _, pm, gm, dm := setupTest(t, 1)
+	gs := globalModel.NewGlobalStorage(testGlobalKVStoreKey)
+	as := accModel.NewAccountStorage(testAccountKVStoreKey)
+
+	user, postID := createTestPost(t, ctx, "user", "postID", am, pm, "0")
+	permlink := types.GetPermlink(user, postID)
+	user1 := createTestAccount(t, ctx, am, "user1")
+
+	gs.SetConsumptionMeta(ctx, &globalModel.ConsumptionMeta{
+		ConsumptionRewardPool: types.NewCoinFromInt64(100),
+		ConsumptionWindow:     types.NewCoinFromInt64(100),
+	})
+	as.SetReward(ctx, user, &accModel.Reward{})
+	err := pm.AddPendingReward(ctx, permlink, ctx.BlockHeader().Time.Unix())
+	assert.Nil(t, err)
+	err = pm.CensorPost(ctx, permlink, types.ProposalKey("1"))
+	assert.Nil(t, err)
+
+	rewardEvent := RewardEvent{
+		PostAuthor: user,
+		PostID:     postID,
+		Consumer:   user1,
+		Evaluate:   types.NewCoinFromInt64(100),
+		Original:   types.NewCoinFromInt64(100),
+		Friction:   types.NewCoinFromInt64(15),
+		FromApp:    "",
+	}
+	err = rewardEvent.Execute(ctx, pm, am, gm, dm)
+	assert.Nil(t, err)
+
+	// reward stays in content reward pool and author gets no inflation,
+	// the donation income and pending reward are still settled
+	consumptionMeta, err := gs.GetConsumptionMeta(ctx)
+	assert.Nil(t, err)
+	assert.True(t, consumptionMeta.ConsumptionRewardPool.IsEqual(types.NewCoinFromInt64(100)))
+	assert.True(t, consumptionMeta.ConsumptionWindow.IsZero())
+	reward, err := as.GetReward(ctx, user)
+	assert.Nil(t, err)
+	assert.Equal(t, accModel.Reward{
+		TotalIncome:     types.NewCoinFromInt64(0),
+		OriginalIncome:  types.NewCoinFromInt64(15),
+		FrictionIncome:  types.NewCoinFromInt64(15),
+		InflationIncome: types.NewCoinFromInt64(0),
+		UnclaimReward:   types.NewCoinFromInt64(0),
+	}, *reward)
+	rewardTimes, err := pm.postStorage.GetPostPendingRewardTimes(ctx, permlink)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(rewardTimes))
+}
+
+func TestTipRewardEvent(t *testing.T) {
+	ctx, am, _, _, gm, dm := setupTest(t, 1)
+	gs := globalModel.NewGlobalStorage(testGlobalKVStoreKey)
+	as := accModel.NewAccountStorage(testAccountKVStoreKey)
+
+	streamer := createTestAccount(t, ctx, am, "streamer")
+	user := createTestAccount(t, ctx, am, "user")
+
+	gs.SetConsumptionMeta(ctx, &globalModel.ConsumptionMeta{
+		ConsumptionRewardPool: types.NewCoinFromInt64(100),
+		ConsumptionWindow:     types.NewCoinFromInt64(200),
+	})
+	as.SetReward(ctx, streamer, &accModel.Reward{})
+
+	tipRewardEvent := TipRewardEvent{
+		Target:    streamer,
+		Reference: "live",
+		Consumer:  user,
+		Evaluate:  types.NewCoinFromInt64(100),
+		Original:  types.NewCoinFromInt64(100),
+		Friction:  types.NewCoinFromInt64(15),
+		FromApp:   "",
+	}
+	err := tipRewardEvent.Execute(ctx, am, gm, dm)
+	assert.Nil(t, err)
+
+	reward, err := as.GetReward(ctx, streamer)
+	assert.Nil(t, err)
+	assert.Equal(t, accModel.Reward{
+		TotalIncome:     types.NewCoinFromInt64(50),
+		OriginalIncome:  types.NewCoinFromInt64(15),
+		FrictionIncome:  types.NewCoinFromInt64(15),
+		InflationIncome: types.NewCoinFromInt64(50),
+		UnclaimReward:   types.NewCoinFromInt64(50),
+	}, *reward)
+
+	rewardHistory, err := as.GetRewardHistory(ctx, streamer, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, accModel.RewardDetail{
+		OriginalDonation: types.NewCoinFromInt64(100),
+		FrictionDonation: types.NewCoinFromInt64(15),
+		ActualReward:     types.NewCoinFromInt64(50),
+		Consumer:         user,
+		Reference:        "live",
+	}, rewardHistory.Details[0])
+}
+
+func TestCurationRewardEvent(t *testing.T) {
+	ctx, am, _, pm, gm, dm := setupTest(t, 1)
+	gs := globalModel.NewGlobalStorage(testGlobalKVStoreKey)
+	as := accModel.NewAccountStorage(testAccountKVStoreKey)
+	baseTime := ctx.BlockHeader().Time.Unix()
+
+	user, postID := createTestPost(t, ctx, "user", "postID", am, pm, "0")
+	permlink := types.GetPermlink(user, postID)
+	curator1 := createTestAccount(t, ctx, am, "curator1")
+	curator2 := createTestAccount(t, ctx, am, "curator2")
+	consumer := createTestAccount(t, ctx, am, "consumer")
+
+	err := pm.ReportOrUpvoteToPost(ctx, permlink, curator1, types.NewCoinFromInt64(100), false)
+	assert.Nil(t, err)
+	err = pm.ReportOrUpvoteToPost(
+		ctx.WithBlockHeader(abci.Header{ChainID: "Lino", Time: time.Unix(baseTime+50, 0)}),
+		permlink, curator2, types.NewCoinFromInt64(100), false)
+	assert.Nil(t, err)
+
+	gs.SetConsumptionMeta(ctx, &globalModel.ConsumptionMeta{
+		ConsumptionRewardPool: types.NewCoinFromInt64(100),
+		ConsumptionWindow:     types.NewCoinFromInt64(100),
+	})
+	as.SetReward(ctx, user, &accModel.Reward{})
+	as.SetReward(ctx, curator1, &accModel.Reward{})
+	as.SetReward(ctx, curator2, &accModel.Reward{})
+
+	rewardEvent := RewardEvent{
+		PostAuthor: user,
+		PostID:     postID,
+		Consumer:   consumer,
+		Evaluate:   types.NewCoinFromInt64(100),
+		Original:   types.NewCoinFromInt64(100),
+		Friction:   types.NewCoinFromInt64(15),
+		FromApp:    "",
+		ConsumedAt: baseTime + 100,
+	}
+	err = rewardEvent.Execute(ctx, pm, am, gm, dm)
+	assert.Nil(t, err)
+
+	testCases := []struct {
+		testName     string
+		username     types.AccountKey
+		expectReward accModel.Reward
+	}{
+		{
+			testName: "author gets reward except curation reward",
+			username: user,
+			expectReward: accModel.Reward{
+				TotalIncome:     types.NewCoinFromInt64(91),
+				OriginalIncome:  types.NewCoinFromInt64(15),
+				FrictionIncome:  types.NewCoinFromInt64(15),
+				InflationIncome: types.NewCoinFromInt64(91),
+				UnclaimReward:   types.NewCoinFromInt64(91),
+			},
+		},
+		{
+			testName: "early curator gets more curation reward",
+			username: curator1,
+			expectReward: accModel.Reward{
+				TotalIncome:     types.NewCoinFromInt64(6),
+				OriginalIncome:  types.NewCoinFromInt64(0),
+				FrictionIncome:  types.NewCoinFromInt64(0),
+				InflationIncome: types.NewCoinFromInt64(6),
+				UnclaimReward:   types.NewCoinFromInt64(6),
+			},
+		},
+		{
+			testName: "late curator gets less curation reward",
+			username: curator2,
+			expectReward: accModel.Reward{
+				TotalIncome:     types.NewCoinFromInt64(3),
+				OriginalIncome:  types.NewCoinFromInt64(0),
+				FrictionIncome:  types.NewCoinFromInt64(0),
+				InflationIncome: types.NewCoinFromInt64(3),
+				UnclaimReward:   types.NewCoinFromInt64(3),
+			},
+		},
+	}
+	for _, tc := range testCases {
+		reward, err := as.GetReward(ctx, tc.username)
+		if err != nil {
+			t.Errorf("%s: failed to get reward, got err %v", tc.testName, err)
+		}
+		if !assert.Equal(t, tc.expectReward, *reward) {
+			t.Errorf("%s: diff reward, got %v, want %v", tc.testName, *reward, tc.expectReward)
+		}
+	}
+
+	rewardHistory, err := as.GetRewardHistory(ctx, curator1, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, accModel.RewardDetail{
+		OriginalDonation: types.NewCoinFromInt64(0),
+		FrictionDonation: types.NewCoinFromInt64(0),
+		ActualReward:     types.NewCoinFromInt64(6),
+		Consumer:         consumer,
+		PostAuthor:       user,
+		PostID:           postID,
+	}, rewardHistory.Details[0])
+}
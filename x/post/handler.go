@@ -6,24 +6,38 @@ import (
 
 	"github.com/lino-network/lino/types"
 	"github.com/lino-network/lino/x/global"
+	"github.com/lino-network/lino/x/post/model"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	acc "github.com/lino-network/lino/x/account"
 	dev "github.com/lino-network/lino/x/developer"
+	infra "github.com/lino-network/lino/x/infra"
 )
 
 // NewHandler - Handle all "post" type messages.
-func NewHandler(pm PostManager, am acc.AccountManager, gm global.GlobalManager, dm dev.DeveloperManager) sdk.Handler {
+func NewHandler(
+	pm PostManager, am acc.AccountManager, gm global.GlobalManager,
+	dm dev.DeveloperManager, im infra.InfraManager) sdk.Handler {
 	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
 		switch msg := msg.(type) {
 		case CreatePostMsg:
 			return handleCreatePostMsg(ctx, msg, pm, am, gm)
 		case DonateMsg:
 			return handleDonateMsg(ctx, msg, pm, am, gm, dm)
+		case TipMsg:
+			return handleTipMsg(ctx, msg, am, gm, dm)
 		case ReportOrUpvoteMsg:
 			return handleReportOrUpvoteMsg(ctx, msg, pm, am, gm)
 		case ViewMsg:
 			return handleViewMsg(ctx, msg, pm, am, gm)
+		case ViewReceiptsMsg:
+			return handleViewReceiptsMsg(ctx, msg, pm, am, dm, im)
+		case UnlockPostMsg:
+			return handleUnlockPostMsg(ctx, msg, pm, am, gm, dm)
+		case ApproveCoAuthorMsg:
+			return handleApproveCoAuthorMsg(ctx, msg, pm, am)
+		case HideCommentMsg:
+			return handleHideCommentMsg(ctx, msg, pm, am)
 		case UpdatePostMsg:
 			return handleUpdatePostMsg(ctx, msg, pm, am)
 		case DeletePostMsg:
@@ -42,6 +56,9 @@ func handleCreatePostMsg(ctx sdk.Context, msg CreatePostMsg, pm PostManager, am
 	}
 	permlink := types.GetPermlink(msg.Author, msg.PostID)
 	if pm.DoesPostExist(ctx, permlink) {
+		if isCensored, _ := pm.IsCensored(ctx, permlink); isCensored {
+			return ErrPostCensored(permlink).Result()
+		}
 		return ErrPostAlreadyExist(permlink).Result()
 	}
 	postParam, err := pm.paramHolder.GetPostParam(ctx)
@@ -55,11 +72,26 @@ func handleCreatePostMsg(ctx sdk.Context, msg CreatePostMsg, pm PostManager, am
 	if lastPostAt+postParam.PostIntervalSec > ctx.BlockHeader().Time.Unix() {
 		return ErrPostTooOften(msg.Author).Result()
 	}
+	if int64(len(msg.Tags)) > postParam.MaxNumOfTags {
+		return ErrTooManyTags().Result()
+	}
 	if len(msg.ParentAuthor) > 0 || len(msg.ParentPostID) > 0 {
 		parentPostKey := types.GetPermlink(msg.ParentAuthor, msg.ParentPostID)
 		if !pm.DoesPostExist(ctx, parentPostKey) {
 			return ErrPostNotFound(parentPostKey).Result()
 		}
+		replyMode, err := pm.GetReplyMode(ctx, parentPostKey)
+		if err != nil {
+			return err.Result()
+		}
+		switch replyMode {
+		case types.ReplyNobody:
+			return ErrReplyNotAllowed(msg.Author, parentPostKey).Result()
+		case types.ReplyFollowersOnly:
+			if msg.Author != msg.ParentAuthor && !am.IsMyFollower(ctx, msg.ParentAuthor, msg.Author) {
+				return ErrReplyNotAllowed(msg.Author, parentPostKey).Result()
+			}
+		}
 		if err := pm.AddComment(ctx, parentPostKey, msg.Author, msg.PostID); err != nil {
 			return err.Result()
 		}
@@ -70,13 +102,54 @@ func handleCreatePostMsg(ctx sdk.Context, msg CreatePostMsg, pm PostManager, am
 		return ErrInvalidPostRedistributionSplitRate().Result()
 	}
 
+	coAuthors := []model.CoAuthor{}
+	for _, coAuthor := range msg.CoAuthors {
+		if !am.DoesAccountExist(ctx, coAuthor.Username) {
+			return ErrAccountNotFound(coAuthor.Username).Result()
+		}
+		weight, err := sdk.NewRatFromDecimal(coAuthor.Weight, types.NewRatFromDecimalPrecision)
+		if err != nil {
+			return ErrInvalidCoAuthors(err.Error()).Result()
+		}
+		coAuthors = append(coAuthors, model.CoAuthor{
+			Username: coAuthor.Username,
+			Weight:   weight,
+			Approved: coAuthor.Username == msg.Author,
+		})
+	}
+
 	if err := pm.CreatePost(
 		ctx, msg.Author, msg.PostID, msg.SourceAuthor, msg.SourcePostID,
 		msg.ParentAuthor, msg.ParentPostID, msg.Content, msg.Title,
-		splitRate, msg.Links); err != nil {
+		splitRate, msg.Links, msg.Tags); err != nil {
 		return err.Result()
 	}
 
+	if msg.AccessMode != types.PublicAccess {
+		unlockPrice := types.NewCoinFromInt64(0)
+		if msg.AccessMode == types.UnlockAccess {
+			unlockPrice, err = types.LinoToCoin(msg.UnlockPrice)
+			if err != nil {
+				return err.Result()
+			}
+		}
+		if err := pm.SetPostAccess(ctx, permlink, msg.AccessMode, unlockPrice); err != nil {
+			return err.Result()
+		}
+	}
+
+	if msg.ReplyMode != types.ReplyEveryone {
+		if err := pm.SetReplyMode(ctx, permlink, msg.ReplyMode); err != nil {
+			return err.Result()
+		}
+	}
+
+	if len(coAuthors) > 0 {
+		if err := pm.SetCoAuthors(ctx, permlink, coAuthors); err != nil {
+			return err.Result()
+		}
+	}
+
 	if err := am.UpdateLastPostAt(ctx, msg.Author); err != nil {
 		return err.Result()
 	}
@@ -99,6 +172,67 @@ func handleViewMsg(ctx sdk.Context, msg ViewMsg, pm PostManager, am acc.AccountM
 	return sdk.Result{}
 }
 
+// Handle ViewReceiptsMsg
+func handleViewReceiptsMsg(
+	ctx sdk.Context, msg ViewReceiptsMsg, pm PostManager, am acc.AccountManager,
+	dm dev.DeveloperManager, im infra.InfraManager) sdk.Result {
+	if !dm.DoesDeveloperExist(ctx, msg.App) {
+		return ErrDeveloperNotFound(msg.App).Result()
+	}
+	postParam, err := pm.paramHolder.GetPostParam(ctx)
+	if err != nil {
+		return err.Result()
+	}
+
+	appViews := int64(0)
+	// keep infra providers in receipt order to report usage deterministically
+	providers := []types.AccountKey{}
+	providerViews := map[types.AccountKey]int64{}
+	for _, receipt := range msg.Receipts {
+		if !am.DoesAccountExist(ctx, receipt.Username) {
+			return ErrAccountNotFound(receipt.Username).Result()
+		}
+		permlink := types.GetPermlink(receipt.Author, receipt.PostID)
+		if !pm.DoesPostExist(ctx, permlink) {
+			return ErrPostNotFound(permlink).Result()
+		}
+		if len(receipt.InfraProvider) > 0 && !im.DoesInfraProviderExist(ctx, receipt.InfraProvider) {
+			return ErrInfraProviderNotFound(receipt.InfraProvider).Result()
+		}
+		// only views of users who granted app permission to the app are counted
+		if !am.HasAppPermission(ctx, receipt.Username, msg.App) {
+			continue
+		}
+		counted, err := pm.AddUniqueViewToPost(ctx, permlink, receipt.Username)
+		if err != nil {
+			return err.Result()
+		}
+		if !counted {
+			continue
+		}
+		appViews++
+		if len(receipt.InfraProvider) > 0 {
+			if _, exist := providerViews[receipt.InfraProvider]; !exist {
+				providers = append(providers, receipt.InfraProvider)
+			}
+			providerViews[receipt.InfraProvider]++
+		}
+	}
+
+	if appViews > 0 {
+		consumption := types.RatToCoin(postParam.ConsumptionPerView.ToRat().Mul(sdk.NewRat(appViews)))
+		if err := dm.ReportConsumption(ctx, msg.App, consumption); err != nil {
+			return err.Result()
+		}
+	}
+	for _, provider := range providers {
+		if err := im.ReportUsage(ctx, provider, providerViews[provider]); err != nil {
+			return err.Result()
+		}
+	}
+	return sdk.Result{}
+}
+
 // Handle DonateMsg
 func handleDonateMsg(
 	ctx sdk.Context, msg DonateMsg, pm PostManager, am acc.AccountManager,
@@ -140,34 +274,189 @@ func handleDonateMsg(
 	if err := pm.ReportOrUpvoteToPost(ctx, permlink, msg.Username, stake, false); err != nil {
 		return err.Result()
 	}
-	sourceAuthor, sourcePostID, err := pm.GetSourcePost(ctx, permlink)
-	if err != nil {
+	if err := processDonation(
+		ctx, msg.Username, coin, msg.Author, msg.PostID, msg.FromApp, am, pm, gm); err != nil {
 		return err.Result()
 	}
-	if sourceAuthor != types.AccountKey("") && sourcePostID != "" {
-		sourcePermlink := types.GetPermlink(sourceAuthor, sourcePostID)
-
-		redistributionSplitRate, err := pm.GetRedistributionSplitRate(ctx, sourcePermlink)
+	if msg.FromApp != "" {
+		// app's matching pool matches the donation, matched coin is donated by the app
+		matched, err := dm.MatchDonation(ctx, msg.FromApp, msg.Username, coin)
 		if err != nil {
 			return err.Result()
 		}
-		sourceIncome := types.RatToCoin(coin.ToRat().Mul(sdk.OneRat().Sub(redistributionSplitRate)))
-		coin = coin.Minus(sourceIncome)
+		if err := processDonation(
+			ctx, msg.FromApp, matched, msg.Author, msg.PostID, msg.FromApp, am, pm, gm); err != nil {
+			return err.Result()
+		}
+	}
+	return sdk.Result{}
+}
+
+// processDonation - split donation along the repost chain, then process friction of each part.
+// Parts paid to upstream posts are recorded as royalty from the post donated to.
+func processDonation(
+	ctx sdk.Context, consumer types.AccountKey, coin types.Coin,
+	postAuthor types.AccountKey, postID string, fromApp types.AccountKey,
+	am acc.AccountManager, pm PostManager, gm global.GlobalManager) sdk.Error {
+	if coin.IsZero() {
+		return nil
+	}
+	permlink := types.GetPermlink(postAuthor, postID)
+	payouts, err := pm.SplitRepostRoyalty(ctx, postAuthor, postID, coin)
+	if err != nil {
+		return err
+	}
+	for _, payout := range payouts {
+		if payout.Hop == 0 {
+			if err := processDonationFriction(
+				ctx, consumer, payout.Amount, postAuthor, postID, fromApp, "", 0, am, pm, gm); err != nil {
+				return ErrProcessDonation(permlink)
+			}
+			continue
+		}
 		if err := processDonationFriction(
-			ctx, msg.Username, sourceIncome, sourceAuthor, sourcePostID, msg.FromApp, am, pm, gm); err != nil {
-			return ErrProcessSourceDonation(sourcePermlink).Result()
+			ctx, consumer, payout.Amount, payout.Author, payout.PostID, fromApp,
+			permlink, payout.Hop, am, pm, gm); err != nil {
+			return ErrProcessSourceDonation(types.GetPermlink(payout.Author, payout.PostID))
+		}
+	}
+	return nil
+}
+
+// Handle TipMsg
+func handleTipMsg(
+	ctx sdk.Context, msg TipMsg, am acc.AccountManager,
+	gm global.GlobalManager, dm dev.DeveloperManager) sdk.Result {
+	coin, err := types.LinoToCoin(msg.Amount)
+	if err != nil {
+		return err.Result()
+	}
+	if !am.DoesAccountExist(ctx, msg.Username) {
+		return ErrAccountNotFound(msg.Username).Result()
+	}
+	if !am.DoesAccountExist(ctx, msg.Target) {
+		return ErrAccountNotFound(msg.Target).Result()
+	}
+	if msg.Username == msg.Target {
+		return ErrCannotDonateToSelf(msg.Username).Result()
+	}
+	if msg.FromApp != "" {
+		if !dm.DoesDeveloperExist(ctx, msg.FromApp) {
+			return ErrDeveloperNotFound(msg.FromApp).Result()
+		}
+	}
+
+	if err := am.MinusSavingCoin(
+		ctx, msg.Username, coin, msg.Target,
+		fmt.Sprintf("tip to: %v, reference: %v", msg.Target, msg.Reference),
+		types.DonationOut); err != nil {
+		return err.Result()
+	}
+	if err := processTipFriction(
+		ctx, msg.Username, coin, msg.Target, msg.Reference, msg.FromApp, am, gm); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{}
+}
+
+// Handle UnlockPostMsg
+func handleUnlockPostMsg(
+	ctx sdk.Context, msg UnlockPostMsg, pm PostManager, am acc.AccountManager,
+	gm global.GlobalManager, dm dev.DeveloperManager) sdk.Result {
+	permlink := types.GetPermlink(msg.Author, msg.PostID)
+	coin, err := types.LinoToCoin(msg.Amount)
+	if err != nil {
+		return err.Result()
+	}
+	if !am.DoesAccountExist(ctx, msg.Username) {
+		return ErrAccountNotFound(msg.Username).Result()
+	}
+	if !pm.DoesPostExist(ctx, permlink) {
+		return ErrPostNotFound(permlink).Result()
+	}
+	if isDeleted, err := pm.IsDeleted(ctx, permlink); isDeleted || err != nil {
+		return ErrDonatePostIsDeleted(permlink).Result()
+	}
+	if msg.Username == msg.Author || pm.IsCoAuthor(ctx, permlink, msg.Username) {
+		return ErrCannotUnlockOwnPost(msg.Username).Result()
+	}
+	if msg.FromApp != "" {
+		if !dm.DoesDeveloperExist(ctx, msg.FromApp) {
+			return ErrDeveloperNotFound(msg.FromApp).Result()
 		}
 	}
+	accessMode, unlockPrice, err := pm.GetPostAccess(ctx, permlink)
+	if err != nil {
+		return err.Result()
+	}
+	if accessMode != types.UnlockAccess {
+		return ErrPostNotUnlockable(permlink).Result()
+	}
+	if pm.HasUnlocked(ctx, msg.Username, permlink) {
+		return ErrPostAlreadyUnlocked(msg.Username, permlink).Result()
+	}
+	if !coin.IsEqual(unlockPrice) {
+		return ErrUnlockPriceMismatch(permlink).Result()
+	}
+
+	if err := am.MinusSavingCoin(
+		ctx, msg.Username, coin, msg.Author,
+		fmt.Sprintf("unlock post: %v", string(permlink)), types.DonationOut); err != nil {
+		return err.Result()
+	}
 	if err := processDonationFriction(
-		ctx, msg.Username, coin, msg.Author, msg.PostID, msg.FromApp, am, pm, gm); err != nil {
+		ctx, msg.Username, coin, msg.Author, msg.PostID, msg.FromApp, "", 0, am, pm, gm); err != nil {
 		return ErrProcessDonation(permlink).Result()
 	}
+	if err := pm.AddUnlockReceipt(ctx, permlink, msg.Username, coin); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{}
+}
+
+// Handle ApproveCoAuthorMsg
+func handleApproveCoAuthorMsg(
+	ctx sdk.Context, msg ApproveCoAuthorMsg, pm PostManager, am acc.AccountManager) sdk.Result {
+	if !am.DoesAccountExist(ctx, msg.Username) {
+		return ErrAccountNotFound(msg.Username).Result()
+	}
+	permlink := types.GetPermlink(msg.Author, msg.PostID)
+	if !pm.DoesPostExist(ctx, permlink) {
+		return ErrPostNotFound(permlink).Result()
+	}
+	if err := pm.ApproveCoAuthor(ctx, permlink, msg.Username); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{}
+}
+
+// Handle HideCommentMsg
+func handleHideCommentMsg(
+	ctx sdk.Context, msg HideCommentMsg, pm PostManager, am acc.AccountManager) sdk.Result {
+	if !am.DoesAccountExist(ctx, msg.Username) {
+		return ErrAccountNotFound(msg.Username).Result()
+	}
+	permlink := types.GetPermlink(msg.CommentAuthor, msg.CommentPostID)
+	if !pm.DoesPostExist(ctx, permlink) {
+		return ErrPostNotFound(permlink).Result()
+	}
+	parentAuthor, _, err := pm.GetParentPost(ctx, permlink)
+	if err != nil {
+		return err.Result()
+	}
+	if parentAuthor != msg.Username {
+		return ErrHideCommentNotAllowed(msg.Username, permlink).Result()
+	}
+	if err := pm.SetCommentHidden(ctx, permlink, msg.IsHidden); err != nil {
+		return err.Result()
+	}
 	return sdk.Result{}
 }
 
 func processDonationFriction(
 	ctx sdk.Context, consumer types.AccountKey, coin types.Coin,
 	postAuthor types.AccountKey, postID string, fromApp types.AccountKey,
+	repost types.Permlink, hop int64,
 	am acc.AccountManager, pm PostManager, gm global.GlobalManager) sdk.Error {
 	postKey := types.GetPermlink(postAuthor, postID)
 	if coin.IsZero() {
@@ -194,9 +483,16 @@ func processDonationFriction(
 		Original:   coin,
 		Friction:   frictionCoin,
 		FromApp:    fromApp,
+		ConsumedAt: ctx.BlockHeader().Time.Unix(),
+		Repost:     repost,
+		Hop:        hop,
 	}
-	if err := gm.AddFrictionAndRegisterContentRewardEvent(
-		ctx, rewardEvent, frictionCoin, evaluateResult); err != nil {
+	rewardAt, err := gm.AddFrictionAndRegisterContentRewardEvent(
+		ctx, rewardEvent, frictionCoin, evaluateResult)
+	if err != nil {
+		return err
+	}
+	if err := pm.AddPendingReward(ctx, postKey, rewardAt); err != nil {
 		return err
 	}
 
@@ -204,17 +500,83 @@ func processDonationFriction(
 	if err := pm.AddDonation(ctx, postKey, consumer, directDeposit, types.DirectDeposit); err != nil {
 		return err
 	}
+	if repost != "" {
+		if err := pm.AddRepostRoyalty(
+			ctx, postKey, repost, hop, directDeposit, types.DirectDeposit); err != nil {
+			return err
+		}
+	}
+	// direct deposit is paid to all co-authors in proportion
+	authors, deposits, err := pm.SplitRevenue(ctx, postKey, postAuthor, directDeposit)
+	if err != nil {
+		return err
+	}
+	for i, author := range authors {
+		if err := am.AddSavingCoin(
+			ctx, author, deposits[i], consumer, string(postKey), types.DonationIn); err != nil {
+			return err
+		}
+		if err := am.AddDirectDeposit(ctx, author, deposits[i]); err != nil {
+			return err
+		}
+	}
+	if err := gm.AddConsumption(ctx, coin); err != nil {
+		return err
+	}
+	if err := am.UpdateDonationRelationship(ctx, postAuthor, consumer, coin); err != nil {
+		return err
+	}
+	return nil
+}
+
+// processTipFriction - tip is evaluated as a consumption on a new post without reward
+func processTipFriction(
+	ctx sdk.Context, consumer types.AccountKey, coin types.Coin,
+	target types.AccountKey, reference string, fromApp types.AccountKey,
+	am acc.AccountManager, gm global.GlobalManager) sdk.Error {
+	if coin.IsZero() {
+		return nil
+	}
+	consumptionFrictionRate, err := gm.GetConsumptionFrictionRate(ctx)
+	if err != nil {
+		return err
+	}
+	frictionCoin := types.RatToCoin(coin.ToRat().Mul(consumptionFrictionRate))
+	numOfConsumptionOnTarget, err := am.GetDonationRelationship(ctx, consumer, target)
+	if err != nil {
+		return err
+	}
+	evaluateResult, err := gm.EvaluateConsumption(
+		ctx, coin, numOfConsumptionOnTarget, ctx.BlockHeader().Time.Unix(), types.NewCoinFromInt64(0))
+	if err != nil {
+		return err
+	}
+	tipRewardEvent := TipRewardEvent{
+		Target:    target,
+		Reference: reference,
+		Consumer:  consumer,
+		Evaluate:  evaluateResult,
+		Original:  coin,
+		Friction:  frictionCoin,
+		FromApp:   fromApp,
+	}
+	if _, err := gm.AddFrictionAndRegisterContentRewardEvent(
+		ctx, tipRewardEvent, frictionCoin, evaluateResult); err != nil {
+		return err
+	}
+
+	directDeposit := coin.Minus(frictionCoin)
 	if err := am.AddSavingCoin(
-		ctx, postAuthor, directDeposit, consumer, string(postKey), types.DonationIn); err != nil {
+		ctx, target, directDeposit, consumer, reference, types.DonationIn); err != nil {
 		return err
 	}
-	if err := am.AddDirectDeposit(ctx, postAuthor, directDeposit); err != nil {
+	if err := am.AddDirectDeposit(ctx, target, directDeposit); err != nil {
 		return err
 	}
 	if err := gm.AddConsumption(ctx, coin); err != nil {
 		return err
 	}
-	if err := am.UpdateDonationRelationship(ctx, postAuthor, consumer); err != nil {
+	if err := am.UpdateDonationRelationship(ctx, target, consumer, coin); err != nil {
 		return err
 	}
 	return nil
@@ -265,9 +627,16 @@ func handleReportOrUpvoteMsg(
 		return ErrReportOrUpvoteTooOften().Result()
 	}
 
-	if err := pm.ReportOrUpvoteToPost(
-		ctx, permlink, msg.Username, stake, msg.IsReport); err != nil {
-		return err.Result()
+	if msg.IsReport {
+		if err := pm.ReportToPost(
+			ctx, permlink, msg.Username, stake, msg.Reason, msg.Evidence); err != nil {
+			return err.Result()
+		}
+	} else {
+		if err := pm.ReportOrUpvoteToPost(
+			ctx, permlink, msg.Username, stake, false); err != nil {
+			return err.Result()
+		}
 	}
 	if err := am.UpdateLastReportOrUpvoteAt(ctx, msg.Username); err != nil {
 		return err.Result()
@@ -287,11 +656,24 @@ func handleUpdatePostMsg(
 	if isDeleted, err := pm.IsDeleted(ctx, permlink); isDeleted || err != nil {
 		return ErrUpdatePostIsDeleted(permlink).Result()
 	}
+	postParam, err := pm.paramHolder.GetPostParam(ctx)
+	if err != nil {
+		return err.Result()
+	}
+	if int64(len(msg.Tags)) > postParam.MaxNumOfTags {
+		return ErrTooManyTags().Result()
+	}
 
 	if err := pm.UpdatePost(
-		ctx, msg.Author, msg.PostID, msg.Title, msg.Content, msg.Links); err != nil {
+		ctx, msg.Author, msg.PostID, msg.Title, msg.Content, msg.Links, msg.Tags); err != nil {
 		return err.Result()
 	}
+	// reply mode is unchanged if it isn't set in the msg
+	if msg.ReplyMode != nil {
+		if err := pm.SetReplyMode(ctx, permlink, *msg.ReplyMode); err != nil {
+			return err.Result()
+		}
+	}
 	return sdk.Result{}
 }
 
@@ -16,7 +16,7 @@ import (
 
 func TestHandlerCreatePost(t *testing.T) {
 	ctx, am, _, pm, gm, dm := setupTest(t, 1)
-	handler := NewHandler(pm, am, gm, dm)
+	handler := NewHandler(pm, am, gm, dm, newTestInfraManager())
 
 	user := createTestAccount(t, ctx, am, "user1")
 
@@ -51,11 +51,39 @@ func TestHandlerCreatePost(t *testing.T) {
 	msg.PostID = "Post too often"
 	result = handler(ctx, msg)
 	assert.Equal(t, result, ErrPostTooOften(msg.Author).Result())
+
+	// test tags exceed post param limitation
+	user2 := createTestAccount(t, ctx, am, "user2")
+	msg.Author = user2
+	msg.Tags = []string{"1", "2", "3", "4", "5", "6"}
+	result = handler(ctx, msg)
+	assert.Equal(t, result, ErrTooManyTags().Result())
+
+	// test post with tags
+	msg.Tags = []string{"lino", "music"}
+	result = handler(ctx, msg)
+	assert.Equal(t, result, sdk.Result{})
+	permlink := types.GetPermlink(user2, msg.PostID)
+	assert.Equal(t, []types.Permlink{permlink}, pm.GetPostsByTag(ctx, "lino", 10))
+	assert.Equal(t, []types.Permlink{permlink}, pm.GetPostsByTag(ctx, "music", 10))
+
+	// test paywalled post
+	user3 := createTestAccount(t, ctx, am, "user3")
+	msg.Author = user3
+	msg.Tags = nil
+	msg.AccessMode = types.UnlockAccess
+	msg.UnlockPrice = types.LNO("10")
+	result = handler(ctx, msg)
+	assert.Equal(t, result, sdk.Result{})
+	accessMode, unlockPrice, err := pm.GetPostAccess(ctx, types.GetPermlink(user3, msg.PostID))
+	assert.Nil(t, err)
+	assert.Equal(t, types.UnlockAccess, accessMode)
+	assert.Equal(t, types.NewCoinFromInt64(10*types.Decimals), unlockPrice)
 }
 
 func TestHandlerUpdatePost(t *testing.T) {
 	ctx, am, _, pm, gm, dm := setupTest(t, 1)
-	handler := NewHandler(pm, am, gm, dm)
+	handler := NewHandler(pm, am, gm, dm, newTestInfraManager())
 
 	user, postID := createTestPost(t, ctx, "user", "postID", am, pm, "0")
 	user1, postID1 := createTestPost(t, ctx, "user1", "postID1", am, pm, "0")
@@ -68,23 +96,29 @@ func TestHandlerUpdatePost(t *testing.T) {
 		wantResult sdk.Result
 	}{
 		"normal update": {
-			msg:        NewUpdatePostMsg(string(user), postID, "update title", "update content", []types.IDToURLMapping(nil)),
+			msg:        NewUpdatePostMsg(string(user), postID, "update title", "update content", []types.IDToURLMapping(nil), nil),
 			wantResult: sdk.Result{},
 		},
 		"update author doesn't exist": {
-			msg:        NewUpdatePostMsg("invalid", postID, "update title", "update content", []types.IDToURLMapping(nil)),
+			msg:        NewUpdatePostMsg("invalid", postID, "update title", "update content", []types.IDToURLMapping(nil), nil),
 			wantResult: ErrAccountNotFound("invalid").Result(),
 		},
 		"update post doesn't exist - invalid post ID": {
-			msg:        NewUpdatePostMsg(string(user), "invalid", "update title", "update content", []types.IDToURLMapping(nil)),
+			msg:        NewUpdatePostMsg(string(user), "invalid", "update title", "update content", []types.IDToURLMapping(nil), nil),
 			wantResult: ErrPostNotFound(types.GetPermlink(user, "invalid")).Result(),
 		},
 		"update post doesn't exist - invalid author": {
-			msg:        NewUpdatePostMsg(string(user2), postID, "update title", "update content", []types.IDToURLMapping(nil)),
+			msg:        NewUpdatePostMsg(string(user2), postID, "update title", "update content", []types.IDToURLMapping(nil), nil),
 			wantResult: ErrPostNotFound(types.GetPermlink(user2, postID)).Result(),
 		},
+		"update with too many tags": {
+			msg: NewUpdatePostMsg(
+				string(user), postID, "update title", "update content", []types.IDToURLMapping(nil),
+				[]string{"1", "2", "3", "4", "5", "6"}),
+			wantResult: ErrTooManyTags().Result(),
+		},
 		"update deleted post": {
-			msg:        NewUpdatePostMsg(string(user1), postID1, "update title", "update content", []types.IDToURLMapping(nil)),
+			msg:        NewUpdatePostMsg(string(user1), postID1, "update title", "update content", []types.IDToURLMapping(nil), nil),
 			wantResult: ErrUpdatePostIsDeleted(types.GetPermlink(user1, postID1)).Result(),
 		},
 	}
@@ -105,6 +139,7 @@ func TestHandlerUpdatePost(t *testing.T) {
 			SourceAuthor: "",
 			SourcePostID: "",
 			Links:        tc.msg.Links,
+			Tags:         tc.msg.Tags,
 		}
 
 		postMeta := model.PostMeta{
@@ -125,7 +160,7 @@ func TestHandlerUpdatePost(t *testing.T) {
 
 func TestHandlerDeletePost(t *testing.T) {
 	ctx, am, _, pm, gm, dm := setupTest(t, 1)
-	handler := NewHandler(pm, am, gm, dm)
+	handler := NewHandler(pm, am, gm, dm, newTestInfraManager())
 
 	user, postID := createTestPost(t, ctx, "user", "postID", am, pm, "0")
 	user1 := createTestAccount(t, ctx, am, "user1")
@@ -173,7 +208,7 @@ func TestHandlerDeletePost(t *testing.T) {
 
 func TestHandlerCreateComment(t *testing.T) {
 	ctx, am, ph, pm, gm, dm := setupTest(t, 1)
-	handler := NewHandler(pm, am, gm, dm)
+	handler := NewHandler(pm, am, gm, dm, newTestInfraManager())
 	postParam, err := ph.GetPostParam(ctx)
 	assert.Nil(t, err)
 
@@ -268,9 +303,254 @@ func TestHandlerCreateComment(t *testing.T) {
 	assert.Equal(t, result, ErrPostNotFound(types.GetPermlink(user, msg.PostID)).Result())
 }
 
+func TestHandlerReplyModeAndHideComment(t *testing.T) {
+	ctx, am, ph, pm, gm, dm := setupTest(t, 1)
+	handler := NewHandler(pm, am, gm, dm, newTestInfraManager())
+	postParam, err := ph.GetPostParam(ctx)
+	assert.Nil(t, err)
+
+	author := createTestAccount(t, ctx, am, "author")
+	follower := createTestAccount(t, ctx, am, "follower")
+	stranger := createTestAccount(t, ctx, am, "stranger")
+	err = am.SetFollower(ctx, author, follower)
+	assert.Nil(t, err)
+
+	msg := CreatePostMsg{
+		PostID:                  "postID",
+		Title:                   string(make([]byte, 50)),
+		Content:                 string(make([]byte, 1000)),
+		Author:                  author,
+		RedistributionSplitRate: "0",
+		ReplyMode:               types.ReplyFollowersOnly,
+	}
+	result := handler(ctx, msg)
+	assert.Equal(t, sdk.Result{}, result)
+	permlink := types.GetPermlink(author, msg.PostID)
+
+	testCases := []struct {
+		testName     string
+		replyMode    types.ReplyMode
+		commenter    types.AccountKey
+		commentID    string
+		expectResult sdk.Result
+	}{
+		{
+			testName:     "stranger can't reply to followers only post",
+			replyMode:    types.ReplyFollowersOnly,
+			commenter:    stranger,
+			commentID:    "comment1",
+			expectResult: ErrReplyNotAllowed(stranger, permlink).Result(),
+		},
+		{
+			testName:     "follower replies to followers only post",
+			replyMode:    types.ReplyFollowersOnly,
+			commenter:    follower,
+			commentID:    "comment2",
+			expectResult: sdk.Result{},
+		},
+		{
+			testName:     "stranger replies to post open to everyone",
+			replyMode:    types.ReplyEveryone,
+			commenter:    stranger,
+			commentID:    "comment3",
+			expectResult: sdk.Result{},
+		},
+		{
+			testName:     "author can't reply to post closed to replies",
+			replyMode:    types.ReplyNobody,
+			commenter:    author,
+			commentID:    "comment4",
+			expectResult: ErrReplyNotAllowed(author, permlink).Result(),
+		},
+	}
+	for _, tc := range testCases {
+		// avoid posting too often
+		ctx = ctx.WithBlockHeader(abci.Header{
+			ChainID: "Lino",
+			Time:    ctx.BlockHeader().Time.Add(time.Duration(postParam.PostIntervalSec) * time.Second),
+		})
+		updateMsg := NewUpdatePostMsg(
+			string(author), msg.PostID, msg.Title, msg.Content, nil, nil)
+		replyMode := tc.replyMode
+		updateMsg.ReplyMode = &replyMode
+		result := handler(ctx, updateMsg)
+		if !assert.Equal(t, sdk.Result{}, result) {
+			t.Errorf("%s: failed to update reply mode, got %v", tc.testName, result)
+		}
+
+		commentMsg := CreatePostMsg{
+			PostID:                  tc.commentID,
+			Title:                   string(make([]byte, 50)),
+			Content:                 string(make([]byte, 1000)),
+			Author:                  tc.commenter,
+			ParentAuthor:            author,
+			ParentPostID:            msg.PostID,
+			RedistributionSplitRate: "0",
+		}
+		result = handler(ctx, commentMsg)
+		if !assert.Equal(t, tc.expectResult, result) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectResult)
+		}
+	}
+
+	// update without reply mode keeps the reply mode
+	result = handler(ctx, NewUpdatePostMsg(
+		string(author), msg.PostID, msg.Title, msg.Content, nil, nil))
+	assert.Equal(t, sdk.Result{}, result)
+	replyMode, err := pm.GetReplyMode(ctx, permlink)
+	assert.Nil(t, err)
+	assert.Equal(t, types.ReplyNobody, replyMode)
+
+	commentPermlink := types.GetPermlink(stranger, "comment3")
+	result = handler(ctx, NewHideCommentMsg(string(follower), string(stranger), "comment3", true))
+	assert.Equal(t, ErrHideCommentNotAllowed(follower, commentPermlink).Result(), result)
+	result = handler(ctx, NewHideCommentMsg(string(author), string(stranger), "comment3", true))
+	assert.Equal(t, sdk.Result{}, result)
+	postMeta, err := pm.postStorage.GetPostMeta(ctx, commentPermlink)
+	assert.Nil(t, err)
+	assert.True(t, postMeta.IsHidden)
+	assert.False(t, postMeta.IsDeleted)
+	comments, err := pm.GetComments(ctx, permlink, false)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(comments))
+	assert.Equal(t, follower, comments[0].Author)
+	comments, err = pm.GetComments(ctx, permlink, true)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(comments))
+
+	result = handler(ctx, NewHideCommentMsg(string(author), string(stranger), "comment3", false))
+	assert.Equal(t, sdk.Result{}, result)
+	postMeta, err = pm.postStorage.GetPostMeta(ctx, commentPermlink)
+	assert.Nil(t, err)
+	assert.False(t, postMeta.IsHidden)
+
+	// root post is not a comment
+	result = handler(ctx, NewHideCommentMsg(string(author), string(author), msg.PostID, true))
+	assert.Equal(t, ErrHideCommentNotAllowed(author, permlink).Result(), result)
+}
+
+func TestHandlerViewReceipts(t *testing.T) {
+	ctx, am, _, pm, gm, dm := setupTest(t, 1)
+	im := newTestInfraManager()
+	handler := NewHandler(pm, am, gm, dm, im)
+
+	author, postID := createTestPost(t, ctx, "author", "postID", am, pm, "0")
+	user1 := createTestAccount(t, ctx, am, "user1")
+	user2 := createTestAccount(t, ctx, am, "user2")
+	user3 := createTestAccount(t, ctx, am, "user3")
+	user4 := createTestAccount(t, ctx, am, "user4")
+	app1 := createTestAccount(t, ctx, am, "app1")
+	app2 := createTestAccount(t, ctx, am, "app2")
+	for _, user := range []types.AccountKey{user1, user2, user3} {
+		err := am.AuthorizePermission(ctx, user, app1, 100, types.AppPermission, types.NewCoinFromInt64(0))
+		assert.Nil(t, err)
+	}
+	// user4 grants permission to app2 only
+	err := am.AuthorizePermission(ctx, user4, app2, 100, types.AppPermission, types.NewCoinFromInt64(0))
+	assert.Nil(t, err)
+	err = dm.RegisterDeveloper(ctx, app1, types.NewCoinFromInt64(1000000*types.Decimals), "", "", "")
+	assert.Nil(t, err)
+	err = dm.RegisterDeveloper(ctx, app2, types.NewCoinFromInt64(1000000*types.Decimals), "", "", "")
+	assert.Nil(t, err)
+	err = im.InitGenesis(ctx)
+	assert.Nil(t, err)
+	for _, provider := range []types.AccountKey{"infra1", "infra2"} {
+		err = im.RegisterInfraProvider(ctx, provider)
+		assert.Nil(t, err)
+		err = im.AddToInfraProviderList(ctx, provider)
+		assert.Nil(t, err)
+	}
+
+	receipts := []ViewReceipt{
+		{Username: user1, Author: author, PostID: postID, InfraProvider: "infra1"},
+		{Username: user2, Author: author, PostID: postID, InfraProvider: "infra1"},
+		{Username: user3, Author: author, PostID: postID, InfraProvider: "infra2"},
+		// duplicate view in the same batch is not counted
+		{Username: user1, Author: author, PostID: postID, InfraProvider: "infra2"},
+		{Username: user1, Author: author, PostID: postID},
+		// user4 didn't grant permission to app1, view is not counted
+		{Username: user4, Author: author, PostID: postID, InfraProvider: "infra2"},
+	}
+
+	result := handler(ctx, NewViewReceiptsMsg("invalid", receipts))
+	assert.Equal(t, ErrDeveloperNotFound("invalid").Result(), result)
+	result = handler(ctx, NewViewReceiptsMsg(string(app1), []ViewReceipt{
+		{Username: user1, Author: author, PostID: postID, InfraProvider: "invalid"},
+	}))
+	assert.Equal(t, ErrInfraProviderNotFound("invalid").Result(), result)
+
+	result = handler(ctx, NewViewReceiptsMsg(string(app1), receipts))
+	assert.Equal(t, sdk.Result{}, result)
+
+	postMeta, err := pm.postStorage.GetPostMeta(ctx, types.GetPermlink(author, postID))
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), postMeta.TotalViewCount)
+
+	consumptionWeight, err := dm.GetConsumptionWeight(ctx, app1)
+	assert.Nil(t, err)
+	assert.True(t, consumptionWeight.Equal(sdk.OneRat()))
+	consumptionWeight, err = dm.GetConsumptionWeight(ctx, app2)
+	assert.Nil(t, err)
+	assert.True(t, consumptionWeight.IsZero())
+
+	usageWeight, err := im.GetUsageWeight(ctx, "infra1")
+	assert.Nil(t, err)
+	assert.True(t, usageWeight.Equal(sdk.NewRat(2, 3).Round(types.PrecisionFactor)))
+	usageWeight, err = im.GetUsageWeight(ctx, "infra2")
+	assert.Nil(t, err)
+	assert.True(t, usageWeight.Equal(sdk.NewRat(1, 3).Round(types.PrecisionFactor)))
+}
+
+func TestHandlerCreateCensoredPost(t *testing.T) {
+	ctx, am, _, pm, gm, dm := setupTest(t, 1)
+	handler := NewHandler(pm, am, gm, dm, newTestInfraManager())
+	user, postID := createTestPost(t, ctx, "user", "postID", am, pm, "0")
+	user2, postID2 := createTestPost(t, ctx, "user2", "postID2", am, pm, "0")
+	permlink := types.GetPermlink(user, postID)
+	permlink2 := types.GetPermlink(user2, postID2)
+
+	err := pm.CensorPost(ctx, permlink, types.ProposalKey("1"))
+	assert.Nil(t, err)
+	err = pm.DeletePost(ctx, permlink2)
+	assert.Nil(t, err)
+
+	testCases := []struct {
+		testName     string
+		author       types.AccountKey
+		postID       string
+		expectResult sdk.Result
+	}{
+		{
+			testName:     "author can't publish censored post again",
+			author:       user,
+			postID:       postID,
+			expectResult: ErrPostCensored(permlink).Result(),
+		},
+		{
+			testName:     "author can't publish deleted post again",
+			author:       user2,
+			postID:       postID2,
+			expectResult: ErrPostAlreadyExist(permlink2).Result(),
+		},
+	}
+	for _, tc := range testCases {
+		msg := CreatePostMsg{
+			PostID:                  tc.postID,
+			Title:                   string(make([]byte, 50)),
+			Content:                 string(make([]byte, 1000)),
+			Author:                  tc.author,
+			RedistributionSplitRate: "0",
+		}
+		result := handler(ctx, msg)
+		if !assert.Equal(t, tc.expectResult, result) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectResult)
+		}
+	}
+}
+
 func TestHandlerRepost(t *testing.T) {
 	ctx, am, ph, pm, gm, dm := setupTest(t, 1)
-	handler := NewHandler(pm, am, gm, dm)
+	handler := NewHandler(pm, am, gm, dm, newTestInfraManager())
 	postParam, err := ph.GetPostParam(ctx)
 	assert.Nil(t, err)
 
@@ -308,6 +588,7 @@ func TestHandlerRepost(t *testing.T) {
 		SourceAuthor: msg.SourceAuthor,
 		SourcePostID: msg.SourcePostID,
 		Links:        msg.Links,
+		RepostChain:  []model.RepostHop{{Author: user, PostID: postID}},
 	}
 
 	postMeta := model.PostMeta{
@@ -346,12 +627,13 @@ func TestHandlerRepost(t *testing.T) {
 	}
 	postInfo.SourceAuthor = user
 	postInfo.SourcePostID = postID
+	postInfo.RepostChain = []model.RepostHop{{Author: user, PostID: "repost"}, {Author: user, PostID: postID}}
 	checkPostKVStore(t, ctx, types.GetPermlink(user, postInfo.PostID), postInfo, postMeta)
 }
 
 func TestHandlerPostDonate(t *testing.T) {
 	ctx, am, ph, pm, gm, dm := setupTest(t, 1)
-	handler := NewHandler(pm, am, gm, dm)
+	handler := NewHandler(pm, am, gm, dm, newTestInfraManager())
 
 	accParam, err := ph.GetAccountParam(ctx)
 	assert.Nil(t, err)
@@ -423,6 +705,7 @@ func TestHandlerPostDonate(t *testing.T) {
 				Original:   types.NewCoinFromInt64(100 * types.Decimals),
 				Friction:   types.NewCoinFromInt64(5 * types.Decimals),
 				FromApp:    "",
+				ConsumedAt: ctx.BlockHeader().Time.Unix(),
 			},
 			expectDonateTimesFromUserToAuthor: 1,
 			expectCumulativeConsumption:       types.NewCoinFromInt64(100 * types.Decimals),
@@ -479,6 +762,7 @@ func TestHandlerPostDonate(t *testing.T) {
 				Original:   types.NewCoinFromInt64(50 * types.Decimals),
 				Friction:   types.NewCoinFromInt64(250000),
 				FromApp:    "",
+				ConsumedAt: ctx.BlockHeader().Time.Unix(),
 			},
 			expectDonateTimesFromUserToAuthor: 1,
 			expectCumulativeConsumption:       types.NewCoinFromInt64(150 * types.Decimals),
@@ -515,6 +799,7 @@ func TestHandlerPostDonate(t *testing.T) {
 				Original:   types.NewCoinFromInt64(50 * types.Decimals),
 				Friction:   types.NewCoinFromInt64(250000),
 				FromApp:    "",
+				ConsumedAt: ctx.BlockHeader().Time.Unix(),
 			},
 			expectDonateTimesFromUserToAuthor: 2,
 			expectCumulativeConsumption:       types.NewCoinFromInt64(200 * types.Decimals),
@@ -551,6 +836,7 @@ func TestHandlerPostDonate(t *testing.T) {
 				Original:   types.NewCoinFromInt64(1),
 				Friction:   types.NewCoinFromInt64(0),
 				FromApp:    "",
+				ConsumedAt: ctx.BlockHeader().Time.Unix(),
 			},
 			expectDonateTimesFromUserToAuthor: 1,
 			expectCumulativeConsumption:       types.NewCoinFromInt64(20000001),
@@ -705,9 +991,73 @@ func TestHandlerPostDonate(t *testing.T) {
 	}
 }
 
+func TestHandlerDonateWithMatchingPool(t *testing.T) {
+	ctx, am, ph, pm, gm, dm := setupTest(t, 1)
+	handler := NewHandler(pm, am, gm, dm, newTestInfraManager())
+
+	accParam, err := ph.GetAccountParam(ctx)
+	assert.Nil(t, err)
+
+	author, postID := createTestPost(t, ctx, "author", "postID", am, pm, "0")
+	user := createTestAccount(t, ctx, am, "user")
+	err = am.AddSavingCoin(
+		ctx, user, types.NewCoinFromInt64(100*types.Decimals), referrer, "", types.TransferIn)
+	assert.Nil(t, err)
+	app := createTestAccount(t, ctx, am, "app")
+	err = dm.RegisterDeveloper(ctx, app, types.NewCoinFromInt64(1000000*types.Decimals), "", "", "")
+	assert.Nil(t, err)
+	err = dm.OpenMatchingPool(
+		ctx, app, types.NewCoinFromInt64(10*types.Decimals), types.NewCoinFromInt64(5*types.Decimals),
+		ctx.BlockHeader().Time.Unix()+3600)
+	assert.Nil(t, err)
+
+	testCases := []struct {
+		testName           string
+		fromApp            string
+		expectAuthorSaving types.Coin
+		expectRemaining    types.Coin
+	}{
+		{
+			testName: "donation without app is not matched",
+			fromApp:  "",
+			expectAuthorSaving: accParam.RegisterFee.Plus(
+				types.NewCoinFromInt64(19 * types.Decimals)),
+			expectRemaining: types.NewCoinFromInt64(10 * types.Decimals),
+		},
+		{
+			testName: "donation from app is matched up to per user cap",
+			fromApp:  string(app),
+			expectAuthorSaving: accParam.RegisterFee.Plus(
+				types.NewCoinFromInt64(4275 * types.Decimals / 100)),
+			expectRemaining: types.NewCoinFromInt64(5 * types.Decimals),
+		},
+		{
+			testName: "user reaches per user cap",
+			fromApp:  string(app),
+			expectAuthorSaving: accParam.RegisterFee.Plus(
+				types.NewCoinFromInt64(6175 * types.Decimals / 100)),
+			expectRemaining: types.NewCoinFromInt64(5 * types.Decimals),
+		},
+	}
+	for _, tc := range testCases {
+		result := handler(ctx, NewDonateMsg(string(user), types.LNO("20"), string(author), postID, tc.fromApp, ""))
+		assert.Equal(t, sdk.Result{}, result)
+		saving, err := am.GetSavingFromBank(ctx, author)
+		assert.Nil(t, err)
+		if !tc.expectAuthorSaving.IsEqual(saving) {
+			t.Errorf("%s: diff author saving, got %v, want %v", tc.testName, saving, tc.expectAuthorSaving)
+		}
+		pool, err := dm.GetMatchingPool(ctx, app)
+		assert.Nil(t, err)
+		if !tc.expectRemaining.IsEqual(pool.Remaining) {
+			t.Errorf("%s: diff remaining, got %v, want %v", tc.testName, pool.Remaining, tc.expectRemaining)
+		}
+	}
+}
+
 func TestHandlerRePostDonate(t *testing.T) {
 	ctx, am, _, pm, gm, dm := setupTest(t, 1)
-	handler := NewHandler(pm, am, gm, dm)
+	handler := NewHandler(pm, am, gm, dm, newTestInfraManager())
 
 	user1, postID := createTestPost(t, ctx, "user1", "postID", am, pm, "0.15")
 	user2 := createTestAccount(t, ctx, am, "user2")
@@ -751,6 +1101,7 @@ func TestHandlerRePostDonate(t *testing.T) {
 		SourceAuthor: msg.SourceAuthor,
 		SourcePostID: msg.SourcePostID,
 		Links:        msg.Links,
+		RepostChain:  []model.RepostHop{{Author: user1, PostID: postID}},
 	}
 	totalReward := types.RatToCoin(sdk.NewRat(15 * types.Decimals).Mul(sdk.NewRat(95, 100)))
 	postMeta := model.PostMeta{
@@ -773,6 +1124,7 @@ func TestHandlerRePostDonate(t *testing.T) {
 		Original:   types.NewCoinFromInt64(15 * types.Decimals),
 		Friction:   types.NewCoinFromInt64(75000),
 		FromApp:    "",
+		ConsumedAt: ctx.BlockHeader().Time.Unix(),
 	}
 	assert.Equal(t, repostRewardEvent, eventList.Events[1])
 
@@ -782,6 +1134,7 @@ func TestHandlerRePostDonate(t *testing.T) {
 	postInfo.PostID = postID
 	postInfo.SourceAuthor = ""
 	postInfo.SourcePostID = ""
+	postInfo.RepostChain = nil
 	postMeta.RedistributionSplitRate = sdk.NewRat(3, 20)
 	postMeta.TotalUpvoteStake = types.NewCoinFromInt64(0)
 
@@ -804,13 +1157,401 @@ func TestHandlerRePostDonate(t *testing.T) {
 		Original:   types.NewCoinFromInt64(85 * types.Decimals),
 		Friction:   types.NewCoinFromInt64(425000),
 		FromApp:    "",
+		ConsumedAt: ctx.BlockHeader().Time.Unix(),
+		Repost:     types.GetPermlink(user2, "repost"),
+		Hop:        1,
 	}
 	assert.Equal(t, sourceRewardEvent, eventList.Events[0])
+
+	royalty, err := pm.postStorage.GetPostRepostRoyalty(
+		ctx, types.GetPermlink(user1, postID), types.GetPermlink(user2, "repost"))
+	assert.Nil(t, err)
+	assert.Equal(t, model.RepostRoyalty{
+		Repost:        types.GetPermlink(user2, "repost"),
+		Hop:           1,
+		Times:         1,
+		DirectDeposit: acc1SavingCoin,
+		Inflation:     types.NewCoinFromInt64(0),
+	}, *royalty)
+}
+
+func TestHandlerRepostChainDonate(t *testing.T) {
+	ctx, am, _, pm, gm, dm := setupTest(t, 1)
+	handler := NewHandler(pm, am, gm, dm, newTestInfraManager())
+
+	user1, postID := createTestPost(t, ctx, "user1", "postID", am, pm, "0.5")
+	user2 := createTestAccount(t, ctx, am, "user2")
+	user3 := createTestAccount(t, ctx, am, "user3")
+	user4 := createTestAccount(t, ctx, am, "user4")
+	err := am.AddSavingCoin(
+		ctx, user4, types.NewCoinFromInt64(100*types.Decimals),
+		referrer, "", types.TransferIn)
+	assert.Nil(t, err)
+	// user2 reposts root and user3 reposts the repost
+	msg := CreatePostMsg{
+		PostID:       "repost",
+		Title:        string(make([]byte, 50)),
+		Content:      string(make([]byte, 1000)),
+		Author:       user2,
+		SourceAuthor: user1,
+		SourcePostID: postID,
+		RedistributionSplitRate: "0",
+	}
+	result := handler(ctx, msg)
+	assert.Equal(t, result, sdk.Result{})
+	msg.Author = user3
+	msg.SourceAuthor = user2
+	msg.SourcePostID = "repost"
+	result = handler(ctx, msg)
+	assert.Equal(t, result, sdk.Result{})
+
+	result = handler(ctx, NewDonateMsg(
+		string(user4), types.LNO("100"), string(user3), "repost", "", memo1))
+	assert.Equal(t, result, sdk.Result{})
+
+	// root gets 50, the rest 50 cascades: user3 keeps 40 and passes 10 to user2
+	afterFriction := sdk.NewRat(95, 100)
+	expectSavings := map[types.AccountKey]int64{user1: 50, user2: 10, user3: 40}
+	for user, amount := range expectSavings {
+		saving, err := am.GetSavingFromBank(ctx, user)
+		assert.Nil(t, err)
+		assert.Equal(t,
+			initCoin.Plus(types.RatToCoin(sdk.NewRat(amount*types.Decimals).Mul(afterFriction))), saving)
+	}
+
+	repost := types.GetPermlink(user3, "repost")
+	royalty, err := pm.postStorage.GetPostRepostRoyalty(ctx, types.GetPermlink(user2, "repost"), repost)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), royalty.Hop)
+	assert.Equal(t, types.RatToCoin(sdk.NewRat(10*types.Decimals).Mul(afterFriction)), royalty.DirectDeposit)
+	royalty, err = pm.postStorage.GetPostRepostRoyalty(ctx, types.GetPermlink(user1, postID), repost)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), royalty.Hop)
+	assert.Equal(t, types.RatToCoin(sdk.NewRat(50*types.Decimals).Mul(afterFriction)), royalty.DirectDeposit)
+}
+
+func TestHandlerTip(t *testing.T) {
+	ctx, am, ph, pm, gm, dm := setupTest(t, 1)
+	handler := NewHandler(pm, am, gm, dm, newTestInfraManager())
+
+	accParam, err := ph.GetAccountParam(ctx)
+	assert.Nil(t, err)
+
+	streamer := createTestAccount(t, ctx, am, "streamer")
+	user := createTestAccount(t, ctx, am, "user")
+	err = am.AddSavingCoin(
+		ctx, user, types.NewCoinFromInt64(100*types.Decimals), referrer, "", types.TransferIn)
+	assert.Nil(t, err)
+
+	testCases := []struct {
+		testName             string
+		msg                  TipMsg
+		expectResult         sdk.Result
+		expectUserSaving     types.Coin
+		expectStreamerSaving types.Coin
+		expectRelationship   int64
+	}{
+		{
+			testName:             "tip to non-exist account",
+			msg:                  NewTipMsg(string(user), types.LNO("10"), "invalid", "live", ""),
+			expectResult:         ErrAccountNotFound("invalid").Result(),
+			expectUserSaving:     accParam.RegisterFee.Plus(types.NewCoinFromInt64(100 * types.Decimals)),
+			expectStreamerSaving: accParam.RegisterFee,
+			expectRelationship:   0,
+		},
+		{
+			testName:             "tip to self",
+			msg:                  NewTipMsg(string(user), types.LNO("10"), string(user), "live", ""),
+			expectResult:         ErrCannotDonateToSelf(user).Result(),
+			expectUserSaving:     accParam.RegisterFee.Plus(types.NewCoinFromInt64(100 * types.Decimals)),
+			expectStreamerSaving: accParam.RegisterFee,
+			expectRelationship:   0,
+		},
+		{
+			testName:             "tip from non-exist app",
+			msg:                  NewTipMsg(string(user), types.LNO("10"), string(streamer), "live", "invalidApp"),
+			expectResult:         ErrDeveloperNotFound("invalidApp").Result(),
+			expectUserSaving:     accParam.RegisterFee.Plus(types.NewCoinFromInt64(100 * types.Decimals)),
+			expectStreamerSaving: accParam.RegisterFee,
+			expectRelationship:   0,
+		},
+		{
+			testName:             "tip to streamer",
+			msg:                  NewTipMsg(string(user), types.LNO("10"), string(streamer), "live", ""),
+			expectResult:         sdk.Result{},
+			expectUserSaving:     accParam.RegisterFee.Plus(types.NewCoinFromInt64(90 * types.Decimals)),
+			expectStreamerSaving: accParam.RegisterFee.Plus(types.NewCoinFromInt64(95 * types.Decimals / 10)),
+			expectRelationship:   1,
+		},
+		{
+			testName:             "tip to streamer again",
+			msg:                  NewTipMsg(string(user), types.LNO("10"), string(streamer), "", ""),
+			expectResult:         sdk.Result{},
+			expectUserSaving:     accParam.RegisterFee.Plus(types.NewCoinFromInt64(80 * types.Decimals)),
+			expectStreamerSaving: accParam.RegisterFee.Plus(types.NewCoinFromInt64(19 * types.Decimals)),
+			expectRelationship:   2,
+		},
+	}
+
+	for _, tc := range testCases {
+		result := handler(ctx, tc.msg)
+		if !assert.Equal(t, tc.expectResult, result) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectResult)
+		}
+		saving, err := am.GetSavingFromBank(ctx, user)
+		if err != nil {
+			t.Errorf("%s: failed to get saving from bank, got err %v", tc.testName, err)
+		}
+		if !saving.IsEqual(tc.expectUserSaving) {
+			t.Errorf("%s: diff user saving, got %v, want %v", tc.testName, saving, tc.expectUserSaving)
+		}
+		saving, err = am.GetSavingFromBank(ctx, streamer)
+		if err != nil {
+			t.Errorf("%s: failed to get saving from bank, got err %v", tc.testName, err)
+		}
+		if !saving.IsEqual(tc.expectStreamerSaving) {
+			t.Errorf("%s: diff streamer saving, got %v, want %v", tc.testName, saving, tc.expectStreamerSaving)
+		}
+		relationship, err := am.GetDonationRelationship(ctx, user, streamer)
+		if err != nil {
+			t.Errorf("%s: failed to get donation relationship, got err %v", tc.testName, err)
+		}
+		if relationship != tc.expectRelationship {
+			t.Errorf("%s: diff donation relationship, got %v, want %v", tc.testName, relationship, tc.expectRelationship)
+		}
+	}
+}
+
+func TestHandlerUnlockPost(t *testing.T) {
+	ctx, am, ph, pm, gm, dm := setupTest(t, 1)
+	handler := NewHandler(pm, am, gm, dm, newTestInfraManager())
+
+	accParam, err := ph.GetAccountParam(ctx)
+	assert.Nil(t, err)
+
+	author, postID := createTestPost(t, ctx, "author", "postID", am, pm, "0")
+	permlink := types.GetPermlink(author, postID)
+	err = pm.SetPostAccess(ctx, permlink, types.UnlockAccess, types.NewCoinFromInt64(10*types.Decimals))
+	assert.Nil(t, err)
+	author1, publicPostID := createTestPost(t, ctx, "author1", "public", am, pm, "0")
+
+	user := createTestAccount(t, ctx, am, "user")
+	err = am.AddSavingCoin(
+		ctx, user, types.NewCoinFromInt64(100*types.Decimals), referrer, "", types.TransferIn)
+	assert.Nil(t, err)
+	coAuthor := createTestAccount(t, ctx, am, "coauthor")
+	err = pm.SetCoAuthors(ctx, permlink, []model.CoAuthor{
+		{Username: author, Weight: sdk.NewRat(1, 2), Approved: true},
+		{Username: coAuthor, Weight: sdk.NewRat(1, 2), Approved: true},
+	})
+	assert.Nil(t, err)
+
+	testCases := []struct {
+		testName           string
+		msg                UnlockPostMsg
+		expectResult       sdk.Result
+		expectUnlocked     bool
+		expectUserSaving   types.Coin
+		expectAuthorSaving types.Coin
+	}{
+		{
+			testName:           "author can't unlock own post",
+			msg:                NewUnlockPostMsg(string(author), string(author), postID, types.LNO("10"), ""),
+			expectResult:       ErrCannotUnlockOwnPost(author).Result(),
+			expectUnlocked:     false,
+			expectUserSaving:   accParam.RegisterFee.Plus(types.NewCoinFromInt64(100 * types.Decimals)),
+			expectAuthorSaving: accParam.RegisterFee,
+		},
+		{
+			testName:           "co-author can't unlock own post",
+			msg:                NewUnlockPostMsg(string(coAuthor), string(author), postID, types.LNO("10"), ""),
+			expectResult:       ErrCannotUnlockOwnPost(coAuthor).Result(),
+			expectUnlocked:     false,
+			expectUserSaving:   accParam.RegisterFee.Plus(types.NewCoinFromInt64(100 * types.Decimals)),
+			expectAuthorSaving: accParam.RegisterFee,
+		},
+		{
+			testName:           "public post can't be unlocked",
+			msg:                NewUnlockPostMsg(string(user), string(author1), publicPostID, types.LNO("10"), ""),
+			expectResult:       ErrPostNotUnlockable(types.GetPermlink(author1, publicPostID)).Result(),
+			expectUnlocked:     false,
+			expectUserSaving:   accParam.RegisterFee.Plus(types.NewCoinFromInt64(100 * types.Decimals)),
+			expectAuthorSaving: accParam.RegisterFee,
+		},
+		{
+			testName:           "unlock amount doesn't match price",
+			msg:                NewUnlockPostMsg(string(user), string(author), postID, types.LNO("5"), ""),
+			expectResult:       ErrUnlockPriceMismatch(permlink).Result(),
+			expectUnlocked:     false,
+			expectUserSaving:   accParam.RegisterFee.Plus(types.NewCoinFromInt64(100 * types.Decimals)),
+			expectAuthorSaving: accParam.RegisterFee,
+		},
+		{
+			testName:           "unlock post",
+			msg:                NewUnlockPostMsg(string(user), string(author), postID, types.LNO("10"), ""),
+			expectResult:       sdk.Result{},
+			expectUnlocked:     true,
+			expectUserSaving:   accParam.RegisterFee.Plus(types.NewCoinFromInt64(90 * types.Decimals)),
+			expectAuthorSaving: accParam.RegisterFee.Plus(types.NewCoinFromInt64(95 * types.Decimals / 10)),
+		},
+		{
+			testName:           "unlock post again",
+			msg:                NewUnlockPostMsg(string(user), string(author), postID, types.LNO("10"), ""),
+			expectResult:       ErrPostAlreadyUnlocked(user, permlink).Result(),
+			expectUnlocked:     true,
+			expectUserSaving:   accParam.RegisterFee.Plus(types.NewCoinFromInt64(90 * types.Decimals)),
+			expectAuthorSaving: accParam.RegisterFee.Plus(types.NewCoinFromInt64(95 * types.Decimals / 10)),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := handler(ctx, tc.msg)
+		if !assert.Equal(t, tc.expectResult, result) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectResult)
+		}
+		if pm.HasUnlocked(ctx, user, permlink) != tc.expectUnlocked {
+			t.Errorf("%s: diff unlocked, want %v", tc.testName, tc.expectUnlocked)
+		}
+		saving, err := am.GetSavingFromBank(ctx, user)
+		if err != nil {
+			t.Errorf("%s: failed to get saving from bank, got err %v", tc.testName, err)
+		}
+		if !saving.IsEqual(tc.expectUserSaving) {
+			t.Errorf("%s: diff user saving, got %v, want %v", tc.testName, saving, tc.expectUserSaving)
+		}
+		saving, err = am.GetSavingFromBank(ctx, author)
+		if err != nil {
+			t.Errorf("%s: failed to get saving from bank, got err %v", tc.testName, err)
+		}
+		if !saving.IsEqual(tc.expectAuthorSaving) {
+			t.Errorf("%s: diff author saving, got %v, want %v", tc.testName, saving, tc.expectAuthorSaving)
+		}
+	}
+}
+
+func TestHandlerCoAuthoredPost(t *testing.T) {
+	ctx, am, ph, pm, gm, dm := setupTest(t, 1)
+	handler := NewHandler(pm, am, gm, dm, newTestInfraManager())
+
+	accParam, err := ph.GetAccountParam(ctx)
+	assert.Nil(t, err)
+
+	author := createTestAccount(t, ctx, am, "author")
+	coAuthor1 := createTestAccount(t, ctx, am, "coauthor1")
+	coAuthor2 := createTestAccount(t, ctx, am, "coauthor2")
+	user := createTestAccount(t, ctx, am, "user")
+	err = am.AddSavingCoin(
+		ctx, user, types.NewCoinFromInt64(100*types.Decimals), referrer, "", types.TransferIn)
+	assert.Nil(t, err)
+
+	msg := CreatePostMsg{
+		PostID:                  "postID",
+		Title:                   string(make([]byte, 50)),
+		Content:                 string(make([]byte, 1000)),
+		Author:                  author,
+		RedistributionSplitRate: "0",
+		CoAuthors: []CoAuthorWeight{
+			{Username: author, Weight: "0.5"},
+			{Username: coAuthor1, Weight: "0.3"},
+			{Username: "invalid", Weight: "0.2"},
+		},
+	}
+	result := handler(ctx, msg)
+	assert.Equal(t, ErrAccountNotFound("invalid").Result(), result)
+
+	msg.CoAuthors[2].Username = coAuthor2
+	result = handler(ctx, msg)
+	assert.Equal(t, sdk.Result{}, result)
+	permlink := types.GetPermlink(author, msg.PostID)
+
+	result = handler(ctx, NewApproveCoAuthorMsg(string(user), string(author), msg.PostID))
+	assert.Equal(t, ErrCoAuthorNotFound(user, permlink).Result(), result)
+	result = handler(ctx, NewApproveCoAuthorMsg(string(coAuthor1), string(author), msg.PostID))
+	assert.Equal(t, sdk.Result{}, result)
+	result = handler(ctx, NewApproveCoAuthorMsg(string(coAuthor1), string(author), msg.PostID))
+	assert.Equal(t, ErrCoAuthorAlreadyApproved(coAuthor1, permlink).Result(), result)
+
+	// direct deposit is split between author and approved co-author,
+	// share of unapproved co-author goes to author
+	result = handler(ctx, NewDonateMsg(string(user), types.LNO("10"), string(author), msg.PostID, "", ""))
+	assert.Equal(t, sdk.Result{}, result)
+
+	testCases := []struct {
+		testName     string
+		username     types.AccountKey
+		expectSaving types.Coin
+	}{
+		{
+			testName:     "author gets 70% direct deposit",
+			username:     author,
+			expectSaving: accParam.RegisterFee.Plus(types.NewCoinFromInt64(665 * types.Decimals / 100)),
+		},
+		{
+			testName:     "approved co-author gets 30% direct deposit",
+			username:     coAuthor1,
+			expectSaving: accParam.RegisterFee.Plus(types.NewCoinFromInt64(285 * types.Decimals / 100)),
+		},
+		{
+			testName:     "unapproved co-author gets nothing",
+			username:     coAuthor2,
+			expectSaving: accParam.RegisterFee,
+		},
+	}
+	for _, tc := range testCases {
+		saving, err := am.GetSavingFromBank(ctx, tc.username)
+		if err != nil {
+			t.Errorf("%s: failed to get saving from bank, got err %v", tc.testName, err)
+		}
+		if !saving.IsEqual(tc.expectSaving) {
+			t.Errorf("%s: diff saving, got %v, want %v", tc.testName, saving, tc.expectSaving)
+		}
+	}
+}
+
+func TestHandlerDonateRewardEstimate(t *testing.T) {
+	ctx, am, _, pm, gm, dm := setupTest(t, 1)
+	handler := NewHandler(pm, am, gm, dm, newTestInfraManager())
+	author, postID := createTestPost(t, ctx, "author", "postID", am, pm, "0")
+	user := createTestAccount(t, ctx, am, "user")
+	permlink := types.GetPermlink(author, postID)
+	coin := types.NewCoinFromInt64(10 * types.Decimals)
+
+	estimate, err := pm.EstimateDonationReward(ctx, permlink, user, coin, am, gm)
+	assert.Nil(t, err)
+	assert.Equal(t, user, estimate.Consumer)
+	assert.True(t, estimate.ExpectedReward.IsPositive())
+	estimates, err := pm.GetPendingRewardEstimates(ctx, permlink, am, gm)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(estimates))
+
+	result := handler(ctx, NewDonateMsg(string(user), types.LNO("10"), string(author), postID, "", ""))
+	assert.Equal(t, sdk.Result{}, result)
+
+	// pending reward of the donation is estimated as the hypothetical donation
+	estimates, err = pm.GetPendingRewardEstimates(ctx, permlink, am, gm)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(estimates))
+	assert.Equal(t, user, estimates[0].Consumer)
+	assert.True(t, coin.IsEqual(estimates[0].Original))
+	assert.Equal(t, estimate.RewardAt, estimates[0].RewardAt)
+	assert.True(t, estimates[0].ExpectedReward.IsPositive())
+
+	// pending reward is removed after reward event is executed
+	ctx = ctx.WithBlockHeader(abci.Header{ChainID: "Lino", Time: time.Unix(estimate.RewardAt, 0)})
+	eventList := gm.GetTimeEventListAtTime(ctx, estimate.RewardAt)
+	assert.Equal(t, 1, len(eventList.Events))
+	rewardEvent, ok := eventList.Events[0].(RewardEvent)
+	assert.True(t, ok)
+	err = rewardEvent.Execute(ctx, pm, am, gm, dm)
+	assert.Nil(t, err)
+	estimates, err = pm.GetPendingRewardEstimates(ctx, permlink, am, gm)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(estimates))
 }
 
 func TestHandlerReportOrUpvote(t *testing.T) {
 	ctx, am, ph, pm, gm, dm := setupTest(t, 1)
-	handler := NewHandler(pm, am, gm, dm)
+	handler := NewHandler(pm, am, gm, dm, newTestInfraManager())
 	coinDayParam, _ := ph.GetCoinDayParam(ctx)
 	accParam, _ := ph.GetAccountParam(ctx)
 	postParam, _ := ph.GetPostParam(ctx)
@@ -939,8 +1680,11 @@ func TestHandlerReportOrUpvote(t *testing.T) {
 }
 
 func TestHandlerView(t *testing.T) {
-	ctx, am, _, pm, gm, dm := setupTest(t, 1)
-	handler := NewHandler(pm, am, gm, dm)
+	ctx, am, ph, pm, gm, dm := setupTest(t, 1)
+	postParam, err := ph.GetPostParam(ctx)
+	assert.Nil(t, err)
+	viewDedupInterval := postParam.ViewDedupIntervalSec
+	handler := NewHandler(pm, am, gm, dm, newTestInfraManager())
 
 	createTime := ctx.BlockHeader().Time.Unix()
 	user1, postID := createTestPost(t, ctx, "user1", "postID", am, pm, "0")
@@ -954,6 +1698,7 @@ func TestHandlerView(t *testing.T) {
 		viewTime             int64
 		expectTotalViewCount int64
 		expectUserViewCount  int64
+		expectLastViewAt     int64
 	}{
 		{
 			testName:             "user3 views (postID, user1)",
@@ -963,15 +1708,27 @@ func TestHandlerView(t *testing.T) {
 			viewTime:             1,
 			expectTotalViewCount: 1,
 			expectUserViewCount:  1,
+			expectLastViewAt:     1,
 		},
 		{
-			testName:             "user3 views (postID, user1) again",
+			testName:             "user3 views (postID, user1) again within view dedup interval",
 			viewUser:             user3,
 			postID:               postID,
 			author:               user1,
 			viewTime:             2,
+			expectTotalViewCount: 1,
+			expectUserViewCount:  1,
+			expectLastViewAt:     1,
+		},
+		{
+			testName:             "user3 views (postID, user1) again after view dedup interval",
+			viewUser:             user3,
+			postID:               postID,
+			author:               user1,
+			viewTime:             1 + viewDedupInterval,
 			expectTotalViewCount: 2,
 			expectUserViewCount:  2,
+			expectLastViewAt:     1 + viewDedupInterval,
 		},
 		{
 			testName:             "user2 views (postID, user1)",
@@ -981,15 +1738,17 @@ func TestHandlerView(t *testing.T) {
 			viewTime:             3,
 			expectTotalViewCount: 3,
 			expectUserViewCount:  1,
+			expectLastViewAt:     3,
 		},
 		{
-			testName:             "user2 views (postID, user1) again",
+			testName:             "user2 views (postID, user1) again after view dedup interval",
 			viewUser:             user2,
 			postID:               postID,
 			author:               user1,
-			viewTime:             4,
+			viewTime:             3 + viewDedupInterval,
 			expectTotalViewCount: 4,
 			expectUserViewCount:  2,
+			expectLastViewAt:     3 + viewDedupInterval,
 		},
 		{
 			testName:             "user1 views (postID, user1)",
@@ -999,6 +1758,7 @@ func TestHandlerView(t *testing.T) {
 			viewTime:             5,
 			expectTotalViewCount: 5,
 			expectUserViewCount:  1,
+			expectLastViewAt:     5,
 		},
 	}
 
@@ -1030,8 +1790,8 @@ func TestHandlerView(t *testing.T) {
 		if view.Times != tc.expectUserViewCount {
 			t.Errorf("%s: diff view times, got %v, want %v", tc.testName, view.Times, tc.expectUserViewCount)
 		}
-		if view.LastViewAt != tc.viewTime {
-			t.Errorf("%s: diff last view at, got %v, want %v", tc.testName, view.LastViewAt, tc.viewTime)
+		if view.LastViewAt != tc.expectLastViewAt {
+			t.Errorf("%s: diff last view at, got %v, want %v", tc.testName, view.LastViewAt, tc.expectLastViewAt)
 		}
 	}
 }
@@ -1,10 +1,16 @@
 package post
 
 import (
+	"math/big"
+	"sort"
+
 	"github.com/lino-network/lino/param"
 	"github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/x/global"
 	"github.com/lino-network/lino/x/post/model"
 
+	acc "github.com/lino-network/lino/x/account"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -60,30 +66,140 @@ func (pm PostManager) GetSourcePost(
 	return postInfo.SourceAuthor, postInfo.SourcePostID, nil
 }
 
-func (pm PostManager) setRootSourcePost(ctx sdk.Context, postInfo *model.PostInfo) sdk.Error {
+// setRepostChain - set source of repost to the root post and record upstream posts from
+// the direct source to the root. Chain longer than max repost chain depth keeps the
+// nearest upstream posts and the root.
+func (pm PostManager) setRepostChain(ctx sdk.Context, postInfo *model.PostInfo) sdk.Error {
 	if postInfo.SourceAuthor == types.AccountKey("") || postInfo.SourcePostID == "" {
 		return nil
 	}
 	permlink := types.GetPermlink(postInfo.Author, postInfo.PostID)
-	rootAuthor, rootPostID, err :=
-		pm.GetSourcePost(ctx, types.GetPermlink(postInfo.SourceAuthor, postInfo.SourcePostID))
+	sourceInfo, err :=
+		pm.postStorage.GetPostInfo(ctx, types.GetPermlink(postInfo.SourceAuthor, postInfo.SourcePostID))
 	if err != nil {
 		return ErrGetSourcePost(permlink)
 	}
-	if rootAuthor != types.AccountKey("") && rootPostID != "" {
-		postInfo.SourceAuthor = rootAuthor
-		postInfo.SourcePostID = rootPostID
+	postParam, err := pm.paramHolder.GetPostParam(ctx)
+	if err != nil {
+		return err
+	}
+	chain := []model.RepostHop{{Author: postInfo.SourceAuthor, PostID: postInfo.SourcePostID}}
+	if sourceInfo.SourceAuthor != types.AccountKey("") && sourceInfo.SourcePostID != "" {
+		postInfo.SourceAuthor = sourceInfo.SourceAuthor
+		postInfo.SourcePostID = sourceInfo.SourcePostID
+		upstream := sourceInfo.RepostChain
+		if len(upstream) == 0 {
+			// repost without recorded chain only knows its root
+			upstream = []model.RepostHop{{Author: sourceInfo.SourceAuthor, PostID: sourceInfo.SourcePostID}}
+		}
+		chain = append(chain, upstream...)
+	}
+	// root of the chain is always kept
+	maxDepth := postParam.MaxRepostChainDepth
+	if maxDepth < 1 {
+		maxDepth = 1
+	}
+	if int64(len(chain)) > maxDepth {
+		root := chain[len(chain)-1]
+		chain = append(chain[:maxDepth-1], root)
 	}
+	postInfo.RepostChain = chain
 	return nil
 }
 
+// RepostPayout - share of a donation paid to a post in repost chain,
+// hop is the distance from the post donated to
+type RepostPayout struct {
+	Author types.AccountKey
+	PostID string
+	Hop    int64
+	Amount types.Coin
+}
+
+// SplitRepostRoyalty - split donation to a post along its repost chain. Root post gets
+// its share by its redistribution split rate as before, the rest cascades from the post
+// up the chain: each post passes repost royalty rate of what it receives to the next
+// upstream post and keeps the remaining. Deleted upstream posts are skipped.
+// Root is always the first one in the result.
+func (pm PostManager) SplitRepostRoyalty(
+	ctx sdk.Context, author types.AccountKey, postID string,
+	coin types.Coin) ([]RepostPayout, sdk.Error) {
+	postInfo, err := pm.postStorage.GetPostInfo(ctx, types.GetPermlink(author, postID))
+	if err != nil {
+		return nil, err
+	}
+	payout := RepostPayout{Author: author, PostID: postID, Hop: 0, Amount: coin}
+	if postInfo.SourceAuthor == types.AccountKey("") || postInfo.SourcePostID == "" {
+		return []RepostPayout{payout}, nil
+	}
+	chain := postInfo.RepostChain
+	if len(chain) == 0 {
+		chain = []model.RepostHop{{Author: postInfo.SourceAuthor, PostID: postInfo.SourcePostID}}
+	}
+	redistributionSplitRate, err := pm.GetRedistributionSplitRate(
+		ctx, types.GetPermlink(postInfo.SourceAuthor, postInfo.SourcePostID))
+	if err != nil {
+		return nil, err
+	}
+	postParam, err := pm.paramHolder.GetPostParam(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rootIncome := types.RatToCoin(coin.ToRat().Mul(sdk.OneRat().Sub(redistributionSplitRate)))
+	payouts := []RepostPayout{{
+		Author: postInfo.SourceAuthor,
+		PostID: postInfo.SourcePostID,
+		Hop:    int64(len(chain)),
+		Amount: rootIncome,
+	}}
+	received := coin.Minus(rootIncome)
+	for i, hop := range chain[:len(chain)-1] {
+		if isDeleted, err := pm.IsDeleted(ctx, types.GetPermlink(hop.Author, hop.PostID)); isDeleted || err != nil {
+			continue
+		}
+		passed := types.RatToCoin(received.ToRat().Mul(postParam.RepostRoyaltyRate))
+		payout.Amount = received.Minus(passed)
+		payouts = append(payouts, payout)
+		payout = RepostPayout{Author: hop.Author, PostID: hop.PostID, Hop: int64(i + 1)}
+		received = passed
+	}
+	payout.Amount = received
+	return append(payouts, payout), nil
+}
+
+// AddRepostRoyalty - add royalty paid to post from donations to a repost
+func (pm PostManager) AddRepostRoyalty(
+	ctx sdk.Context, permlink types.Permlink, repost types.Permlink, hop int64,
+	amount types.Coin, donationType types.DonationType) sdk.Error {
+	royalty, err := pm.postStorage.GetPostRepostRoyalty(ctx, permlink, repost)
+	if err != nil {
+		return err
+	}
+	if royalty == nil {
+		royalty = &model.RepostRoyalty{
+			Repost:        repost,
+			Hop:           hop,
+			DirectDeposit: types.NewCoinFromInt64(0),
+			Inflation:     types.NewCoinFromInt64(0),
+		}
+	}
+	switch donationType {
+	case types.DirectDeposit:
+		royalty.Times++
+		royalty.DirectDeposit = royalty.DirectDeposit.Plus(amount)
+	case types.Inflation:
+		royalty.Inflation = royalty.Inflation.Plus(amount)
+	}
+	return pm.postStorage.SetPostRepostRoyalty(ctx, permlink, royalty)
+}
+
 // create the post
 func (pm PostManager) CreatePost(
 	ctx sdk.Context, author types.AccountKey, postID string,
 	sourceAuthor types.AccountKey, sourcePostID string,
 	parentAuthor types.AccountKey, parentPostID string,
 	content string, title string, redistributionSplitRate sdk.Rat,
-	links []types.IDToURLMapping) sdk.Error {
+	links []types.IDToURLMapping, tags []string) sdk.Error {
 	postInfo := &model.PostInfo{
 		PostID:       postID,
 		Title:        title,
@@ -94,12 +210,13 @@ func (pm PostManager) CreatePost(
 		SourceAuthor: sourceAuthor,
 		SourcePostID: sourcePostID,
 		Links:        links,
+		Tags:         tags,
 	}
 	permlink := types.GetPermlink(postInfo.Author, postInfo.PostID)
 	if pm.DoesPostExist(ctx, permlink) {
 		return ErrPostAlreadyExist(permlink)
 	}
-	if err := pm.setRootSourcePost(ctx, postInfo); err != nil {
+	if err := pm.setRepostChain(ctx, postInfo); err != nil {
 		return ErrCreatePostSourceInvalid(permlink)
 	}
 	if err := pm.postStorage.SetPostInfo(ctx, postInfo); err != nil {
@@ -116,13 +233,16 @@ func (pm PostManager) CreatePost(
 	if err := pm.postStorage.SetPostMeta(ctx, permlink, postMeta); err != nil {
 		return err
 	}
+	for _, tag := range tags {
+		pm.postStorage.SetPostTag(ctx, tag, postMeta.CreatedAt, permlink)
+	}
 	return nil
 }
 
-// UpdatePost - update post title, content and links. Can't update a deleted post
+// UpdatePost - update post title, content, links and tags. Can't update a deleted post
 func (pm PostManager) UpdatePost(
 	ctx sdk.Context, author types.AccountKey, postID, title, content string,
-	links []types.IDToURLMapping) sdk.Error {
+	links []types.IDToURLMapping, tags []string) sdk.Error {
 	permlink := types.GetPermlink(author, postID)
 	postInfo, err := pm.postStorage.GetPostInfo(ctx, permlink)
 	if err != nil {
@@ -136,6 +256,14 @@ func (pm PostManager) UpdatePost(
 	postInfo.Title = title
 	postInfo.Content = content
 	postInfo.Links = links
+	// re-index the post under the new tags, created time is kept
+	for _, tag := range postInfo.Tags {
+		pm.postStorage.RemovePostTag(ctx, tag, postMeta.CreatedAt, permlink)
+	}
+	for _, tag := range tags {
+		pm.postStorage.SetPostTag(ctx, tag, postMeta.CreatedAt, permlink)
+	}
+	postInfo.Tags = tags
 	// postMeta.RedistributionSplitRate = redistributionSplitRate
 	postMeta.LastUpdatedAt = ctx.BlockHeader().Time.Unix()
 
@@ -151,31 +279,59 @@ func (pm PostManager) UpdatePost(
 // AddOrUpdateViewToPost - add or update view from the user if view exists
 func (pm PostManager) AddOrUpdateViewToPost(
 	ctx sdk.Context, permlink types.Permlink, user types.AccountKey) sdk.Error {
+	_, err := pm.AddUniqueViewToPost(ctx, permlink, user)
+	return err
+}
+
+// AddUniqueViewToPost - add view from the user to the post, return false if the user
+// already viewed the post within view dedup interval and the view is not counted
+func (pm PostManager) AddUniqueViewToPost(
+	ctx sdk.Context, permlink types.Permlink, user types.AccountKey) (bool, sdk.Error) {
+	postParam, err := pm.paramHolder.GetPostParam(ctx)
+	if err != nil {
+		return false, err
+	}
 	postMeta, err := pm.postStorage.GetPostMeta(ctx, permlink)
 	if err != nil {
-		return err
+		return false, err
 	}
 	view, _ := pm.postStorage.GetPostView(ctx, permlink, user)
 	// override previous
 	if view == nil {
 		view = &model.View{Username: user}
+	} else if view.LastViewAt+postParam.ViewDedupIntervalSec > ctx.BlockHeader().Time.Unix() {
+		return false, nil
 	}
 	postMeta.TotalViewCount++
 	view.Times++
 	view.LastViewAt = ctx.BlockHeader().Time.Unix()
 	if err := pm.postStorage.SetPostView(ctx, permlink, view); err != nil {
-		return err
+		return false, err
 	}
 	if err := pm.postStorage.SetPostMeta(ctx, permlink, postMeta); err != nil {
-		return err
+		return false, err
 	}
-	return nil
+	return true, nil
 }
 
 // ReportOrUpvoteToPost - add or update report or upvote from the user if exist
 func (pm PostManager) ReportOrUpvoteToPost(
 	ctx sdk.Context, permlink types.Permlink, user types.AccountKey,
 	stake types.Coin, isReport bool) sdk.Error {
+	return pm.reportOrUpvoteToPost(
+		ctx, permlink, user, stake, isReport, types.ReportReasonUnspecified, "")
+}
+
+// ReportToPost - add or update report with reason and evidence from the user
+func (pm PostManager) ReportToPost(
+	ctx sdk.Context, permlink types.Permlink, user types.AccountKey,
+	stake types.Coin, reason types.ReportReason, evidence string) sdk.Error {
+	return pm.reportOrUpvoteToPost(ctx, permlink, user, stake, true, reason, evidence)
+}
+
+func (pm PostManager) reportOrUpvoteToPost(
+	ctx sdk.Context, permlink types.Permlink, user types.AccountKey,
+	stake types.Coin, isReport bool, reason types.ReportReason, evidence string) sdk.Error {
 	postMeta, err := pm.postStorage.GetPostMeta(ctx, permlink)
 	if err != nil {
 		return err
@@ -184,16 +340,35 @@ func (pm PostManager) ReportOrUpvoteToPost(
 
 	reportOrUpvote, _ := pm.postStorage.GetPostReportOrUpvote(ctx, permlink, user)
 
+	createdAt := ctx.BlockHeader().Time.Unix()
 	if reportOrUpvote != nil {
+		// keep the time of the first upvote or report so repeated upvotes
+		// and donations don't lose their curation earliness
+		if reportOrUpvote.IsReport == isReport {
+			createdAt = reportOrUpvote.CreatedAt
+		}
 		if reportOrUpvote.IsReport {
-			postMeta.TotalReportStake = postMeta.TotalReportStake.Minus(reportOrUpvote.Stake)
+			// rejected report has been removed from total report stake
+			if !reportOrUpvote.Rejected {
+				postMeta.TotalReportStake = postMeta.TotalReportStake.Minus(reportOrUpvote.Stake)
+			}
 		} else {
 			postMeta.TotalUpvoteStake = postMeta.TotalUpvoteStake.Minus(reportOrUpvote.Stake)
 		}
 	}
-	reportOrUpvote =
-		&model.ReportOrUpvote{Username: user, Stake: stake, CreatedAt: ctx.BlockHeader().Time.Unix()}
+	reportOrUpvote = &model.ReportOrUpvote{Username: user, Stake: stake, CreatedAt: createdAt}
 	if isReport {
+		// report weight is lowered by the number of rejected reports of the user
+		reporterStat, err := pm.postStorage.GetReporterStat(ctx, user)
+		if err != nil {
+			return err
+		}
+		if reporterStat.RejectedReports > 0 {
+			reportOrUpvote.Stake = types.RatToCoin(
+				stake.ToRat().Quo(sdk.NewRat(1+reporterStat.RejectedReports, 1)))
+		}
+		reportOrUpvote.Reason = reason
+		reportOrUpvote.Evidence = evidence
 		postMeta.TotalReportStake = postMeta.TotalReportStake.Plus(reportOrUpvote.Stake)
 		reportOrUpvote.IsReport = true
 	} else {
@@ -206,6 +381,8 @@ func (pm PostManager) ReportOrUpvoteToPost(
 	if err := pm.postStorage.SetPostMeta(ctx, permlink, postMeta); err != nil {
 		return err
 	}
+	// cached penalty score is stale after the vote changes
+	pm.postStorage.DeletePostPenaltyScore(ctx, permlink)
 	return nil
 }
 
@@ -257,7 +434,32 @@ func (pm PostManager) AddDonation(
 	return nil
 }
 
-// DeletePost - delete post by author or content censorship
+// CensorPost - remove post content by content censorship proposal, the post is kept
+// as a tombstone with the proposal ID and the permlink can't be used again
+func (pm PostManager) CensorPost(
+	ctx sdk.Context, permlink types.Permlink, proposalID types.ProposalKey) sdk.Error {
+	if err := pm.DeletePost(ctx, permlink); err != nil {
+		return err
+	}
+	postMeta, err := pm.postStorage.GetPostMeta(ctx, permlink)
+	if err != nil {
+		return err
+	}
+	postMeta.IsCensored = true
+	postMeta.CensoredBy = proposalID
+	return pm.postStorage.SetPostMeta(ctx, permlink, postMeta)
+}
+
+// IsCensored - check if post is censored by governance
+func (pm PostManager) IsCensored(ctx sdk.Context, permlink types.Permlink) (bool, sdk.Error) {
+	postMeta, err := pm.postStorage.GetPostMeta(ctx, permlink)
+	if err != nil {
+		return false, err
+	}
+	return postMeta.IsCensored, nil
+}
+
+// DeletePost - delete post by author, content censorship uses CensorPost
 func (pm PostManager) DeletePost(ctx sdk.Context, permlink types.Permlink) sdk.Error {
 	postMeta, err := pm.postStorage.GetPostMeta(ctx, permlink)
 	if err != nil {
@@ -276,6 +478,10 @@ func (pm PostManager) DeletePost(ctx sdk.Context, permlink types.Permlink) sdk.E
 	postInfo.Title = ""
 	postInfo.Content = ""
 	postInfo.Links = nil
+	for _, tag := range postInfo.Tags {
+		pm.postStorage.RemovePostTag(ctx, tag, postMeta.CreatedAt, permlink)
+	}
+	postInfo.Tags = nil
 
 	if err := pm.postStorage.SetPostInfo(ctx, postInfo); err != nil {
 		return err
@@ -283,6 +489,307 @@ func (pm PostManager) DeletePost(ctx sdk.Context, permlink types.Permlink) sdk.E
 	return nil
 }
 
+// GetPostsByTag - get most recent posts under the tag
+func (pm PostManager) GetPostsByTag(ctx sdk.Context, tag string, limit int) []types.Permlink {
+	return pm.postStorage.GetPostsByTag(ctx, tag, limit)
+}
+
+// SetReplyMode - set who can reply to a post
+func (pm PostManager) SetReplyMode(
+	ctx sdk.Context, permlink types.Permlink, mode types.ReplyMode) sdk.Error {
+	postMeta, err := pm.postStorage.GetPostMeta(ctx, permlink)
+	if err != nil {
+		return err
+	}
+	postMeta.ReplyMode = mode
+	postMeta.AllowReplies = mode != types.ReplyNobody
+	return pm.postStorage.SetPostMeta(ctx, permlink, postMeta)
+}
+
+// GetReplyMode - get who can reply to a post
+func (pm PostManager) GetReplyMode(ctx sdk.Context, permlink types.Permlink) (types.ReplyMode, sdk.Error) {
+	postMeta, err := pm.postStorage.GetPostMeta(ctx, permlink)
+	if err != nil {
+		return types.ReplyEveryone, err
+	}
+	if !postMeta.AllowReplies {
+		return types.ReplyNobody, nil
+	}
+	return postMeta.ReplyMode, nil
+}
+
+// GetParentPost - get parent post of a comment, empty if the post is not a comment
+func (pm PostManager) GetParentPost(
+	ctx sdk.Context, permlink types.Permlink) (types.AccountKey, string, sdk.Error) {
+	postInfo, err := pm.postStorage.GetPostInfo(ctx, permlink)
+	if err != nil {
+		return types.AccountKey(""), "", err
+	}
+	return postInfo.ParentAuthor, postInfo.ParentPostID, nil
+}
+
+// GetComments - get comments of a post, comments hidden by the post author
+// are excluded unless include hidden is set
+func (pm PostManager) GetComments(
+	ctx sdk.Context, permlink types.Permlink, includeHidden bool) ([]model.Comment, sdk.Error) {
+	comments, err := pm.postStorage.GetPostComments(ctx, permlink)
+	if err != nil {
+		return nil, err
+	}
+	if includeHidden {
+		return comments, nil
+	}
+	visibleComments := []model.Comment{}
+	for _, comment := range comments {
+		postMeta, err := pm.postStorage.GetPostMeta(ctx, types.GetPermlink(comment.Author, comment.PostID))
+		if err != nil {
+			return nil, err
+		}
+		if postMeta.IsHidden {
+			continue
+		}
+		visibleComments = append(visibleComments, comment)
+	}
+	return visibleComments, nil
+}
+
+// SetCommentHidden - hide or unhide a comment, hidden comment is still a valid post
+func (pm PostManager) SetCommentHidden(
+	ctx sdk.Context, permlink types.Permlink, isHidden bool) sdk.Error {
+	postMeta, err := pm.postStorage.GetPostMeta(ctx, permlink)
+	if err != nil {
+		return err
+	}
+	postMeta.IsHidden = isHidden
+	return pm.postStorage.SetPostMeta(ctx, permlink, postMeta)
+}
+
+// SetPostAccess - restrict full content of a post to unlocked users or subscribers
+func (pm PostManager) SetPostAccess(
+	ctx sdk.Context, permlink types.Permlink, mode types.PostAccessMode, unlockPrice types.Coin) sdk.Error {
+	postAccess := &model.PostAccess{
+		Mode:        mode,
+		UnlockPrice: unlockPrice,
+	}
+	return pm.postStorage.SetPostAccess(ctx, permlink, postAccess)
+}
+
+// GetPostAccess - get post access mode and unlock price, post is public by default
+func (pm PostManager) GetPostAccess(
+	ctx sdk.Context, permlink types.Permlink) (types.PostAccessMode, types.Coin, sdk.Error) {
+	postAccess, err := pm.postStorage.GetPostAccess(ctx, permlink)
+	if err != nil {
+		return types.PublicAccess, types.NewCoinFromInt64(0), err
+	}
+	if postAccess == nil {
+		return types.PublicAccess, types.NewCoinFromInt64(0), nil
+	}
+	return postAccess.Mode, postAccess.UnlockPrice, nil
+}
+
+// HasUnlocked - check if user has paid to unlock the post
+func (pm PostManager) HasUnlocked(ctx sdk.Context, user types.AccountKey, permlink types.Permlink) bool {
+	return pm.postStorage.DoesUnlockReceiptExist(ctx, user, permlink)
+}
+
+// GetUserPostAccess - get access mode of a post and if user can access its full content.
+// Authors and approved co-authors can always access their post, others need to unlock
+// the post or subscribe to the author based on access mode
+func (pm PostManager) GetUserPostAccess(
+	ctx sdk.Context, user types.AccountKey, permlink types.Permlink,
+	am acc.AccountManager) (*model.PostAccessResult, sdk.Error) {
+	postInfo, err := pm.postStorage.GetPostInfo(ctx, permlink)
+	if err != nil {
+		return nil, err
+	}
+	accessMode, unlockPrice, err := pm.GetPostAccess(ctx, permlink)
+	if err != nil {
+		return nil, err
+	}
+	result := &model.PostAccessResult{
+		Username:    user,
+		Permlink:    permlink,
+		AccessMode:  accessMode,
+		UnlockPrice: unlockPrice,
+	}
+	switch {
+	case accessMode == types.PublicAccess || user == postInfo.Author || pm.IsCoAuthor(ctx, permlink, user):
+		result.CanAccess = true
+	case accessMode == types.UnlockAccess:
+		result.CanAccess = pm.HasUnlocked(ctx, user, permlink)
+	case accessMode == types.SubscriberAccess:
+		result.CanAccess, err = am.IsActiveSubscriber(ctx, user, postInfo.Author)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// AddUnlockReceipt - record user unlock payment to the post
+func (pm PostManager) AddUnlockReceipt(
+	ctx sdk.Context, permlink types.Permlink, user types.AccountKey, amount types.Coin) sdk.Error {
+	receipt := &model.UnlockReceipt{
+		Username:   user,
+		Amount:     amount,
+		UnlockedAt: ctx.BlockHeader().Time.Unix(),
+	}
+	return pm.postStorage.SetUnlockReceipt(ctx, permlink, receipt)
+}
+
+// GetCurationRewards - split curation reward between users who upvoted or donated to the post
+// before the consumption, excluding the consumer, post author and co-authors. Each curator is weighted by
+// stake and how early the upvote is, between post creation and the consumption.
+func (pm PostManager) GetCurationRewards(
+	ctx sdk.Context, permlink types.Permlink, consumer types.AccountKey,
+	consumedAt int64, curationReward types.Coin) ([]types.AccountKey, []types.Coin, sdk.Error) {
+	postInfo, err := pm.postStorage.GetPostInfo(ctx, permlink)
+	if err != nil {
+		return nil, nil, err
+	}
+	postMeta, err := pm.postStorage.GetPostMeta(ctx, permlink)
+	if err != nil {
+		return nil, nil, err
+	}
+	curators := []types.AccountKey{}
+	rewards := []types.Coin{}
+	if curationReward.IsZero() || consumedAt <= postMeta.CreatedAt {
+		return curators, rewards, nil
+	}
+	reportOrUpvotes, err := pm.postStorage.GetPostReportOrUpvotes(ctx, permlink)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	weights := []sdk.Rat{}
+	totalWeight := sdk.ZeroRat()
+	period := sdk.NewRat(consumedAt-postMeta.CreatedAt, 1)
+	for _, upvote := range reportOrUpvotes {
+		if upvote.IsReport || upvote.Username == consumer || upvote.Username == postInfo.Author ||
+			pm.IsCoAuthor(ctx, permlink, upvote.Username) ||
+			upvote.CreatedAt >= consumedAt || upvote.Stake.IsZero() {
+			continue
+		}
+		earliness := sdk.OneRat()
+		if upvote.CreatedAt > postMeta.CreatedAt {
+			earliness = sdk.NewRat(consumedAt-upvote.CreatedAt, 1).Quo(period)
+		}
+		weight := upvote.Stake.ToRat().Mul(earliness)
+		curators = append(curators, upvote.Username)
+		weights = append(weights, weight)
+		totalWeight = totalWeight.Add(weight)
+	}
+	for _, weight := range weights {
+		// round down to make sure total curation reward doesn't exceed the given amount
+		share := curationReward.ToRat().Mul(weight).Quo(totalWeight)
+		rewards = append(rewards, types.NewCoinFromBigInt(new(big.Int).Quo(share.Num(), share.Denom())))
+	}
+	return curators, rewards, nil
+}
+
+// RejectReports - mark all reports to the post as rejected, rejected reports are removed
+// from post total report stake and lower the future report weight of the reporters
+func (pm PostManager) RejectReports(ctx sdk.Context, permlink types.Permlink) sdk.Error {
+	postMeta, err := pm.postStorage.GetPostMeta(ctx, permlink)
+	if err != nil {
+		return err
+	}
+	reportOrUpvotes, err := pm.postStorage.GetPostReportOrUpvotes(ctx, permlink)
+	if err != nil {
+		return err
+	}
+	for _, report := range reportOrUpvotes {
+		if !report.IsReport || report.Rejected {
+			continue
+		}
+		report.Rejected = true
+		postMeta.TotalReportStake = postMeta.TotalReportStake.Minus(report.Stake)
+		if err := pm.postStorage.SetPostReportOrUpvote(ctx, permlink, &report); err != nil {
+			return err
+		}
+		reporterStat, err := pm.postStorage.GetReporterStat(ctx, report.Username)
+		if err != nil {
+			return err
+		}
+		reporterStat.RejectedReports++
+		if err := pm.postStorage.SetReporterStat(ctx, report.Username, reporterStat); err != nil {
+			return err
+		}
+	}
+	pm.postStorage.DeletePostPenaltyScore(ctx, permlink)
+	return pm.postStorage.SetPostMeta(ctx, permlink, postMeta)
+}
+
+// SetCoAuthors - set co-authors and their revenue weights of a post
+func (pm PostManager) SetCoAuthors(
+	ctx sdk.Context, permlink types.Permlink, coAuthors []model.CoAuthor) sdk.Error {
+	return pm.postStorage.SetPostCoAuthors(ctx, permlink, &model.CoAuthors{Authors: coAuthors})
+}
+
+// IsCoAuthor - check if user is an approved co-author of a post
+func (pm PostManager) IsCoAuthor(ctx sdk.Context, permlink types.Permlink, user types.AccountKey) bool {
+	coAuthors, err := pm.postStorage.GetPostCoAuthors(ctx, permlink)
+	if err != nil || coAuthors == nil {
+		return false
+	}
+	for _, coAuthor := range coAuthors.Authors {
+		if coAuthor.Username == user {
+			return coAuthor.Approved
+		}
+	}
+	return false
+}
+
+// ApproveCoAuthor - co-author approves the co-authorship of a post
+func (pm PostManager) ApproveCoAuthor(
+	ctx sdk.Context, permlink types.Permlink, user types.AccountKey) sdk.Error {
+	coAuthors, err := pm.postStorage.GetPostCoAuthors(ctx, permlink)
+	if err != nil {
+		return err
+	}
+	if coAuthors == nil {
+		return ErrCoAuthorNotFound(user, permlink)
+	}
+	for i := range coAuthors.Authors {
+		if coAuthors.Authors[i].Username != user {
+			continue
+		}
+		if coAuthors.Authors[i].Approved {
+			return ErrCoAuthorAlreadyApproved(user, permlink)
+		}
+		coAuthors.Authors[i].Approved = true
+		return pm.postStorage.SetPostCoAuthors(ctx, permlink, coAuthors)
+	}
+	return ErrCoAuthorNotFound(user, permlink)
+}
+
+// SplitRevenue - split coin between post author and approved co-authors by weight.
+// Share of unapproved co-authors and the rounding remainder go to post author,
+// which is always the first one in the result.
+func (pm PostManager) SplitRevenue(
+	ctx sdk.Context, permlink types.Permlink, postAuthor types.AccountKey,
+	coin types.Coin) ([]types.AccountKey, []types.Coin, sdk.Error) {
+	coAuthors, err := pm.postStorage.GetPostCoAuthors(ctx, permlink)
+	if err != nil {
+		return nil, nil, err
+	}
+	usernames := []types.AccountKey{postAuthor}
+	shares := []types.Coin{coin}
+	if coAuthors == nil {
+		return usernames, shares, nil
+	}
+	for _, coAuthor := range coAuthors.Authors {
+		if coAuthor.Username == postAuthor || !coAuthor.Approved {
+			continue
+		}
+		share := types.RatToCoin(coin.ToRat().Mul(coAuthor.Weight))
+		usernames = append(usernames, coAuthor.Username)
+		shares = append(shares, share)
+		shares[0] = shares[0].Minus(share)
+	}
+	return usernames, shares, nil
+}
+
 // IsDeleted - check if a post is deleted or not
 func (pm PostManager) IsDeleted(ctx sdk.Context, permlink types.Permlink) (bool, sdk.Error) {
 	postMeta, err := pm.postStorage.GetPostMeta(ctx, permlink)
@@ -292,7 +799,147 @@ func (pm PostManager) IsDeleted(ctx sdk.Context, permlink types.Permlink) (bool,
 	return postMeta.IsDeleted, nil
 }
 
-// GetPenaltyScore - get penalty score from report and upvote
+// RefreshPenaltyScore - recompute penalty score of the root source post from
+// current stake of reporters and upvoters, and cache the result to post.
+// The cached score is reused if it is refreshed within penalty score refresh
+// interval, so a post with many votes isn't recomputed by every reward event.
+func (pm PostManager) RefreshPenaltyScore(
+	ctx sdk.Context, permlink types.Permlink, am acc.AccountManager) (sdk.Rat, sdk.Error) {
+	sourceAuthor, sourcePostID, err := pm.GetSourcePost(ctx, permlink)
+	if err != nil {
+		return sdk.ZeroRat(), err
+	}
+	if sourceAuthor != types.AccountKey("") && sourcePostID != "" {
+		permlink = types.GetPermlink(sourceAuthor, sourcePostID)
+	}
+	postParam, err := pm.paramHolder.GetPostParam(ctx)
+	if err != nil {
+		return sdk.ZeroRat(), err
+	}
+	penaltyScoreCache, err := pm.postStorage.GetPostPenaltyScore(ctx, permlink)
+	if err != nil {
+		return sdk.ZeroRat(), err
+	}
+	if penaltyScoreCache != nil &&
+		ctx.BlockHeader().Time.Unix()-penaltyScoreCache.UpdatedAt < postParam.PenaltyScoreRefreshSec {
+		return penaltyScoreCache.Score, nil
+	}
+	penaltyScore, err := pm.computePenaltyScore(ctx, permlink, am)
+	if err != nil {
+		return sdk.ZeroRat(), err
+	}
+	if err := pm.postStorage.SetPostPenaltyScore(ctx, permlink, &model.PenaltyScore{
+		Score:     penaltyScore,
+		UpdatedAt: ctx.BlockHeader().Time.Unix(),
+	}); err != nil {
+		return sdk.ZeroRat(), err
+	}
+	return penaltyScore, nil
+}
+
+// AuditPenaltyScore - get cached penalty score of the root source post and
+// recompute it at current block time without updating the cache
+func (pm PostManager) AuditPenaltyScore(
+	ctx sdk.Context, permlink types.Permlink, am acc.AccountManager) (*model.PenaltyScoreAudit, sdk.Error) {
+	sourceAuthor, sourcePostID, err := pm.GetSourcePost(ctx, permlink)
+	if err != nil {
+		return nil, err
+	}
+	if sourceAuthor != types.AccountKey("") && sourcePostID != "" {
+		permlink = types.GetPermlink(sourceAuthor, sourcePostID)
+	}
+	penaltyScoreCache, err := pm.postStorage.GetPostPenaltyScore(ctx, permlink)
+	if err != nil {
+		return nil, err
+	}
+	penaltyScore, err := pm.computePenaltyScore(ctx, permlink, am)
+	if err != nil {
+		return nil, err
+	}
+	return &model.PenaltyScoreAudit{
+		Permlink:    permlink,
+		Cached:      penaltyScoreCache,
+		Recomputed:  penaltyScore,
+		RecomputeAt: ctx.BlockHeader().Time.Unix(),
+	}, nil
+}
+
+// computePenaltyScore - compute penalty score of a post from current stake
+// of reporters and upvoters without caching the result
+func (pm PostManager) computePenaltyScore(
+	ctx sdk.Context, permlink types.Permlink, am acc.AccountManager) (sdk.Rat, sdk.Error) {
+	postParam, err := pm.paramHolder.GetPostParam(ctx)
+	if err != nil {
+		return sdk.ZeroRat(), err
+	}
+	reportOrUpvotes, err := pm.postStorage.GetPostReportOrUpvotes(ctx, permlink)
+	if err != nil {
+		return sdk.ZeroRat(), err
+	}
+	currentStakes := map[types.AccountKey]types.Coin{}
+	for _, reportOrUpvote := range reportOrUpvotes {
+		// account without bank has no stake
+		stake, err := am.GetStake(ctx, reportOrUpvote.Username)
+		if err != nil {
+			stake = types.NewCoinFromInt64(0)
+		}
+		currentStakes[reportOrUpvote.Username] = stake
+	}
+	return CalculatePenaltyScore(
+		reportOrUpvotes, currentStakes, ctx.BlockHeader().Time.Unix(), postParam.ReportHalfLifeSec), nil
+}
+
+// CalculatePenaltyScore - calculate penalty score from reports and upvotes of a post.
+// Each vote is weighted by the current stake of the voter, report weight halves
+// every report half life.
+func CalculatePenaltyScore(
+	reportOrUpvotes []model.ReportOrUpvote, currentStakes map[types.AccountKey]types.Coin,
+	now, reportHalfLifeSec int64) sdk.Rat {
+	totalReportStake := sdk.ZeroRat()
+	totalUpvoteStake := sdk.ZeroRat()
+	for _, reportOrUpvote := range reportOrUpvotes {
+		if reportOrUpvote.Rejected {
+			continue
+		}
+		stake, exist := currentStakes[reportOrUpvote.Username]
+		if !exist {
+			stake = types.NewCoinFromInt64(0)
+		}
+		if reportOrUpvote.IsReport {
+			totalReportStake = totalReportStake.Add(
+				stake.ToRat().Mul(reportDecayFactor(now-reportOrUpvote.CreatedAt, reportHalfLifeSec)))
+		} else {
+			totalUpvoteStake = totalUpvoteStake.Add(stake.ToRat())
+		}
+	}
+	if totalReportStake.IsZero() {
+		return sdk.ZeroRat()
+	}
+	if totalUpvoteStake.IsZero() {
+		return sdk.OneRat()
+	}
+	penaltyScore := totalReportStake.Quo(totalUpvoteStake).Round(types.PrecisionFactor)
+	if penaltyScore.GT(sdk.OneRat()) {
+		return sdk.OneRat()
+	}
+	return penaltyScore
+}
+
+// reportDecayFactor - weight of a report after elapsed seconds, it halves every
+// half life and decreases linearly within a half life
+func reportDecayFactor(elapsed, halfLife int64) sdk.Rat {
+	if halfLife <= 0 || elapsed <= 0 {
+		return sdk.OneRat()
+	}
+	halvings := elapsed / halfLife
+	if halvings >= 62 {
+		return sdk.ZeroRat()
+	}
+	return sdk.NewRat(2*halfLife-elapsed%halfLife, 2*halfLife).Quo(sdk.NewRat(int64(1)<<uint(halvings), 1))
+}
+
+// GetPenaltyScore - get penalty score from report and upvote, the cached score
+// is returned if the penalty score has been refreshed since the last report or upvote
 func (pm PostManager) GetPenaltyScore(ctx sdk.Context, permlink types.Permlink) (sdk.Rat, sdk.Error) {
 	sourceAuthor, sourcePostID, err := pm.GetSourcePost(ctx, permlink)
 	if err != nil {
@@ -305,6 +952,13 @@ func (pm PostManager) GetPenaltyScore(ctx sdk.Context, permlink types.Permlink)
 		}
 		return paneltyScore, nil
 	}
+	penaltyScoreCache, err := pm.postStorage.GetPostPenaltyScore(ctx, permlink)
+	if err != nil {
+		return sdk.ZeroRat(), err
+	}
+	if penaltyScoreCache != nil {
+		return penaltyScoreCache.Score, nil
+	}
 	postMeta, err := pm.postStorage.GetPostMeta(ctx, permlink)
 	if err != nil {
 		return sdk.ZeroRat(), err
@@ -321,3 +975,129 @@ func (pm PostManager) GetPenaltyScore(ctx sdk.Context, permlink types.Permlink)
 	}
 	return penaltyScore, nil
 }
+
+// AddPendingReward - record time of a reward event registered for the post
+func (pm PostManager) AddPendingReward(
+	ctx sdk.Context, permlink types.Permlink, rewardAt int64) sdk.Error {
+	return pm.postStorage.SetPostPendingReward(ctx, permlink, rewardAt)
+}
+
+// RemoveExecutedPendingRewards - remove times of reward events of the post
+// which are due at current block time
+func (pm PostManager) RemoveExecutedPendingRewards(ctx sdk.Context, permlink types.Permlink) sdk.Error {
+	rewardTimes, err := pm.postStorage.GetPostPendingRewardTimes(ctx, permlink)
+	if err != nil {
+		return err
+	}
+	for _, rewardAt := range rewardTimes {
+		if rewardAt <= ctx.BlockHeader().Time.Unix() {
+			pm.postStorage.RemovePostPendingReward(ctx, permlink, rewardAt)
+		}
+	}
+	return nil
+}
+
+// GetPendingRewardEstimates - estimate inflation reward of the reward events of the post
+// which haven't been executed, as if they were executed at current block time
+func (pm PostManager) GetPendingRewardEstimates(
+	ctx sdk.Context, permlink types.Permlink, am acc.AccountManager,
+	gm global.GlobalManager) ([]model.RewardEstimate, sdk.Error) {
+	postInfo, err := pm.postStorage.GetPostInfo(ctx, permlink)
+	if err != nil {
+		return nil, err
+	}
+	penaltyScore, err := pm.getRewardPenaltyScore(ctx, permlink, am)
+	if err != nil {
+		return nil, err
+	}
+	rewardTimes, err := pm.postStorage.GetPostPendingRewardTimes(ctx, permlink)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(rewardTimes, func(i, j int) bool { return rewardTimes[i] < rewardTimes[j] })
+
+	estimates := []model.RewardEstimate{}
+	for _, rewardAt := range rewardTimes {
+		eventList := gm.GetTimeEventListAtTime(ctx, rewardAt)
+		if eventList == nil {
+			continue
+		}
+		for _, event := range eventList.Events {
+			rewardEvent, ok := event.(RewardEvent)
+			if !ok || rewardEvent.PostAuthor != postInfo.Author || rewardEvent.PostID != postInfo.PostID {
+				continue
+			}
+			reward, err := gm.GetRewardFromWindow(ctx, rewardEvent.Evaluate, penaltyScore)
+			if err != nil {
+				return nil, err
+			}
+			estimates = append(estimates, model.RewardEstimate{
+				Consumer:       rewardEvent.Consumer,
+				Original:       rewardEvent.Original,
+				Evaluate:       rewardEvent.Evaluate,
+				PenaltyScore:   penaltyScore,
+				ExpectedReward: reward,
+				RewardAt:       rewardAt,
+				Repost:         rewardEvent.Repost,
+			})
+		}
+	}
+	return estimates, nil
+}
+
+// EstimateDonationReward - estimate inflation reward of a donation from donator
+// to the post, as if its reward event was executed at current block time
+func (pm PostManager) EstimateDonationReward(
+	ctx sdk.Context, permlink types.Permlink, donator types.AccountKey, coin types.Coin,
+	am acc.AccountManager, gm global.GlobalManager) (*model.RewardEstimate, sdk.Error) {
+	postInfo, err := pm.postStorage.GetPostInfo(ctx, permlink)
+	if err != nil {
+		return nil, err
+	}
+	penaltyScore, err := pm.getRewardPenaltyScore(ctx, permlink, am)
+	if err != nil {
+		return nil, err
+	}
+	consumptionFrictionRate, err := gm.GetConsumptionFrictionRate(ctx)
+	if err != nil {
+		return nil, err
+	}
+	friction := types.RatToCoin(coin.ToRat().Mul(consumptionFrictionRate))
+	evaluate, err := evaluateConsumption(
+		ctx, donator, coin, postInfo.Author, postInfo.PostID, am, pm, gm)
+	if err != nil {
+		return nil, err
+	}
+	reward, rewardAt, err := gm.GetRewardOfNewConsumption(ctx, friction, evaluate, penaltyScore)
+	if err != nil {
+		return nil, err
+	}
+	return &model.RewardEstimate{
+		Consumer:       donator,
+		Original:       coin,
+		Evaluate:       evaluate,
+		PenaltyScore:   penaltyScore,
+		ExpectedReward: reward,
+		RewardAt:       rewardAt,
+	}, nil
+}
+
+// getRewardPenaltyScore - penalty score a reward event of the post would be executed with,
+// deleted or censored post gets full penalty since it gets no reward
+func (pm PostManager) getRewardPenaltyScore(
+	ctx sdk.Context, permlink types.Permlink, am acc.AccountManager) (sdk.Rat, sdk.Error) {
+	if isCensored, _ := pm.IsCensored(ctx, permlink); isCensored {
+		return sdk.OneRat(), nil
+	}
+	if isDeleted, err := pm.IsDeleted(ctx, permlink); isDeleted || err != nil {
+		return sdk.OneRat(), nil
+	}
+	sourceAuthor, sourcePostID, err := pm.GetSourcePost(ctx, permlink)
+	if err != nil {
+		return sdk.ZeroRat(), err
+	}
+	if sourceAuthor != types.AccountKey("") && sourcePostID != "" {
+		permlink = types.GetPermlink(sourceAuthor, sourcePostID)
+	}
+	return pm.computePenaltyScore(ctx, permlink, am)
+}
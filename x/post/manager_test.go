@@ -1,6 +1,7 @@
 package post
 
 import (
+	"fmt"
 	"math/big"
 	"testing"
 	"time"
@@ -99,7 +100,7 @@ func TestCreatePost(t *testing.T) {
 		err := pm.CreatePost(
 			ctx, msg.Author, msg.PostID, msg.SourceAuthor, msg.SourcePostID,
 			msg.ParentAuthor, msg.ParentPostID, msg.Content,
-			msg.Title, sdk.ZeroRat(), msg.Links)
+			msg.Title, sdk.ZeroRat(), msg.Links, nil)
 		if !assert.Equal(t, err, tc.expectResult) {
 			t.Errorf("%s: diff result, got %v, want %v", tc.testName, err, tc.expectResult)
 		}
@@ -149,7 +150,8 @@ func TestUpdatePost(t *testing.T) {
 			testName: "normal update",
 			msg: NewUpdatePostMsg(
 				string(user), postID, "update to this title", "update to this content",
-				[]types.IDToURLMapping{{Identifier: "#1", URL: "https://lino.network"}}),
+				[]types.IDToURLMapping{{Identifier: "#1", URL: "https://lino.network"}},
+				[]string{"lino", "blockchain"}),
 			expectErr:  nil,
 			updateTime: baseTime + 10,
 		},
@@ -157,7 +159,7 @@ func TestUpdatePost(t *testing.T) {
 			testName: "update with invalid post id",
 			msg: NewUpdatePostMsg(
 				"invalid", postID, "update to this title", "update to this content",
-				[]types.IDToURLMapping{{Identifier: "#1", URL: "https://lino.network"}}),
+				[]types.IDToURLMapping{{Identifier: "#1", URL: "https://lino.network"}}, nil),
 			expectErr:  model.ErrPostNotFound(model.GetPostInfoKey(types.GetPermlink("invalid", postID))),
 			updateTime: baseTime + 100,
 		},
@@ -165,7 +167,7 @@ func TestUpdatePost(t *testing.T) {
 			testName: "update with invalid author",
 			msg: NewUpdatePostMsg(
 				string(user), "invalid", "update to this title", "update to this content",
-				[]types.IDToURLMapping{{Identifier: "#1", URL: "https://lino.network"}}),
+				[]types.IDToURLMapping{{Identifier: "#1", URL: "https://lino.network"}}, nil),
 			expectErr:  model.ErrPostNotFound(model.GetPostInfoKey(types.GetPermlink(user, "invalid"))),
 			updateTime: baseTime + 1000,
 		},
@@ -175,7 +177,7 @@ func TestUpdatePost(t *testing.T) {
 		ctx = ctx.WithBlockHeader(abci.Header{ChainID: "Lino", Time: time.Unix(tc.updateTime, 0)})
 
 		err := pm.UpdatePost(
-			ctx, tc.msg.Author, tc.msg.PostID, tc.msg.Title, tc.msg.Content, tc.msg.Links)
+			ctx, tc.msg.Author, tc.msg.PostID, tc.msg.Title, tc.msg.Content, tc.msg.Links, tc.msg.Tags)
 		if !assert.Equal(t, err, tc.expectErr) {
 			t.Errorf("%s: diff err, got %v, want %v", tc.testName, err, tc.expectErr)
 		}
@@ -191,6 +193,7 @@ func TestUpdatePost(t *testing.T) {
 			SourceAuthor: "",
 			SourcePostID: "",
 			Links:        tc.msg.Links,
+			Tags:         tc.msg.Tags,
 		}
 
 		postMeta := model.PostMeta{
@@ -209,6 +212,78 @@ func TestUpdatePost(t *testing.T) {
 	}
 }
 
+func TestPostTagIndex(t *testing.T) {
+	ctx, am, _, pm, _, _ := setupTest(t, 1)
+	baseTime := time.Now().Unix()
+	user1 := createTestAccount(t, ctx, am, "user1")
+	user2 := createTestAccount(t, ctx, am, "user2")
+
+	ctx = ctx.WithBlockHeader(abci.Header{ChainID: "Lino", Time: time.Unix(baseTime, 0)})
+	err := pm.CreatePost(
+		ctx, user1, "postID", "", "", "", "", "content", "title",
+		sdk.ZeroRat(), nil, []string{"lino", "music"})
+	assert.Nil(t, err)
+
+	ctx = ctx.WithBlockHeader(abci.Header{ChainID: "Lino", Time: time.Unix(baseTime+100, 0)})
+	err = pm.CreatePost(
+		ctx, user2, "postID", "", "", "", "", "content", "title",
+		sdk.ZeroRat(), nil, []string{"lino"})
+	assert.Nil(t, err)
+
+	permlink1 := types.GetPermlink(user1, "postID")
+	permlink2 := types.GetPermlink(user2, "postID")
+
+	testCases := []struct {
+		testName      string
+		tag           string
+		limit         int
+		expectResults []types.Permlink
+	}{
+		{
+			testName:      "most recent post comes first",
+			tag:           "lino",
+			limit:         10,
+			expectResults: []types.Permlink{permlink2, permlink1},
+		},
+		{
+			testName:      "limit number of posts",
+			tag:           "lino",
+			limit:         1,
+			expectResults: []types.Permlink{permlink2},
+		},
+		{
+			testName:      "single post under tag",
+			tag:           "music",
+			limit:         10,
+			expectResults: []types.Permlink{permlink1},
+		},
+		{
+			testName:      "tag prefix doesn't match",
+			tag:           "lin",
+			limit:         10,
+			expectResults: []types.Permlink{},
+		},
+	}
+	for _, tc := range testCases {
+		permlinks := pm.GetPostsByTag(ctx, tc.tag, tc.limit)
+		if !assert.Equal(t, tc.expectResults, permlinks) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, permlinks, tc.expectResults)
+		}
+	}
+
+	// update tags should re-index the post
+	err = pm.UpdatePost(ctx, user1, "postID", "title", "content", nil, []string{"news"})
+	assert.Nil(t, err)
+	assert.Equal(t, []types.Permlink{permlink2}, pm.GetPostsByTag(ctx, "lino", 10))
+	assert.Equal(t, []types.Permlink{}, pm.GetPostsByTag(ctx, "music", 10))
+	assert.Equal(t, []types.Permlink{permlink1}, pm.GetPostsByTag(ctx, "news", 10))
+
+	// deleted post should be removed from tag index
+	err = pm.DeletePost(ctx, permlink2)
+	assert.Nil(t, err)
+	assert.Equal(t, []types.Permlink{}, pm.GetPostsByTag(ctx, "lino", 10))
+}
+
 // test get source post
 func TestGetSourcePost(t *testing.T) {
 	ctx, _, _, pm, _, _ := setupTest(t, 1)
@@ -279,7 +354,7 @@ func TestGetSourcePost(t *testing.T) {
 		err := pm.CreatePost(
 			ctx, msg.Author, msg.PostID, msg.SourceAuthor, msg.SourcePostID,
 			msg.ParentAuthor, msg.ParentPostID, msg.Content,
-			msg.Title, sdk.ZeroRat(), msg.Links)
+			msg.Title, sdk.ZeroRat(), msg.Links, nil)
 		if err != nil {
 			t.Errorf("%s: failed to create post, got err %v", tc.testName, err)
 		}
@@ -298,8 +373,44 @@ func TestGetSourcePost(t *testing.T) {
 	}
 }
 
+// test repost chain is capped by max repost chain depth
+func TestRepostChainDepth(t *testing.T) {
+	ctx, _, ph, pm, _, _ := setupTest(t, 1)
+	postParam, err := ph.GetPostParam(ctx)
+	assert.Nil(t, err)
+
+	root := types.AccountKey("user0")
+	err = pm.CreatePost(
+		ctx, root, "post", "", "", "", "", "content", "title", sdk.ZeroRat(), nil, nil)
+	assert.Nil(t, err)
+	chain := []model.RepostHop{}
+	source := model.RepostHop{Author: root, PostID: "post"}
+	for i := int64(1); i <= postParam.MaxRepostChainDepth+1; i++ {
+		author := types.AccountKey(fmt.Sprintf("user%d", i))
+		err := pm.CreatePost(
+			ctx, author, "post", source.Author, source.PostID, "", "",
+			"content", "title", sdk.ZeroRat(), nil, nil)
+		assert.Nil(t, err)
+		chain = append([]model.RepostHop{source}, chain...)
+		source = model.RepostHop{Author: author, PostID: "post"}
+
+		postInfo, err := pm.postStorage.GetPostInfo(ctx, types.GetPermlink(author, "post"))
+		assert.Nil(t, err)
+		expectChain := chain
+		if int64(len(chain)) > postParam.MaxRepostChainDepth {
+			expectChain = append(
+				append([]model.RepostHop{}, chain[:postParam.MaxRepostChainDepth-1]...), chain[len(chain)-1])
+		}
+		assert.Equal(t, expectChain, postInfo.RepostChain)
+		assert.Equal(t, root, postInfo.SourceAuthor)
+	}
+}
+
 func TestAddOrUpdateViewToPost(t *testing.T) {
-	ctx, am, _, pm, _, _ := setupTest(t, 1)
+	ctx, am, ph, pm, _, _ := setupTest(t, 1)
+	postParam, err := ph.GetPostParam(ctx)
+	assert.Nil(t, err)
+	viewDedupInterval := postParam.ViewDedupIntervalSec
 	createTime := ctx.BlockHeader().Time
 	user1, postID1 := createTestPost(t, ctx, "user1", "postID1", am, pm, "0")
 	user2, _ := createTestPost(t, ctx, "user2", "postID2", am, pm, "0")
@@ -313,6 +424,7 @@ func TestAddOrUpdateViewToPost(t *testing.T) {
 		viewTime             int64
 		expectTotalViewCount int64
 		expectUserViewCount  int64
+		expectLastViewAt     int64
 	}{
 		{
 			testName:             "user3 views (postID1, user1)",
@@ -322,15 +434,27 @@ func TestAddOrUpdateViewToPost(t *testing.T) {
 			viewTime:             1,
 			expectTotalViewCount: 1,
 			expectUserViewCount:  1,
+			expectLastViewAt:     1,
 		},
 		{
-			testName:             "user3 views (postID1, user1) again",
+			testName:             "user3 views (postID1, user1) again within view dedup interval",
 			viewUser:             user3,
 			postID:               postID1,
 			author:               user1,
 			viewTime:             2,
+			expectTotalViewCount: 1,
+			expectUserViewCount:  1,
+			expectLastViewAt:     1,
+		},
+		{
+			testName:             "user3 views (postID1, user1) again after view dedup interval",
+			viewUser:             user3,
+			postID:               postID1,
+			author:               user1,
+			viewTime:             1 + viewDedupInterval,
 			expectTotalViewCount: 2,
 			expectUserViewCount:  2,
+			expectLastViewAt:     1 + viewDedupInterval,
 		},
 		{
 			testName:             "user2 views (postID1, user1)",
@@ -340,15 +464,17 @@ func TestAddOrUpdateViewToPost(t *testing.T) {
 			viewTime:             3,
 			expectTotalViewCount: 3,
 			expectUserViewCount:  1,
+			expectLastViewAt:     3,
 		},
 		{
-			testName:             "user2 views (postID1, user1) again",
+			testName:             "user2 views (postID1, user1) again after view dedup interval",
 			viewUser:             user2,
 			postID:               postID1,
 			author:               user1,
-			viewTime:             4,
+			viewTime:             3 + viewDedupInterval,
 			expectTotalViewCount: 4,
 			expectUserViewCount:  2,
+			expectLastViewAt:     3 + viewDedupInterval,
 		},
 		{
 			testName:             "user1 views (postID1, user1)",
@@ -358,6 +484,7 @@ func TestAddOrUpdateViewToPost(t *testing.T) {
 			viewTime:             5,
 			expectTotalViewCount: 5,
 			expectUserViewCount:  1,
+			expectLastViewAt:     5,
 		},
 	}
 
@@ -388,8 +515,8 @@ func TestAddOrUpdateViewToPost(t *testing.T) {
 		if view.Times != tc.expectUserViewCount {
 			t.Errorf("%s: diff user view count, got %v, want %v", tc.testName, view.Times, tc.expectUserViewCount)
 		}
-		if view.LastViewAt != tc.viewTime {
-			t.Errorf("%s: diff view time, got %v, want %v", tc.testName, view.LastViewAt, tc.viewTime)
+		if view.LastViewAt != tc.expectLastViewAt {
+			t.Errorf("%s: diff view time, got %v, want %v", tc.testName, view.LastViewAt, tc.expectLastViewAt)
 		}
 	}
 }
@@ -479,6 +606,80 @@ func TestReportOrUpvoteToPost(t *testing.T) {
 	}
 }
 
+func TestReportOrUpvoteKeepsCreatedAt(t *testing.T) {
+	ctx, am, _, pm, _, _ := setupTest(t, 1)
+	user1, postID1 := createTestPost(t, ctx, "user1", "postID1", am, pm, "0")
+	user2 := types.AccountKey("user2")
+	permlink := types.GetPermlink(user1, postID1)
+	baseTime := ctx.BlockHeader().Time.Unix()
+
+	err := pm.ReportOrUpvoteToPost(ctx, permlink, user2, types.NewCoinFromInt64(1), false)
+	assert.Nil(t, err)
+
+	// upvote again later keeps the time of the first upvote
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(baseTime+100, 0)})
+	err = pm.ReportOrUpvoteToPost(ctx, permlink, user2, types.NewCoinFromInt64(2), false)
+	assert.Nil(t, err)
+	upvote, err := pm.postStorage.GetPostReportOrUpvote(ctx, permlink, user2)
+	assert.Nil(t, err)
+	assert.Equal(t, baseTime, upvote.CreatedAt)
+	assert.Equal(t, types.NewCoinFromInt64(2), upvote.Stake)
+
+	// report overriding the upvote starts from the report time
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(baseTime+200, 0)})
+	err = pm.ReportOrUpvoteToPost(ctx, permlink, user2, types.NewCoinFromInt64(2), true)
+	assert.Nil(t, err)
+	report, err := pm.postStorage.GetPostReportOrUpvote(ctx, permlink, user2)
+	assert.Nil(t, err)
+	assert.Equal(t, baseTime+200, report.CreatedAt)
+}
+
+func TestReportToPostAndRejectReports(t *testing.T) {
+	ctx, am, _, pm, _, _ := setupTest(t, 1)
+	user1, postID1 := createTestPost(t, ctx, "user1", "postID1", am, pm, "0")
+	user2, postID2 := createTestPost(t, ctx, "user2", "postID2", am, pm, "0")
+	user3 := types.AccountKey("user3")
+
+	permlink1 := types.GetPermlink(user1, postID1)
+	permlink2 := types.GetPermlink(user2, postID2)
+
+	err := pm.ReportToPost(
+		ctx, permlink1, user3, types.NewCoinFromInt64(100), types.ReportReasonSpam, "spam link")
+	assert.Nil(t, err)
+	reportOrUpvote, err := pm.postStorage.GetPostReportOrUpvote(ctx, permlink1, user3)
+	assert.Nil(t, err)
+	assert.Equal(t, types.ReportReasonSpam, reportOrUpvote.Reason)
+	assert.Equal(t, "spam link", reportOrUpvote.Evidence)
+	assert.False(t, reportOrUpvote.Rejected)
+
+	err = pm.RejectReports(ctx, permlink1)
+	assert.Nil(t, err)
+	postMeta, err := pm.postStorage.GetPostMeta(ctx, permlink1)
+	assert.Nil(t, err)
+	assert.True(t, postMeta.TotalReportStake.IsZero())
+	reportOrUpvote, err = pm.postStorage.GetPostReportOrUpvote(ctx, permlink1, user3)
+	assert.Nil(t, err)
+	assert.True(t, reportOrUpvote.Rejected)
+	reporterStat, err := pm.postStorage.GetReporterStat(ctx, user3)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), reporterStat.RejectedReports)
+
+	// reject again shouldn't count the same report twice
+	err = pm.RejectReports(ctx, permlink1)
+	assert.Nil(t, err)
+	reporterStat, err = pm.postStorage.GetReporterStat(ctx, user3)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), reporterStat.RejectedReports)
+
+	// reporter with one rejected report only has half report weight
+	err = pm.ReportToPost(
+		ctx, permlink2, user3, types.NewCoinFromInt64(100), types.ReportReasonAbuse, "")
+	assert.Nil(t, err)
+	postMeta, err = pm.postStorage.GetPostMeta(ctx, permlink2)
+	assert.Nil(t, err)
+	assert.True(t, postMeta.TotalReportStake.IsEqual(types.NewCoinFromInt64(50)))
+}
+
 func TestDonation(t *testing.T) {
 	ctx, am, _, pm, _, _ := setupTest(t, 1)
 	user1, postID1 := createTestPost(t, ctx, "user1", "postID1", am, pm, "0")
@@ -487,7 +688,7 @@ func TestDonation(t *testing.T) {
 
 	baseTime := ctx.BlockHeader().Time.Unix()
 	testCases := []struct {
-		testName            string
+		testName           string
 		user                types.AccountKey
 		donateAt            int64
 		amount              types.Coin
@@ -672,6 +873,198 @@ func TestGetPenaltyScore(t *testing.T) {
 	}
 }
 
+func TestCalculatePenaltyScore(t *testing.T) {
+	baseTime := int64(1000000)
+	halfLife := int64(3600)
+	reporter := types.AccountKey("reporter")
+	upvoter := types.AccountKey("upvoter")
+	report := model.ReportOrUpvote{
+		Username: reporter, Stake: types.NewCoinFromInt64(10), CreatedAt: baseTime, IsReport: true}
+	upvote := model.ReportOrUpvote{
+		Username: upvoter, Stake: types.NewCoinFromInt64(100), CreatedAt: baseTime, IsReport: false}
+	rejectedReport := report
+	rejectedReport.Rejected = true
+	stakes := map[types.AccountKey]types.Coin{
+		reporter: types.NewCoinFromInt64(10),
+		upvoter:  types.NewCoinFromInt64(100),
+	}
+
+	testCases := []struct {
+		testName           string
+		reportOrUpvotes    []model.ReportOrUpvote
+		currentStakes      map[types.AccountKey]types.Coin
+		now                int64
+		halfLife           int64
+		expectPenaltyScore sdk.Rat
+	}{
+		{
+			testName:           "no report or upvote",
+			reportOrUpvotes:    []model.ReportOrUpvote{},
+			currentStakes:      stakes,
+			now:                baseTime,
+			halfLife:           halfLife,
+			expectPenaltyScore: sdk.ZeroRat(),
+		},
+		{
+			testName:           "report without upvote",
+			reportOrUpvotes:    []model.ReportOrUpvote{report},
+			currentStakes:      stakes,
+			now:                baseTime,
+			halfLife:           halfLife,
+			expectPenaltyScore: sdk.OneRat(),
+		},
+		{
+			testName:           "report and upvote without decay",
+			reportOrUpvotes:    []model.ReportOrUpvote{report, upvote},
+			currentStakes:      stakes,
+			now:                baseTime,
+			halfLife:           halfLife,
+			expectPenaltyScore: sdk.NewRat(1, 10),
+		},
+		{
+			testName:        "reporter current stake drops to zero",
+			reportOrUpvotes: []model.ReportOrUpvote{report, upvote},
+			currentStakes: map[types.AccountKey]types.Coin{
+				reporter: types.NewCoinFromInt64(0),
+				upvoter:  types.NewCoinFromInt64(100),
+			},
+			now:                baseTime,
+			halfLife:           halfLife,
+			expectPenaltyScore: sdk.ZeroRat(),
+		},
+		{
+			testName:        "upvoter current stake higher than stake when upvoted",
+			reportOrUpvotes: []model.ReportOrUpvote{report, upvote},
+			currentStakes: map[types.AccountKey]types.Coin{
+				reporter: types.NewCoinFromInt64(10),
+				upvoter:  types.NewCoinFromInt64(1000),
+			},
+			now:                baseTime,
+			halfLife:           halfLife,
+			expectPenaltyScore: sdk.NewRat(1, 100),
+		},
+		{
+			testName:        "reporter current stake higher than stake when reported",
+			reportOrUpvotes: []model.ReportOrUpvote{report, upvote},
+			currentStakes: map[types.AccountKey]types.Coin{
+				reporter: types.NewCoinFromInt64(50),
+				upvoter:  types.NewCoinFromInt64(100),
+			},
+			now:                baseTime,
+			halfLife:           halfLife,
+			expectPenaltyScore: sdk.NewRat(1, 2),
+		},
+		{
+			testName:           "report decays after one half life",
+			reportOrUpvotes:    []model.ReportOrUpvote{report, upvote},
+			currentStakes:      stakes,
+			now:                baseTime + halfLife,
+			halfLife:           halfLife,
+			expectPenaltyScore: sdk.NewRat(1, 20),
+		},
+		{
+			testName:           "report decays after one and a half half life",
+			reportOrUpvotes:    []model.ReportOrUpvote{report, upvote},
+			currentStakes:      stakes,
+			now:                baseTime + halfLife*3/2,
+			halfLife:           halfLife,
+			expectPenaltyScore: sdk.NewRat(3, 80),
+		},
+		{
+			testName:           "no decay if half life is zero",
+			reportOrUpvotes:    []model.ReportOrUpvote{report, upvote},
+			currentStakes:      stakes,
+			now:                baseTime + 100*halfLife,
+			halfLife:           0,
+			expectPenaltyScore: sdk.NewRat(1, 10),
+		},
+		{
+			testName:           "rejected report doesn't count",
+			reportOrUpvotes:    []model.ReportOrUpvote{rejectedReport, upvote},
+			currentStakes:      stakes,
+			now:                baseTime,
+			halfLife:           halfLife,
+			expectPenaltyScore: sdk.ZeroRat(),
+		},
+	}
+
+	for _, tc := range testCases {
+		penaltyScore := CalculatePenaltyScore(tc.reportOrUpvotes, tc.currentStakes, tc.now, tc.halfLife)
+		if !penaltyScore.Equal(tc.expectPenaltyScore) {
+			t.Errorf("%s: diff penalty score, got %v, want %v", tc.testName, penaltyScore, tc.expectPenaltyScore)
+		}
+	}
+}
+
+func TestRefreshPenaltyScore(t *testing.T) {
+	ctx, am, _, pm, _, _ := setupTest(t, 1)
+	user1, postID1 := createTestPost(t, ctx, "user1", "postID1", am, pm, "0")
+	user2 := createTestAccount(t, ctx, am, "user2")
+	// reporter without account has no current stake
+	reporter := types.AccountKey("reporter")
+	permlink := types.GetPermlink(user1, postID1)
+
+	err := pm.ReportOrUpvoteToPost(ctx, permlink, reporter, types.NewCoinFromInt64(100), true)
+	assert.Nil(t, err)
+	err = pm.ReportOrUpvoteToPost(ctx, permlink, user2, types.NewCoinFromInt64(1), false)
+	assert.Nil(t, err)
+
+	penaltyScore, err := pm.GetPenaltyScore(ctx, permlink)
+	assert.Nil(t, err)
+	assert.True(t, penaltyScore.Equal(sdk.OneRat()))
+
+	penaltyScore, err = pm.RefreshPenaltyScore(ctx, permlink, am)
+	assert.Nil(t, err)
+	assert.True(t, penaltyScore.IsZero())
+
+	penaltyScoreCache, err := pm.postStorage.GetPostPenaltyScore(ctx, permlink)
+	assert.Nil(t, err)
+	assert.Equal(t, ctx.BlockHeader().Time.Unix(), penaltyScoreCache.UpdatedAt)
+
+	penaltyScore, err = pm.GetPenaltyScore(ctx, permlink)
+	assert.Nil(t, err)
+	assert.True(t, penaltyScore.IsZero())
+
+	// cache is reused within refresh interval and recomputed after it
+	postParam, err := pm.paramHolder.GetPostParam(ctx)
+	assert.Nil(t, err)
+	err = pm.postStorage.SetPostPenaltyScore(ctx, permlink, &model.PenaltyScore{
+		Score:     sdk.NewRat(1, 2),
+		UpdatedAt: ctx.BlockHeader().Time.Unix(),
+	})
+	assert.Nil(t, err)
+	penaltyScore, err = pm.RefreshPenaltyScore(ctx, permlink, am)
+	assert.Nil(t, err)
+	assert.True(t, penaltyScore.Equal(sdk.NewRat(1, 2)))
+	refreshCtx := ctx.WithBlockHeader(abci.Header{
+		ChainID: "Lino", Time: ctx.BlockHeader().Time.Add(time.Duration(postParam.PenaltyScoreRefreshSec) * time.Second)})
+	penaltyScore, err = pm.RefreshPenaltyScore(refreshCtx, permlink, am)
+	assert.Nil(t, err)
+	assert.True(t, penaltyScore.IsZero())
+
+	// new upvote invalidates the cache
+	err = pm.ReportOrUpvoteToPost(ctx, permlink, user2, types.NewCoinFromInt64(50), false)
+	assert.Nil(t, err)
+	penaltyScoreCache, err = pm.postStorage.GetPostPenaltyScore(ctx, permlink)
+	assert.Nil(t, err)
+	assert.Nil(t, penaltyScoreCache)
+	penaltyScore, err = pm.GetPenaltyScore(ctx, permlink)
+	assert.Nil(t, err)
+	assert.True(t, penaltyScore.Equal(sdk.OneRat()))
+
+	// rejected reports invalidate the cache
+	_, err = pm.RefreshPenaltyScore(ctx, permlink, am)
+	assert.Nil(t, err)
+	err = pm.RejectReports(ctx, permlink)
+	assert.Nil(t, err)
+	penaltyScoreCache, err = pm.postStorage.GetPostPenaltyScore(ctx, permlink)
+	assert.Nil(t, err)
+	assert.Nil(t, penaltyScoreCache)
+	penaltyScore, err = pm.GetPenaltyScore(ctx, permlink)
+	assert.Nil(t, err)
+	assert.True(t, penaltyScore.IsZero())
+}
+
 func TestGetRepostPenaltyScore(t *testing.T) {
 	ctx, am, _, pm, _, _ := setupTest(t, 1)
 	user, postID := createTestPost(t, ctx, "user", "postID", am, pm, "0")
@@ -773,3 +1166,340 @@ func TestDeletePost(t *testing.T) {
 	assert.Nil(t, err)
 	checkIsDelete(t, ctx, pm, types.GetPermlink(user, postID))
 }
+
+func TestCensorPost(t *testing.T) {
+	ctx, am, _, pm, _, _ := setupTest(t, 1)
+	user, postID := createTestPost(t, ctx, "user", "postID", am, pm, "0")
+	user2, postID2 := createTestPost(t, ctx, "user2", "postID2", am, pm, "0")
+	permlink := types.GetPermlink(user, postID)
+	permlink2 := types.GetPermlink(user2, postID2)
+
+	err := pm.CensorPost(ctx, permlink, types.ProposalKey("1"))
+	assert.Nil(t, err)
+	checkIsDelete(t, ctx, pm, permlink)
+	postMeta, err := pm.postStorage.GetPostMeta(ctx, permlink)
+	assert.Nil(t, err)
+	assert.True(t, postMeta.IsCensored)
+	assert.Equal(t, types.ProposalKey("1"), postMeta.CensoredBy)
+	isCensored, err := pm.IsCensored(ctx, permlink)
+	assert.Nil(t, err)
+	assert.True(t, isCensored)
+
+	// author delete is not censorship
+	err = pm.DeletePost(ctx, permlink2)
+	assert.Nil(t, err)
+	checkIsDelete(t, ctx, pm, permlink2)
+	isCensored, err = pm.IsCensored(ctx, permlink2)
+	assert.Nil(t, err)
+	assert.False(t, isCensored)
+}
+
+func TestPostAccessAndUnlockReceipt(t *testing.T) {
+	ctx, am, _, pm, _, _ := setupTest(t, 1)
+	user, postID := createTestPost(t, ctx, "user", "postID", am, pm, "0")
+	user2 := createTestAccount(t, ctx, am, "user2")
+	permlink := types.GetPermlink(user, postID)
+
+	accessMode, unlockPrice, err := pm.GetPostAccess(ctx, permlink)
+	assert.Nil(t, err)
+	assert.Equal(t, types.PublicAccess, accessMode)
+	assert.Equal(t, types.NewCoinFromInt64(0), unlockPrice)
+
+	err = pm.SetPostAccess(ctx, permlink, types.UnlockAccess, types.NewCoinFromInt64(100))
+	assert.Nil(t, err)
+	accessMode, unlockPrice, err = pm.GetPostAccess(ctx, permlink)
+	assert.Nil(t, err)
+	assert.Equal(t, types.UnlockAccess, accessMode)
+	assert.Equal(t, types.NewCoinFromInt64(100), unlockPrice)
+
+	assert.False(t, pm.HasUnlocked(ctx, user2, permlink))
+	err = pm.AddUnlockReceipt(ctx, permlink, user2, types.NewCoinFromInt64(100))
+	assert.Nil(t, err)
+	assert.True(t, pm.HasUnlocked(ctx, user2, permlink))
+	receipt, err := pm.postStorage.GetUnlockReceipt(ctx, user2, permlink)
+	assert.Nil(t, err)
+	assert.Equal(t, model.UnlockReceipt{
+		Username:   user2,
+		Amount:     types.NewCoinFromInt64(100),
+		UnlockedAt: ctx.BlockHeader().Time.Unix(),
+	}, *receipt)
+}
+
+func TestCoAuthorsAndSplitRevenue(t *testing.T) {
+	ctx, am, _, pm, _, _ := setupTest(t, 1)
+	user, postID := createTestPost(t, ctx, "user", "postID", am, pm, "0")
+	user2 := createTestAccount(t, ctx, am, "user2")
+	user3 := createTestAccount(t, ctx, am, "user3")
+	user4 := createTestAccount(t, ctx, am, "user4")
+	permlink := types.GetPermlink(user, postID)
+
+	// post without co-author pays all revenue to author
+	authors, shares, err := pm.SplitRevenue(ctx, permlink, user, types.NewCoinFromInt64(100))
+	assert.Nil(t, err)
+	assert.Equal(t, []types.AccountKey{user}, authors)
+	assert.Equal(t, []types.Coin{types.NewCoinFromInt64(100)}, shares)
+
+	err = pm.ApproveCoAuthor(ctx, permlink, user2)
+	assert.Equal(t, ErrCoAuthorNotFound(user2, permlink), err)
+
+	err = pm.SetCoAuthors(ctx, permlink, []model.CoAuthor{
+		{Username: user, Weight: sdk.NewRat(1, 2), Approved: true},
+		{Username: user2, Weight: sdk.NewRat(1, 3), Approved: false},
+		{Username: user3, Weight: sdk.NewRat(1, 6), Approved: false},
+	})
+	assert.Nil(t, err)
+
+	testCases := []struct {
+		testName      string
+		approveUser   types.AccountKey
+		expectErr     sdk.Error
+		expectAuthors []types.AccountKey
+		expectShares  []types.Coin
+	}{
+		{
+			testName:      "user is not a co-author",
+			approveUser:   user4,
+			expectErr:     ErrCoAuthorNotFound(user4, permlink),
+			expectAuthors: []types.AccountKey{user},
+			expectShares:  []types.Coin{types.NewCoinFromInt64(100)},
+		},
+		{
+			testName:      "post author has approved",
+			approveUser:   user,
+			expectErr:     ErrCoAuthorAlreadyApproved(user, permlink),
+			expectAuthors: []types.AccountKey{user},
+			expectShares:  []types.Coin{types.NewCoinFromInt64(100)},
+		},
+		{
+			testName:      "co-author approves",
+			approveUser:   user2,
+			expectErr:     nil,
+			expectAuthors: []types.AccountKey{user, user2},
+			expectShares:  []types.Coin{types.NewCoinFromInt64(67), types.NewCoinFromInt64(33)},
+		},
+		{
+			testName:      "co-author approves twice",
+			approveUser:   user2,
+			expectErr:     ErrCoAuthorAlreadyApproved(user2, permlink),
+			expectAuthors: []types.AccountKey{user, user2},
+			expectShares:  []types.Coin{types.NewCoinFromInt64(67), types.NewCoinFromInt64(33)},
+		},
+		{
+			testName:      "rounding remainder goes to post author",
+			approveUser:   user3,
+			expectErr:     nil,
+			expectAuthors: []types.AccountKey{user, user2, user3},
+			expectShares: []types.Coin{
+				types.NewCoinFromInt64(50), types.NewCoinFromInt64(33), types.NewCoinFromInt64(17)},
+		},
+	}
+	for _, tc := range testCases {
+		err := pm.ApproveCoAuthor(ctx, permlink, tc.approveUser)
+		if !assert.Equal(t, tc.expectErr, err) {
+			t.Errorf("%s: diff err, got %v, want %v", tc.testName, err, tc.expectErr)
+		}
+		authors, shares, err := pm.SplitRevenue(ctx, permlink, user, types.NewCoinFromInt64(100))
+		if err != nil {
+			t.Errorf("%s: failed to split revenue, got err %v", tc.testName, err)
+		}
+		if !assert.Equal(t, tc.expectAuthors, authors) {
+			t.Errorf("%s: diff authors, got %v, want %v", tc.testName, authors, tc.expectAuthors)
+		}
+		for i, share := range shares {
+			if !share.IsEqual(tc.expectShares[i]) {
+				t.Errorf("%s: diff share of %v, got %v, want %v", tc.testName, authors[i], share, tc.expectShares[i])
+			}
+		}
+	}
+}
+
+func TestGetCurationRewards(t *testing.T) {
+	ctx, am, _, pm, _, _ := setupTest(t, 1)
+	baseTime := ctx.BlockHeader().Time.Unix()
+	author, postID := createTestPost(t, ctx, "author", "postID", am, pm, "0")
+	user2 := createTestAccount(t, ctx, am, "user2")
+	user3 := createTestAccount(t, ctx, am, "user3")
+	user4 := createTestAccount(t, ctx, am, "user4")
+	coAuthor := createTestAccount(t, ctx, am, "coauthor")
+	consumer := createTestAccount(t, ctx, am, "consumer")
+	permlink := types.GetPermlink(author, postID)
+	err := pm.SetCoAuthors(ctx, permlink, []model.CoAuthor{
+		{Username: author, Weight: sdk.NewRat(1, 2), Approved: true},
+		{Username: coAuthor, Weight: sdk.NewRat(1, 2), Approved: true},
+	})
+	assert.Nil(t, err)
+
+	upvotes := []struct {
+		username types.AccountKey
+		actedAt  int64
+		isReport bool
+	}{
+		{username: user2, actedAt: baseTime, isReport: false},
+		{username: author, actedAt: baseTime + 10, isReport: false},
+		{username: coAuthor, actedAt: baseTime + 10, isReport: false},
+		{username: user4, actedAt: baseTime + 10, isReport: true},
+		{username: user3, actedAt: baseTime + 50, isReport: false},
+		{username: consumer, actedAt: baseTime + 60, isReport: false},
+	}
+	for _, upvote := range upvotes {
+		upvoteCtx := ctx.WithBlockHeader(abci.Header{ChainID: "Lino", Time: time.Unix(upvote.actedAt, 0)})
+		err := pm.ReportOrUpvoteToPost(
+			upvoteCtx, permlink, upvote.username, types.NewCoinFromInt64(100), upvote.isReport)
+		assert.Nil(t, err)
+	}
+
+	testCases := []struct {
+		testName        string
+		consumedAt      int64
+		curationReward  types.Coin
+		expectCurators  []types.AccountKey
+		expectCurations []types.Coin
+	}{
+		{
+			testName:        "no curator before post creation",
+			consumedAt:      baseTime,
+			curationReward:  types.NewCoinFromInt64(300),
+			expectCurators:  []types.AccountKey{},
+			expectCurations: []types.Coin{},
+		},
+		{
+			testName:        "only upvote before consumption is counted",
+			consumedAt:      baseTime + 20,
+			curationReward:  types.NewCoinFromInt64(300),
+			expectCurators:  []types.AccountKey{user2},
+			expectCurations: []types.Coin{types.NewCoinFromInt64(300)},
+		},
+		{
+			testName:        "early upvote gets more curation reward",
+			consumedAt:      baseTime + 100,
+			curationReward:  types.NewCoinFromInt64(300),
+			expectCurators:  []types.AccountKey{user2, user3},
+			expectCurations: []types.Coin{types.NewCoinFromInt64(200), types.NewCoinFromInt64(100)},
+		},
+		{
+			testName:        "curation reward is rounded down",
+			consumedAt:      baseTime + 100,
+			curationReward:  types.NewCoinFromInt64(100),
+			expectCurators:  []types.AccountKey{user2, user3},
+			expectCurations: []types.Coin{types.NewCoinFromInt64(66), types.NewCoinFromInt64(33)},
+		},
+	}
+	for _, tc := range testCases {
+		curators, curations, err := pm.GetCurationRewards(
+			ctx, permlink, consumer, tc.consumedAt, tc.curationReward)
+		if err != nil {
+			t.Errorf("%s: failed to get curation rewards, got err %v", tc.testName, err)
+		}
+		if !assert.Equal(t, tc.expectCurators, curators) {
+			t.Errorf("%s: diff curators, got %v, want %v", tc.testName, curators, tc.expectCurators)
+		}
+		if len(curations) != len(tc.expectCurations) {
+			t.Errorf("%s: diff curations, got %v, want %v", tc.testName, curations, tc.expectCurations)
+			continue
+		}
+		for i, curation := range curations {
+			if !curation.IsEqual(tc.expectCurations[i]) {
+				t.Errorf("%s: diff curation of %v, got %v, want %v", tc.testName, curators[i], curation, tc.expectCurations[i])
+			}
+		}
+	}
+}
+
+func TestGetUserPostAccess(t *testing.T) {
+	ctx, am, _, pm, _, _ := setupTest(t, 1)
+	author, postID := createTestPost(t, ctx, "author", "postID", am, pm, "0")
+	permlink := types.GetPermlink(author, postID)
+	coAuthor := createTestAccount(t, ctx, am, "coauthor")
+	pendingCoAuthor := createTestAccount(t, ctx, am, "pending")
+	unlocker := createTestAccount(t, ctx, am, "unlocker")
+	subscriber := createTestAccount(t, ctx, am, "subscriber")
+	stranger := createTestAccount(t, ctx, am, "stranger")
+	err := pm.SetCoAuthors(ctx, permlink, []model.CoAuthor{
+		{Username: author, Weight: sdk.NewRat(1, 3), Approved: true},
+		{Username: coAuthor, Weight: sdk.NewRat(1, 3), Approved: true},
+		{Username: pendingCoAuthor, Weight: sdk.NewRat(1, 3), Approved: false},
+	})
+	assert.Nil(t, err)
+	err = pm.AddUnlockReceipt(ctx, permlink, unlocker, types.NewCoinFromInt64(100))
+	assert.Nil(t, err)
+	_, err = am.AddSubscription(ctx, subscriber, author, types.NewCoinFromInt64(1), 1, 100)
+	assert.Nil(t, err)
+
+	testCases := []struct {
+		testName     string
+		accessMode   types.PostAccessMode
+		user         types.AccountKey
+		atWhen       int64
+		expectAccess bool
+	}{
+		{
+			testName:     "public post can be accessed by anyone",
+			accessMode:   types.PublicAccess,
+			user:         stranger,
+			expectAccess: true,
+		},
+		{
+			testName:     "author can access own post",
+			accessMode:   types.UnlockAccess,
+			user:         author,
+			expectAccess: true,
+		},
+		{
+			testName:     "approved co-author can access own post",
+			accessMode:   types.UnlockAccess,
+			user:         coAuthor,
+			expectAccess: true,
+		},
+		{
+			testName:     "unapproved co-author needs to unlock",
+			accessMode:   types.UnlockAccess,
+			user:         pendingCoAuthor,
+			expectAccess: false,
+		},
+		{
+			testName:     "user unlocked the post",
+			accessMode:   types.UnlockAccess,
+			user:         unlocker,
+			expectAccess: true,
+		},
+		{
+			testName:     "unlocked user isn't a subscriber",
+			accessMode:   types.SubscriberAccess,
+			user:         unlocker,
+			expectAccess: false,
+		},
+		{
+			testName:     "co-author can access subscriber only post",
+			accessMode:   types.SubscriberAccess,
+			user:         coAuthor,
+			expectAccess: true,
+		},
+		{
+			testName:     "active subscriber",
+			accessMode:   types.SubscriberAccess,
+			user:         subscriber,
+			expectAccess: true,
+		},
+		{
+			testName:     "subscription expired at block time",
+			accessMode:   types.SubscriberAccess,
+			user:         subscriber,
+			atWhen:       100,
+			expectAccess: false,
+		},
+	}
+	for _, tc := range testCases {
+		err := pm.SetPostAccess(ctx, permlink, tc.accessMode, types.NewCoinFromInt64(100))
+		assert.Nil(t, err)
+		newCtx := ctx.WithBlockHeader(abci.Header{
+			ChainID: "Lino", Time: time.Unix(ctx.BlockHeader().Time.Unix()+tc.atWhen, 0)})
+		result, err := pm.GetUserPostAccess(newCtx, tc.user, permlink, am)
+		if err != nil {
+			t.Errorf("%s: failed to get post access, got err %v", tc.testName, err)
+			continue
+		}
+		if result.CanAccess != tc.expectAccess {
+			t.Errorf("%s: diff access, got %v, want %v", tc.testName, result.CanAccess, tc.expectAccess)
+		}
+	}
+}
@@ -97,3 +97,73 @@ func ErrFailedToUnmarshalPostView(err error) sdk.Error {
 func ErrFailedToUnmarshalPostDonations(err error) sdk.Error {
 	return types.NewError(types.CodeFailedToUnmarshalPostDonations, fmt.Sprintf("failed to unmarshal post donations: %s", err.Error()))
 }
+
+// ErrFailedToMarshalPostAccess - error if marshal post access failed
+func ErrFailedToMarshalPostAccess(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalPostAccess, fmt.Sprintf("failed to marshal post access: %s", err.Error()))
+}
+
+// ErrFailedToUnmarshalPostAccess - error if unmarshal post access failed
+func ErrFailedToUnmarshalPostAccess(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalPostAccess, fmt.Sprintf("failed to unmarshal post access: %s", err.Error()))
+}
+
+// ErrFailedToMarshalUnlockReceipt - error if marshal unlock receipt failed
+func ErrFailedToMarshalUnlockReceipt(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalUnlockReceipt, fmt.Sprintf("failed to marshal unlock receipt: %s", err.Error()))
+}
+
+// ErrFailedToUnmarshalUnlockReceipt - error if unmarshal unlock receipt failed
+func ErrFailedToUnmarshalUnlockReceipt(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalUnlockReceipt, fmt.Sprintf("failed to unmarshal unlock receipt: %s", err.Error()))
+}
+
+// ErrFailedToMarshalCoAuthors - error if marshal post co-authors failed
+func ErrFailedToMarshalCoAuthors(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalCoAuthors, fmt.Sprintf("failed to marshal post co-authors: %s", err.Error()))
+}
+
+// ErrFailedToUnmarshalCoAuthors - error if unmarshal post co-authors failed
+func ErrFailedToUnmarshalCoAuthors(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalCoAuthors, fmt.Sprintf("failed to unmarshal post co-authors: %s", err.Error()))
+}
+
+// ErrFailedToMarshalReporterStat - error if marshal reporter stat failed
+func ErrFailedToMarshalReporterStat(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalReporterStat, fmt.Sprintf("failed to marshal reporter stat: %s", err.Error()))
+}
+
+// ErrFailedToUnmarshalReporterStat - error if unmarshal reporter stat failed
+func ErrFailedToUnmarshalReporterStat(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalReporterStat, fmt.Sprintf("failed to unmarshal reporter stat: %s", err.Error()))
+}
+
+// ErrFailedToMarshalPenaltyScore - error if marshal post penalty score failed
+func ErrFailedToMarshalPenaltyScore(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalPenaltyScore, fmt.Sprintf("failed to marshal post penalty score: %s", err.Error()))
+}
+
+// ErrFailedToUnmarshalPenaltyScore - error if unmarshal post penalty score failed
+func ErrFailedToUnmarshalPenaltyScore(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalPenaltyScore, fmt.Sprintf("failed to unmarshal post penalty score: %s", err.Error()))
+}
+
+// ErrFailedToMarshalRepostRoyalty - error if marshal repost royalty failed
+func ErrFailedToMarshalRepostRoyalty(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalRepostRoyalty, fmt.Sprintf("failed to marshal repost royalty: %s", err.Error()))
+}
+
+// ErrFailedToUnmarshalRepostRoyalty - error if unmarshal repost royalty failed
+func ErrFailedToUnmarshalRepostRoyalty(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalRepostRoyalty, fmt.Sprintf("failed to unmarshal repost royalty: %s", err.Error()))
+}
+
+// ErrFailedToMarshalPendingReward - error if marshal pending reward failed
+func ErrFailedToMarshalPendingReward(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalPendingReward, fmt.Sprintf("failed to marshal pending reward: %s", err.Error()))
+}
+
+// ErrFailedToUnmarshalPendingReward - error if unmarshal pending reward failed
+func ErrFailedToUnmarshalPendingReward(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalPendingReward, fmt.Sprintf("failed to unmarshal pending reward: %s", err.Error()))
+}
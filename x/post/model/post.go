@@ -13,6 +13,8 @@ type Identifier string
 type URL string
 
 // PostInfo - can also use to present comment(with parent) or repost(with source)
+// Source of a repost is always the root post, RepostChain records upstream posts
+// from the direct source to the root which share royalty of donations to the repost
 type PostInfo struct {
 	PostID       string                 `json:"post_id"`
 	Title        string                 `json:"title"`
@@ -23,29 +25,46 @@ type PostInfo struct {
 	SourceAuthor types.AccountKey       `json:"source_author"`
 	SourcePostID string                 `json:"source_postID"`
 	Links        []types.IDToURLMapping `json:"links"`
+	Tags         []string               `json:"tags"`
+	RepostChain  []RepostHop            `json:"repost_chain"`
+}
+
+// RepostHop - an upstream post in repost chain
+type RepostHop struct {
+	Author types.AccountKey `json:"author"`
+	PostID string           `json:"post_id"`
 }
 
 // PostMeta - stores tiny and frequently updated fields.
 type PostMeta struct {
-	CreatedAt               int64      `json:"created_at"`
-	LastUpdatedAt           int64      `json:"last_updated_at"`
-	LastActivityAt          int64      `json:"last_activity_at"`
-	AllowReplies            bool       `json:"allow_replies"`
-	IsDeleted               bool       `json:"is_deleted"`
-	TotalDonateCount        int64      `json:"total_donate_count"`
-	TotalReportStake        types.Coin `json:"total_report_stake"`
-	TotalUpvoteStake        types.Coin `json:"total_upvote_stake"`
-	TotalViewCount          int64      `json:"total_view_count"`
-	TotalReward             types.Coin `json:"total_reward"`
-	RedistributionSplitRate sdk.Rat    `json:"redistribution_split_rate"`
+	CreatedAt               int64             `json:"created_at"`
+	LastUpdatedAt           int64             `json:"last_updated_at"`
+	LastActivityAt          int64             `json:"last_activity_at"`
+	AllowReplies            bool              `json:"allow_replies"`
+	IsDeleted               bool              `json:"is_deleted"`
+	TotalDonateCount        int64             `json:"total_donate_count"`
+	TotalReportStake        types.Coin        `json:"total_report_stake"`
+	TotalUpvoteStake        types.Coin        `json:"total_upvote_stake"`
+	TotalViewCount          int64             `json:"total_view_count"`
+	TotalReward             types.Coin        `json:"total_reward"`
+	RedistributionSplitRate sdk.Rat           `json:"redistribution_split_rate"`
+	ReplyMode               types.ReplyMode   `json:"reply_mode"`
+	IsHidden                bool              `json:"is_hidden"`
+	IsCensored              bool              `json:"is_censored"`
+	CensoredBy              types.ProposalKey `json:"censored_by"`
 }
 
 // ReportOrUpvote - report or upvote from a user to a post
+// Reason and Evidence are only used by report, a rejected report
+// doesn't count in post total report stake
 type ReportOrUpvote struct {
-	Username  types.AccountKey `json:"username"`
-	Stake     types.Coin       `json:"stake"`
-	CreatedAt int64            `json:"created_at"`
-	IsReport  bool             `json:"is_report"`
+	Username  types.AccountKey   `json:"username"`
+	Stake     types.Coin         `json:"stake"`
+	CreatedAt int64              `json:"created_at"`
+	IsReport  bool               `json:"is_report"`
+	Reason    types.ReportReason `json:"reason"`
+	Evidence  string             `json:"evidence"`
+	Rejected  bool               `json:"rejected"`
 }
 
 // Comment - comment list store dy a post
@@ -68,3 +87,80 @@ type Donations struct {
 	Times    int64            `json:"times"`
 	Amount   types.Coin       `json:"amount"`
 }
+
+// RepostRoyalty - royalty paid to an upstream post from donations to a repost,
+// hop is the distance from the repost to the upstream post in repost chain
+type RepostRoyalty struct {
+	Repost        types.Permlink `json:"repost"`
+	Hop           int64          `json:"hop"`
+	Times         int64          `json:"times"`
+	DirectDeposit types.Coin     `json:"direct_deposit"`
+	Inflation     types.Coin     `json:"inflation"`
+}
+
+// PostAccess - access restriction of a post, a post without access record is public
+type PostAccess struct {
+	Mode        types.PostAccessMode `json:"mode"`
+	UnlockPrice types.Coin           `json:"unlock_price"`
+}
+
+// UnlockReceipt - record a user unlock payment to a post
+type UnlockReceipt struct {
+	Username   types.AccountKey `json:"username"`
+	Amount     types.Coin       `json:"amount"`
+	UnlockedAt int64            `json:"unlocked_at"`
+}
+
+// PostAccessResult - access of a user to full content of a post
+type PostAccessResult struct {
+	Username    types.AccountKey     `json:"username"`
+	Permlink    types.Permlink       `json:"permlink"`
+	AccessMode  types.PostAccessMode `json:"access_mode"`
+	UnlockPrice types.Coin           `json:"unlock_price"`
+	CanAccess   bool                 `json:"can_access"`
+}
+
+// RewardEstimate - expected inflation reward of a donation to a post if the
+// reward event was executed now, reward at is when the reward event is scheduled
+type RewardEstimate struct {
+	Consumer       types.AccountKey `json:"consumer"`
+	Original       types.Coin       `json:"original"`
+	Evaluate       types.Coin       `json:"evaluate"`
+	PenaltyScore   sdk.Rat          `json:"penalty_score"`
+	ExpectedReward types.Coin       `json:"expected_reward"`
+	RewardAt       int64            `json:"reward_at"`
+	Repost         types.Permlink   `json:"repost"`
+}
+
+// CoAuthor - co-author of a post and the weight of post revenue
+type CoAuthor struct {
+	Username types.AccountKey `json:"username"`
+	Weight   sdk.Rat          `json:"weight"`
+	Approved bool             `json:"approved"`
+}
+
+// CoAuthors - all authors of a post, including the post author
+type CoAuthors struct {
+	Authors []CoAuthor `json:"authors"`
+}
+
+// ReporterStat - report history of a user, used to lower the report weight of frivolous reporter
+type ReporterStat struct {
+	RejectedReports int64 `json:"rejected_reports"`
+}
+
+// PenaltyScore - penalty score cache of a post, recomputed from current stake
+// of reporters and upvoters when the post reward is calculated
+type PenaltyScore struct {
+	Score     sdk.Rat `json:"score"`
+	UpdatedAt int64   `json:"updated_at"`
+}
+
+// PenaltyScoreAudit - cached penalty score of a post and the score recomputed
+// at recompute time, permlink is the root source post the score belongs to
+type PenaltyScoreAudit struct {
+	Permlink    types.Permlink `json:"permlink"`
+	Cached      *PenaltyScore  `json:"cached"`
+	Recomputed  sdk.Rat        `json:"recomputed"`
+	RecomputeAt int64          `json:"recompute_at"`
+}
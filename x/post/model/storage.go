@@ -1,6 +1,9 @@
 package model
 
 import (
+	"fmt"
+	"strconv"
+
 	"github.com/cosmos/cosmos-sdk/wire"
 	"github.com/lino-network/lino/types"
 
@@ -14,6 +17,14 @@ var (
 	postCommentSubStore        = []byte{0x03} // SubStore for all comments
 	postViewsSubStore          = []byte{0x04} // SubStore for all views
 	postDonationsSubStore      = []byte{0x05} // SubStore for all donations
+	postTagSubStore            = []byte{0x06} // SubStore for tag to post index
+	postAccessSubStore         = []byte{0x07} // SubStore for post access restriction
+	postUnlockReceiptSubStore  = []byte{0x08} // SubStore for all unlock receipts
+	postCoAuthorsSubStore      = []byte{0x09} // SubStore for post co-authors
+	postReporterStatSubStore   = []byte{0x0a} // SubStore for reporter stat
+	postPenaltyScoreSubStore   = []byte{0x0b} // SubStore for post penalty score cache
+	postRepostRoyaltySubStore  = []byte{0x0c} // SubStore for royalty paid from reposts
+	postPendingRewardSubStore  = []byte{0x0d} // SubStore for time of pending reward events
 )
 
 // PostStorage - post storage
@@ -108,6 +119,24 @@ func (ps PostStorage) GetPostReportOrUpvote(
 	return reportOrUpvote, nil
 }
 
+// GetPostReportOrUpvotes - get all reports and upvotes of a post from KVStore
+func (ps PostStorage) GetPostReportOrUpvotes(
+	ctx sdk.Context, permlink types.Permlink) ([]ReportOrUpvote, sdk.Error) {
+	store := ctx.KVStore(ps.key)
+	iter := store.Iterator(subspace(GetPostReportOrUpvotePrefix(permlink)))
+	defer iter.Close()
+
+	reportOrUpvotes := []ReportOrUpvote{}
+	for ; iter.Valid(); iter.Next() {
+		reportOrUpvote := ReportOrUpvote{}
+		if unmarshalErr := ps.cdc.UnmarshalJSON(iter.Value(), &reportOrUpvote); unmarshalErr != nil {
+			return nil, ErrFailedToUnmarshalPostReportOrUpvote(unmarshalErr)
+		}
+		reportOrUpvotes = append(reportOrUpvotes, reportOrUpvote)
+	}
+	return reportOrUpvotes, nil
+}
+
 // SetPostReportOrUpvote - set report or upvote to KVStore
 func (ps PostStorage) SetPostReportOrUpvote(
 	ctx sdk.Context, permlink types.Permlink, reportOrUpvote *ReportOrUpvote) sdk.Error {
@@ -135,6 +164,24 @@ func (ps PostStorage) GetPostComment(
 	return postComment, nil
 }
 
+// GetPostComments - get all comments of a post from KVStore
+func (ps PostStorage) GetPostComments(
+	ctx sdk.Context, permlink types.Permlink) ([]Comment, sdk.Error) {
+	store := ctx.KVStore(ps.key)
+	iter := store.Iterator(subspace(getPostCommentPrefix(permlink)))
+	defer iter.Close()
+
+	comments := []Comment{}
+	for ; iter.Valid(); iter.Next() {
+		comment := Comment{}
+		if unmarshalErr := ps.cdc.UnmarshalJSON(iter.Value(), &comment); unmarshalErr != nil {
+			return nil, ErrFailedToUnmarshalPostComment(unmarshalErr)
+		}
+		comments = append(comments, comment)
+	}
+	return comments, nil
+}
+
 // SetPostComment - set post comment to KVStore
 func (ps PostStorage) SetPostComment(
 	ctx sdk.Context, permlink types.Permlink, postComment *Comment) sdk.Error {
@@ -202,6 +249,241 @@ func (ps PostStorage) SetPostDonations(
 	return nil
 }
 
+// SetPostTag - add post to tag index, ordered by post created time
+func (ps PostStorage) SetPostTag(
+	ctx sdk.Context, tag string, createdAt int64, permlink types.Permlink) {
+	store := ctx.KVStore(ps.key)
+	store.Set(GetPostTagKey(tag, createdAt, permlink), []byte(permlink))
+}
+
+// RemovePostTag - remove post from tag index
+func (ps PostStorage) RemovePostTag(
+	ctx sdk.Context, tag string, createdAt int64, permlink types.Permlink) {
+	store := ctx.KVStore(ps.key)
+	store.Delete(GetPostTagKey(tag, createdAt, permlink))
+}
+
+// GetPostsByTag - get at most limit posts under the tag, the most recent post first
+func (ps PostStorage) GetPostsByTag(ctx sdk.Context, tag string, limit int) []types.Permlink {
+	store := ctx.KVStore(ps.key)
+	iter := store.ReverseIterator(subspace(GetPostTagPrefix(tag)))
+	defer iter.Close()
+
+	permlinks := []types.Permlink{}
+	for ; iter.Valid() && len(permlinks) < limit; iter.Next() {
+		permlinks = append(permlinks, types.Permlink(iter.Value()))
+	}
+	return permlinks
+}
+
+// GetPostAccess - get post access restriction from KVStore, returns nil if post is public
+func (ps PostStorage) GetPostAccess(ctx sdk.Context, permlink types.Permlink) (*PostAccess, sdk.Error) {
+	store := ctx.KVStore(ps.key)
+	accessBytes := store.Get(GetPostAccessKey(permlink))
+	if accessBytes == nil {
+		return nil, nil
+	}
+	postAccess := new(PostAccess)
+	if unmarshalErr := ps.cdc.UnmarshalJSON(accessBytes, postAccess); unmarshalErr != nil {
+		return nil, ErrFailedToUnmarshalPostAccess(unmarshalErr)
+	}
+	return postAccess, nil
+}
+
+// SetPostAccess - set post access restriction to KVStore
+func (ps PostStorage) SetPostAccess(
+	ctx sdk.Context, permlink types.Permlink, postAccess *PostAccess) sdk.Error {
+	store := ctx.KVStore(ps.key)
+	accessBytes, err := ps.cdc.MarshalJSON(*postAccess)
+	if err != nil {
+		return ErrFailedToMarshalPostAccess(err)
+	}
+	store.Set(GetPostAccessKey(permlink), accessBytes)
+	return nil
+}
+
+// DoesUnlockReceiptExist - check if user has unlocked the post
+func (ps PostStorage) DoesUnlockReceiptExist(
+	ctx sdk.Context, user types.AccountKey, permlink types.Permlink) bool {
+	store := ctx.KVStore(ps.key)
+	return store.Has(GetUnlockReceiptKey(user, permlink))
+}
+
+// GetUnlockReceipt - get unlock receipt from KVStore, returns nil if user hasn't unlocked the post
+func (ps PostStorage) GetUnlockReceipt(
+	ctx sdk.Context, user types.AccountKey, permlink types.Permlink) (*UnlockReceipt, sdk.Error) {
+	store := ctx.KVStore(ps.key)
+	receiptBytes := store.Get(GetUnlockReceiptKey(user, permlink))
+	if receiptBytes == nil {
+		return nil, nil
+	}
+	receipt := new(UnlockReceipt)
+	if unmarshalErr := ps.cdc.UnmarshalJSON(receiptBytes, receipt); unmarshalErr != nil {
+		return nil, ErrFailedToUnmarshalUnlockReceipt(unmarshalErr)
+	}
+	return receipt, nil
+}
+
+// SetUnlockReceipt - set unlock receipt to KVStore
+func (ps PostStorage) SetUnlockReceipt(
+	ctx sdk.Context, permlink types.Permlink, receipt *UnlockReceipt) sdk.Error {
+	store := ctx.KVStore(ps.key)
+	receiptBytes, err := ps.cdc.MarshalJSON(*receipt)
+	if err != nil {
+		return ErrFailedToMarshalUnlockReceipt(err)
+	}
+	store.Set(GetUnlockReceiptKey(receipt.Username, permlink), receiptBytes)
+	return nil
+}
+
+// GetPostCoAuthors - get post co-authors from KVStore, returns nil if post has no co-author
+func (ps PostStorage) GetPostCoAuthors(ctx sdk.Context, permlink types.Permlink) (*CoAuthors, sdk.Error) {
+	store := ctx.KVStore(ps.key)
+	coAuthorsBytes := store.Get(GetPostCoAuthorsKey(permlink))
+	if coAuthorsBytes == nil {
+		return nil, nil
+	}
+	coAuthors := new(CoAuthors)
+	if unmarshalErr := ps.cdc.UnmarshalJSON(coAuthorsBytes, coAuthors); unmarshalErr != nil {
+		return nil, ErrFailedToUnmarshalCoAuthors(unmarshalErr)
+	}
+	return coAuthors, nil
+}
+
+// SetPostCoAuthors - set post co-authors to KVStore
+func (ps PostStorage) SetPostCoAuthors(
+	ctx sdk.Context, permlink types.Permlink, coAuthors *CoAuthors) sdk.Error {
+	store := ctx.KVStore(ps.key)
+	coAuthorsBytes, err := ps.cdc.MarshalJSON(*coAuthors)
+	if err != nil {
+		return ErrFailedToMarshalCoAuthors(err)
+	}
+	store.Set(GetPostCoAuthorsKey(permlink), coAuthorsBytes)
+	return nil
+}
+
+// GetReporterStat - get reporter stat from KVStore, returns empty stat if user never got report rejected
+func (ps PostStorage) GetReporterStat(ctx sdk.Context, user types.AccountKey) (*ReporterStat, sdk.Error) {
+	store := ctx.KVStore(ps.key)
+	statBytes := store.Get(GetReporterStatKey(user))
+	if statBytes == nil {
+		return &ReporterStat{}, nil
+	}
+	stat := new(ReporterStat)
+	if unmarshalErr := ps.cdc.UnmarshalJSON(statBytes, stat); unmarshalErr != nil {
+		return nil, ErrFailedToUnmarshalReporterStat(unmarshalErr)
+	}
+	return stat, nil
+}
+
+// SetReporterStat - set reporter stat to KVStore
+func (ps PostStorage) SetReporterStat(
+	ctx sdk.Context, user types.AccountKey, stat *ReporterStat) sdk.Error {
+	store := ctx.KVStore(ps.key)
+	statBytes, err := ps.cdc.MarshalJSON(*stat)
+	if err != nil {
+		return ErrFailedToMarshalReporterStat(err)
+	}
+	store.Set(GetReporterStatKey(user), statBytes)
+	return nil
+}
+
+// GetPostPenaltyScore - get post penalty score cache from KVStore, returns nil if never computed
+func (ps PostStorage) GetPostPenaltyScore(ctx sdk.Context, permlink types.Permlink) (*PenaltyScore, sdk.Error) {
+	store := ctx.KVStore(ps.key)
+	penaltyScoreBytes := store.Get(GetPostPenaltyScoreKey(permlink))
+	if penaltyScoreBytes == nil {
+		return nil, nil
+	}
+	penaltyScore := new(PenaltyScore)
+	if unmarshalErr := ps.cdc.UnmarshalJSON(penaltyScoreBytes, penaltyScore); unmarshalErr != nil {
+		return nil, ErrFailedToUnmarshalPenaltyScore(unmarshalErr)
+	}
+	return penaltyScore, nil
+}
+
+// SetPostPenaltyScore - set post penalty score cache to KVStore
+func (ps PostStorage) SetPostPenaltyScore(
+	ctx sdk.Context, permlink types.Permlink, penaltyScore *PenaltyScore) sdk.Error {
+	store := ctx.KVStore(ps.key)
+	penaltyScoreBytes, err := ps.cdc.MarshalJSON(*penaltyScore)
+	if err != nil {
+		return ErrFailedToMarshalPenaltyScore(err)
+	}
+	store.Set(GetPostPenaltyScoreKey(permlink), penaltyScoreBytes)
+	return nil
+}
+
+// DeletePostPenaltyScore - remove post penalty score cache from KVStore
+func (ps PostStorage) DeletePostPenaltyScore(ctx sdk.Context, permlink types.Permlink) {
+	store := ctx.KVStore(ps.key)
+	store.Delete(GetPostPenaltyScoreKey(permlink))
+}
+
+// GetPostRepostRoyalty - get royalty paid to post from a repost, returns nil if never paid
+func (ps PostStorage) GetPostRepostRoyalty(
+	ctx sdk.Context, permlink types.Permlink, repost types.Permlink) (*RepostRoyalty, sdk.Error) {
+	store := ctx.KVStore(ps.key)
+	royaltyBytes := store.Get(getPostRepostRoyaltyKey(permlink, repost))
+	if royaltyBytes == nil {
+		return nil, nil
+	}
+	royalty := new(RepostRoyalty)
+	if unmarshalErr := ps.cdc.UnmarshalJSON(royaltyBytes, royalty); unmarshalErr != nil {
+		return nil, ErrFailedToUnmarshalRepostRoyalty(unmarshalErr)
+	}
+	return royalty, nil
+}
+
+// SetPostRepostRoyalty - set royalty paid to post from a repost to KVStore
+func (ps PostStorage) SetPostRepostRoyalty(
+	ctx sdk.Context, permlink types.Permlink, royalty *RepostRoyalty) sdk.Error {
+	store := ctx.KVStore(ps.key)
+	royaltyBytes, err := ps.cdc.MarshalJSON(*royalty)
+	if err != nil {
+		return ErrFailedToMarshalRepostRoyalty(err)
+	}
+	store.Set(getPostRepostRoyaltyKey(permlink, royalty.Repost), royaltyBytes)
+	return nil
+}
+
+// GetPostPendingRewardTimes - get times of reward events of the post which haven't been executed
+func (ps PostStorage) GetPostPendingRewardTimes(
+	ctx sdk.Context, permlink types.Permlink) ([]int64, sdk.Error) {
+	store := ctx.KVStore(ps.key)
+	iter := store.Iterator(subspace(getPostPendingRewardPrefix(permlink)))
+	defer iter.Close()
+
+	rewardTimes := []int64{}
+	for ; iter.Valid(); iter.Next() {
+		var rewardAt int64
+		if unmarshalErr := ps.cdc.UnmarshalJSON(iter.Value(), &rewardAt); unmarshalErr != nil {
+			return nil, ErrFailedToUnmarshalPendingReward(unmarshalErr)
+		}
+		rewardTimes = append(rewardTimes, rewardAt)
+	}
+	return rewardTimes, nil
+}
+
+// SetPostPendingReward - record time of a reward event of the post to KVStore
+func (ps PostStorage) SetPostPendingReward(
+	ctx sdk.Context, permlink types.Permlink, rewardAt int64) sdk.Error {
+	store := ctx.KVStore(ps.key)
+	rewardAtBytes, err := ps.cdc.MarshalJSON(rewardAt)
+	if err != nil {
+		return ErrFailedToMarshalPendingReward(err)
+	}
+	store.Set(getPostPendingRewardKey(permlink, rewardAt), rewardAtBytes)
+	return nil
+}
+
+// RemovePostPendingReward - remove time of a reward event of the post from KVStore
+func (ps PostStorage) RemovePostPendingReward(
+	ctx sdk.Context, permlink types.Permlink, rewardAt int64) {
+	store := ctx.KVStore(ps.key)
+	store.Delete(getPostPendingRewardKey(permlink, rewardAt))
+}
+
 // GetPostInfoKey - "post info substore" + "permlink"
 func GetPostInfoKey(permlink types.Permlink) []byte {
 	return append(postInfoSubStore, permlink...)
@@ -212,15 +494,15 @@ func GetPostMetaKey(permlink types.Permlink) []byte {
 	return append(postMetaSubStore, permlink...)
 }
 
-// getPostReportOrUpvotePrefix - "post report or upvote substore" + "permlink"
+// GetPostReportOrUpvotePrefix - "post report or upvote substore" + "permlink"
 // which can be used to access all reports belong to this post
-func getPostReportOrUpvotePrefix(permlink types.Permlink) []byte {
+func GetPostReportOrUpvotePrefix(permlink types.Permlink) []byte {
 	return append(append(postReportOrUpvoteSubStore, permlink...), types.KeySeparator...)
 }
 
 // getPostReportOrUpvotePrefix - "post report or upvote substore" + "permlink" + "user"
 func getPostReportOrUpvoteKey(permlink types.Permlink, user types.AccountKey) []byte {
-	return append(getPostReportOrUpvotePrefix(permlink), user...)
+	return append(GetPostReportOrUpvotePrefix(permlink), user...)
 }
 
 // getPostViewPrefix - "post view substore" + "permlink"
@@ -245,13 +527,86 @@ func getPostCommentKey(permlink types.Permlink, commentPermlink types.Permlink)
 	return append(getPostCommentPrefix(permlink), commentPermlink...)
 }
 
-// PostCommentPrefix - "donation substore" + "permlink"
+// GetPostDonationsPrefix - "donation substore" + "permlink"
 // which can be used to access all donations belong to this post
-func getPostDonationsPrefix(permlink types.Permlink) []byte {
+func GetPostDonationsPrefix(permlink types.Permlink) []byte {
 	return append(append(postDonationsSubStore, permlink...), types.KeySeparator...)
 }
 
 // getPostDonationKey - "donation substore" + "permlink" + "donator"
 func getPostDonationKey(permlink types.Permlink, donateUser types.AccountKey) []byte {
-	return append(getPostDonationsPrefix(permlink), donateUser...)
+	return append(GetPostDonationsPrefix(permlink), donateUser...)
+}
+
+// GetPostTagPrefix - "tag substore" + "tag"
+// which can be used to access all posts under this tag
+func GetPostTagPrefix(tag string) []byte {
+	return append(append(postTagSubStore, tag...), types.KeySeparator...)
+}
+
+// GetPostTagKey - "tag substore" + "tag" + "created at" + "permlink"
+// created time is zero padded so the index is ordered by time
+func GetPostTagKey(tag string, createdAt int64, permlink types.Permlink) []byte {
+	return append(append(append(GetPostTagPrefix(tag),
+		fmt.Sprintf("%020d", createdAt)...), types.KeySeparator...), permlink...)
+}
+
+// getPostPendingRewardPrefix - "pending reward substore" + "permlink"
+// which can be used to access all pending reward times of this post
+func getPostPendingRewardPrefix(permlink types.Permlink) []byte {
+	return append(append(postPendingRewardSubStore, permlink...), types.KeySeparator...)
+}
+
+// getPostPendingRewardKey - "pending reward substore" + "permlink" + "reward at"
+func getPostPendingRewardKey(permlink types.Permlink, rewardAt int64) []byte {
+	return append(getPostPendingRewardPrefix(permlink), strconv.FormatInt(rewardAt, 10)...)
+}
+
+// GetPostAccessKey - "post access substore" + "permlink"
+func GetPostAccessKey(permlink types.Permlink) []byte {
+	return append(postAccessSubStore, permlink...)
+}
+
+// GetUnlockReceiptPrefix - "unlock receipt substore" + "user"
+// which can be used to access all posts unlocked by this user
+func GetUnlockReceiptPrefix(user types.AccountKey) []byte {
+	return append(append(postUnlockReceiptSubStore, user...), types.KeySeparator...)
+}
+
+// GetUnlockReceiptKey - "unlock receipt substore" + "user" + "permlink"
+func GetUnlockReceiptKey(user types.AccountKey, permlink types.Permlink) []byte {
+	return append(GetUnlockReceiptPrefix(user), permlink...)
+}
+
+// GetPostCoAuthorsKey - "post co-authors substore" + "permlink"
+func GetPostCoAuthorsKey(permlink types.Permlink) []byte {
+	return append(postCoAuthorsSubStore, permlink...)
+}
+
+// GetReporterStatKey - "reporter stat substore" + "username"
+func GetReporterStatKey(user types.AccountKey) []byte {
+	return append(postReporterStatSubStore, user...)
+}
+
+// GetPostPenaltyScoreKey - "post penalty score substore" + "permlink"
+func GetPostPenaltyScoreKey(permlink types.Permlink) []byte {
+	return append(postPenaltyScoreSubStore, permlink...)
+}
+
+// GetPostRepostRoyaltyPrefix - "repost royalty substore" + "permlink"
+// which can be used to access all royalties paid to this post
+func GetPostRepostRoyaltyPrefix(permlink types.Permlink) []byte {
+	return append(append(postRepostRoyaltySubStore, permlink...), types.KeySeparator...)
+}
+
+// getPostRepostRoyaltyKey - "repost royalty substore" + "permlink" + "repost"
+func getPostRepostRoyaltyKey(permlink types.Permlink, repost types.Permlink) []byte {
+	return append(GetPostRepostRoyaltyPrefix(permlink), repost...)
+}
+
+func subspace(prefix []byte) (start, end []byte) {
+	end = make([]byte, len(prefix))
+	copy(end, prefix)
+	end[len(end)-1]++
+	return prefix, end
 }
@@ -126,6 +126,173 @@ func TestPostDonate(t *testing.T) {
 	})
 }
 
+func TestPostTag(t *testing.T) {
+	permlink1 := types.GetPermlink("user1", "post")
+	permlink2 := types.GetPermlink("user2", "post")
+
+	runTest(t, func(env TestEnv) {
+		env.ps.SetPostTag(env.ctx, "lino", 200, permlink1)
+		env.ps.SetPostTag(env.ctx, "lino", 1000, permlink2)
+		env.ps.SetPostTag(env.ctx, "lino-music", 100, permlink1)
+
+		permlinks := env.ps.GetPostsByTag(env.ctx, "lino", 10)
+		assert.Equal(t, []types.Permlink{permlink2, permlink1}, permlinks)
+
+		env.ps.RemovePostTag(env.ctx, "lino", 1000, permlink2)
+		permlinks = env.ps.GetPostsByTag(env.ctx, "lino", 10)
+		assert.Equal(t, []types.Permlink{permlink1}, permlinks)
+	})
+}
+
+func TestPostAccess(t *testing.T) {
+	permlink := types.GetPermlink("user1", "post")
+	postAccess := PostAccess{Mode: types.UnlockAccess, UnlockPrice: types.NewCoinFromInt64(100)}
+
+	runTest(t, func(env TestEnv) {
+		resultPtr, err := env.ps.GetPostAccess(env.ctx, permlink)
+		assert.Nil(t, err)
+		assert.Nil(t, resultPtr)
+
+		err = env.ps.SetPostAccess(env.ctx, permlink, &postAccess)
+		assert.Nil(t, err)
+
+		resultPtr, err = env.ps.GetPostAccess(env.ctx, permlink)
+		assert.Nil(t, err)
+		assert.Equal(t, postAccess, *resultPtr, "Post access should be equal")
+	})
+}
+
+func TestUnlockReceipt(t *testing.T) {
+	user := types.AccountKey("test")
+	permlink := types.GetPermlink("user1", "post")
+	receipt := UnlockReceipt{Username: user, Amount: types.NewCoinFromInt64(100), UnlockedAt: 100}
+
+	runTest(t, func(env TestEnv) {
+		assert.False(t, env.ps.DoesUnlockReceiptExist(env.ctx, user, permlink))
+
+		err := env.ps.SetUnlockReceipt(env.ctx, permlink, &receipt)
+		assert.Nil(t, err)
+		assert.True(t, env.ps.DoesUnlockReceiptExist(env.ctx, user, permlink))
+
+		resultPtr, err := env.ps.GetUnlockReceipt(env.ctx, user, permlink)
+		assert.Nil(t, err)
+		assert.Equal(t, receipt, *resultPtr, "Unlock receipt should be equal")
+	})
+}
+
+func TestPostCoAuthors(t *testing.T) {
+	permlink := types.GetPermlink("user1", "post")
+	coAuthors := CoAuthors{
+		Authors: []CoAuthor{
+			{Username: "user1", Weight: sdk.NewRat(1, 2), Approved: true},
+			{Username: "user2", Weight: sdk.NewRat(1, 2), Approved: false},
+		},
+	}
+
+	runTest(t, func(env TestEnv) {
+		resultPtr, err := env.ps.GetPostCoAuthors(env.ctx, permlink)
+		assert.Nil(t, err)
+		assert.Nil(t, resultPtr)
+
+		err = env.ps.SetPostCoAuthors(env.ctx, permlink, &coAuthors)
+		assert.Nil(t, err)
+
+		resultPtr, err = env.ps.GetPostCoAuthors(env.ctx, permlink)
+		assert.Nil(t, err)
+		assert.Equal(t, coAuthors, *resultPtr, "Post co-authors should be equal")
+	})
+}
+
+func TestReporterStat(t *testing.T) {
+	runTest(t, func(env TestEnv) {
+		reporterStat, err := env.ps.GetReporterStat(env.ctx, "user1")
+		assert.Nil(t, err)
+		assert.Equal(t, ReporterStat{}, *reporterStat)
+
+		err = env.ps.SetReporterStat(env.ctx, "user1", &ReporterStat{RejectedReports: 2})
+		assert.Nil(t, err)
+
+		reporterStat, err = env.ps.GetReporterStat(env.ctx, "user1")
+		assert.Nil(t, err)
+		assert.Equal(t, ReporterStat{RejectedReports: 2}, *reporterStat)
+	})
+}
+
+func TestPostPenaltyScore(t *testing.T) {
+	permlink := types.GetPermlink("user1", "post")
+	penaltyScore := PenaltyScore{Score: sdk.NewRat(1, 10), UpdatedAt: 100}
+
+	runTest(t, func(env TestEnv) {
+		resultPtr, err := env.ps.GetPostPenaltyScore(env.ctx, permlink)
+		assert.Nil(t, err)
+		assert.Nil(t, resultPtr)
+
+		err = env.ps.SetPostPenaltyScore(env.ctx, permlink, &penaltyScore)
+		assert.Nil(t, err)
+
+		resultPtr, err = env.ps.GetPostPenaltyScore(env.ctx, permlink)
+		assert.Nil(t, err)
+		assert.Equal(t, penaltyScore, *resultPtr, "Post penalty score should be equal")
+
+		env.ps.DeletePostPenaltyScore(env.ctx, permlink)
+		resultPtr, err = env.ps.GetPostPenaltyScore(env.ctx, permlink)
+		assert.Nil(t, err)
+		assert.Nil(t, resultPtr)
+	})
+}
+
+func TestPostRepostRoyalty(t *testing.T) {
+	permlink := types.GetPermlink("user1", "post")
+	repost := types.GetPermlink("user2", "repost")
+	royalty := RepostRoyalty{
+		Repost:        repost,
+		Hop:           1,
+		Times:         2,
+		DirectDeposit: types.NewCoinFromInt64(100),
+		Inflation:     types.NewCoinFromInt64(10),
+	}
+
+	runTest(t, func(env TestEnv) {
+		resultPtr, err := env.ps.GetPostRepostRoyalty(env.ctx, permlink, repost)
+		assert.Nil(t, err)
+		assert.Nil(t, resultPtr)
+
+		err = env.ps.SetPostRepostRoyalty(env.ctx, permlink, &royalty)
+		assert.Nil(t, err)
+
+		resultPtr, err = env.ps.GetPostRepostRoyalty(env.ctx, permlink, repost)
+		assert.Nil(t, err)
+		assert.Equal(t, royalty, *resultPtr, "Post repost royalty should be equal")
+	})
+}
+
+func TestPostPendingReward(t *testing.T) {
+	permlink := types.GetPermlink("user1", "post")
+	otherPermlink := types.GetPermlink("user1", "post2")
+
+	runTest(t, func(env TestEnv) {
+		rewardTimes, err := env.ps.GetPostPendingRewardTimes(env.ctx, permlink)
+		assert.Nil(t, err)
+		assert.Equal(t, []int64{}, rewardTimes)
+
+		for _, rewardAt := range []int64{100, 200} {
+			err = env.ps.SetPostPendingReward(env.ctx, permlink, rewardAt)
+			assert.Nil(t, err)
+		}
+		err = env.ps.SetPostPendingReward(env.ctx, otherPermlink, 300)
+		assert.Nil(t, err)
+
+		rewardTimes, err = env.ps.GetPostPendingRewardTimes(env.ctx, permlink)
+		assert.Nil(t, err)
+		assert.Equal(t, []int64{100, 200}, rewardTimes)
+
+		env.ps.RemovePostPendingReward(env.ctx, permlink, 100)
+		rewardTimes, err = env.ps.GetPostPendingRewardTimes(env.ctx, permlink)
+		assert.Nil(t, err)
+		assert.Equal(t, []int64{200}, rewardTimes)
+	})
+}
+
 //
 // Test Environment setup
 //
@@ -2,6 +2,8 @@ package post
 
 import (
 	"fmt"
+	"strings"
+	"unicode"
 	"unicode/utf8"
 
 	"github.com/lino-network/lino/types"
@@ -15,6 +17,11 @@ var _ types.Msg = DeletePostMsg{}
 var _ types.Msg = DonateMsg{}
 var _ types.Msg = ReportOrUpvoteMsg{}
 var _ types.Msg = ViewMsg{}
+var _ types.Msg = UnlockPostMsg{}
+var _ types.Msg = ApproveCoAuthorMsg{}
+var _ types.Msg = TipMsg{}
+var _ types.Msg = HideCommentMsg{}
+var _ types.Msg = ViewReceiptsMsg{}
 
 // CreatePostMsg contains information to create a post
 type CreatePostMsg struct {
@@ -28,15 +35,28 @@ type CreatePostMsg struct {
 	SourcePostID            string                 `json:"source_postID"`
 	Links                   []types.IDToURLMapping `json:"links"`
 	RedistributionSplitRate string                 `json:"redistribution_split_rate"`
+	Tags                    []string               `json:"tags"`
+	AccessMode              types.PostAccessMode   `json:"access_mode"`
+	UnlockPrice             types.LNO              `json:"unlock_price"`
+	CoAuthors               []CoAuthorWeight       `json:"co_authors"`
+	ReplyMode               types.ReplyMode        `json:"reply_mode"`
+}
+
+// CoAuthorWeight - co-author of a post and the weight of post revenue in decimal
+type CoAuthorWeight struct {
+	Username types.AccountKey `json:"username"`
+	Weight   string           `json:"weight"`
 }
 
 // UpdatePostMsg - update post
 type UpdatePostMsg struct {
-	Author  types.AccountKey       `json:"author"`
-	PostID  string                 `json:"post_id"`
-	Title   string                 `json:"title"`
-	Content string                 `json:"content"`
-	Links   []types.IDToURLMapping `json:"links"`
+	Author    types.AccountKey       `json:"author"`
+	PostID    string                 `json:"post_id"`
+	Title     string                 `json:"title"`
+	Content   string                 `json:"content"`
+	Links     []types.IDToURLMapping `json:"links"`
+	Tags      []string               `json:"tags"`
+	ReplyMode *types.ReplyMode       `json:"reply_mode"`
 }
 
 // DeletePostMsg - sent from a user to a post
@@ -55,6 +75,39 @@ type DonateMsg struct {
 	Memo     string           `json:"memo"`
 }
 
+// TipMsg - sent from a user to tip an account without a post
+type TipMsg struct {
+	Username  types.AccountKey `json:"username"`
+	Amount    types.LNO        `json:"amount"`
+	Target    types.AccountKey `json:"target"`
+	Reference string           `json:"reference"`
+	FromApp   types.AccountKey `json:"from_app"`
+}
+
+// UnlockPostMsg - sent from a user to pay for a paywalled post
+type UnlockPostMsg struct {
+	Username types.AccountKey `json:"username"`
+	Author   types.AccountKey `json:"author"`
+	PostID   string           `json:"post_id"`
+	Amount   types.LNO        `json:"amount"`
+	FromApp  types.AccountKey `json:"from_app"`
+}
+
+// ApproveCoAuthorMsg - sent from a co-author to approve co-authorship of a post
+type ApproveCoAuthorMsg struct {
+	Username types.AccountKey `json:"username"`
+	Author   types.AccountKey `json:"author"`
+	PostID   string           `json:"post_id"`
+}
+
+// HideCommentMsg - sent from a post author to hide or unhide a comment on the post
+type HideCommentMsg struct {
+	Username      types.AccountKey `json:"username"`
+	CommentAuthor types.AccountKey `json:"comment_author"`
+	CommentPostID string           `json:"comment_post_id"`
+	IsHidden      bool             `json:"is_hidden"`
+}
+
 // ViewMsg - sent from a user to a post
 type ViewMsg struct {
 	Username types.AccountKey `json:"username"`
@@ -62,42 +115,60 @@ type ViewMsg struct {
 	PostID   string           `json:"post_id"`
 }
 
+// ViewReceiptsMsg - sent from a developer app to submit views of many users in one batch
+type ViewReceiptsMsg struct {
+	App      types.AccountKey `json:"app"`
+	Receipts []ViewReceipt    `json:"receipts"`
+}
+
+// ViewReceipt - a user viewed a post through an app, served by an optional infra provider
+type ViewReceipt struct {
+	Username      types.AccountKey `json:"username"`
+	Author        types.AccountKey `json:"author"`
+	PostID        string           `json:"post_id"`
+	InfraProvider types.AccountKey `json:"infra_provider"`
+}
+
 // ReportOrUpvoteMsg - sent from a user to a post
 type ReportOrUpvoteMsg struct {
-	Username types.AccountKey `json:"username"`
-	Author   types.AccountKey `json:"author"`
-	PostID   string           `json:"post_id"`
-	IsReport bool             `json:"is_report"`
+	Username types.AccountKey   `json:"username"`
+	Author   types.AccountKey   `json:"author"`
+	PostID   string             `json:"post_id"`
+	IsReport bool               `json:"is_report"`
+	Reason   types.ReportReason `json:"reason"`
+	Evidence string             `json:"evidence"`
 }
 
 // NewCreatePostMsg - constructs a post msg
 func NewCreatePostMsg(
 	author, postID, title, content, parentAuthor, parentPostID,
 	sourceAuthor, sourcePostID, redistributionSplitRate string,
-	links []types.IDToURLMapping) CreatePostMsg {
+	links []types.IDToURLMapping, tags []string) CreatePostMsg {
 	return CreatePostMsg{
-		Author:       types.AccountKey(author),
-		PostID:       postID,
-		Title:        title,
-		Content:      content,
-		ParentAuthor: types.AccountKey(parentAuthor),
-		ParentPostID: parentPostID,
-		SourceAuthor: types.AccountKey(sourceAuthor),
-		SourcePostID: sourcePostID,
-		Links:        links,
+		Author:                  types.AccountKey(author),
+		PostID:                  postID,
+		Title:                   title,
+		Content:                 content,
+		ParentAuthor:            types.AccountKey(parentAuthor),
+		ParentPostID:            parentPostID,
+		SourceAuthor:            types.AccountKey(sourceAuthor),
+		SourcePostID:            sourcePostID,
+		Links:                   links,
 		RedistributionSplitRate: redistributionSplitRate,
+		Tags:                    NormalizeTags(tags),
 	}
 }
 
 // NewUpdatePostMsg - constructs a UpdatePost msg
 func NewUpdatePostMsg(
-	author, postID, title, content string, links []types.IDToURLMapping) UpdatePostMsg {
+	author, postID, title, content string, links []types.IDToURLMapping, tags []string) UpdatePostMsg {
 	return UpdatePostMsg{
 		Author:  types.AccountKey(author),
 		PostID:  postID,
 		Title:   title,
 		Content: content,
 		Links:   links,
+		Tags:    NormalizeTags(tags),
 	}
 }
 
@@ -131,6 +202,57 @@ func NewDonateMsg(
 	}
 }
 
+// NewTipMsg - constructs a tip msg
+func NewTipMsg(
+	user string, amount types.LNO, target string, reference string, fromApp string) TipMsg {
+	return TipMsg{
+		Username:  types.AccountKey(user),
+		Amount:    amount,
+		Target:    types.AccountKey(target),
+		Reference: reference,
+		FromApp:   types.AccountKey(fromApp),
+	}
+}
+
+// NewUnlockPostMsg - constructs a unlock post msg
+func NewUnlockPostMsg(
+	user string, author string, postID string, amount types.LNO, fromApp string) UnlockPostMsg {
+	return UnlockPostMsg{
+		Username: types.AccountKey(user),
+		Author:   types.AccountKey(author),
+		PostID:   postID,
+		Amount:   amount,
+		FromApp:  types.AccountKey(fromApp),
+	}
+}
+
+// NewApproveCoAuthorMsg - constructs a approve co-author msg
+func NewApproveCoAuthorMsg(user, author, postID string) ApproveCoAuthorMsg {
+	return ApproveCoAuthorMsg{
+		Username: types.AccountKey(user),
+		Author:   types.AccountKey(author),
+		PostID:   postID,
+	}
+}
+
+// NewViewReceiptsMsg - constructs a view receipts msg
+func NewViewReceiptsMsg(app string, receipts []ViewReceipt) ViewReceiptsMsg {
+	return ViewReceiptsMsg{
+		App:      types.AccountKey(app),
+		Receipts: receipts,
+	}
+}
+
+// NewHideCommentMsg - constructs a hide comment msg
+func NewHideCommentMsg(user, commentAuthor, commentPostID string, isHidden bool) HideCommentMsg {
+	return HideCommentMsg{
+		Username:      types.AccountKey(user),
+		CommentAuthor: types.AccountKey(commentAuthor),
+		CommentPostID: commentPostID,
+		IsHidden:      isHidden,
+	}
+}
+
 // NewReportOrUpvoteMsg - constructs a ReportOrUpvote msg
 func NewReportOrUpvoteMsg(
 	user, author, postID string, isReport bool) ReportOrUpvoteMsg {
@@ -161,6 +283,21 @@ func (msg ReportOrUpvoteMsg) Type() string { return types.PostRouterName }
 // Type - implements sdk.Msg
 func (msg ViewMsg) Type() string { return types.PostRouterName }
 
+// Type - implements sdk.Msg
+func (msg UnlockPostMsg) Type() string { return types.PostRouterName }
+
+// Type - implements sdk.Msg
+func (msg ApproveCoAuthorMsg) Type() string { return types.PostRouterName }
+
+// Type - implements sdk.Msg
+func (msg HideCommentMsg) Type() string { return types.PostRouterName }
+
+// Type - implements sdk.Msg
+func (msg ViewReceiptsMsg) Type() string { return types.PostRouterName }
+
+// Type - implements sdk.Msg
+func (msg TipMsg) Type() string { return types.PostRouterName }
+
 // ValidateBasic - implements sdk.Msg
 func (msg CreatePostMsg) ValidateBasic() sdk.Error {
 	// Ensure permlink exists
@@ -200,6 +337,31 @@ func (msg CreatePostMsg) ValidateBasic() sdk.Error {
 		}
 	}
 
+	if err := validateTags(msg.Tags); err != nil {
+		return err
+	}
+
+	switch msg.AccessMode {
+	case types.PublicAccess, types.SubscriberAccess:
+		if len(msg.UnlockPrice) != 0 {
+			return ErrInvalidUnlockPrice()
+		}
+	case types.UnlockAccess:
+		price, err := types.LinoToCoin(msg.UnlockPrice)
+		if err != nil || !price.IsPositive() {
+			return ErrInvalidUnlockPrice()
+		}
+	default:
+		return ErrInvalidPostAccessMode()
+	}
+
+	if err := validateCoAuthors(msg.Author, msg.CoAuthors); err != nil {
+		return err
+	}
+	if err := validateReplyMode(msg.ReplyMode); err != nil {
+		return err
+	}
+
 	splitRate, err := sdk.NewRatFromDecimal(msg.RedistributionSplitRate, types.NewRatFromDecimalPrecision)
 	if err != nil {
 		return err
@@ -234,9 +396,26 @@ func (msg UpdatePostMsg) ValidateBasic() sdk.Error {
 			return ErrURLLengthTooLong()
 		}
 	}
+	if err := validateTags(msg.Tags); err != nil {
+		return err
+	}
+	if msg.ReplyMode != nil {
+		if err := validateReplyMode(*msg.ReplyMode); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+func validateReplyMode(mode types.ReplyMode) sdk.Error {
+	switch mode {
+	case types.ReplyEveryone, types.ReplyFollowersOnly, types.ReplyNobody:
+		return nil
+	default:
+		return ErrInvalidReplyMode()
+	}
+}
+
 // ValidateBasic - implements sdk.Msg
 func (msg DeletePostMsg) ValidateBasic() sdk.Error {
 	if len(msg.PostID) == 0 {
@@ -270,6 +449,26 @@ func (msg DonateMsg) ValidateBasic() sdk.Error {
 	return nil
 }
 
+// ValidateBasic - implements sdk.Msg
+func (msg TipMsg) ValidateBasic() sdk.Error {
+	if len(msg.Username) == 0 {
+		return ErrNoUsername()
+	}
+	if len(msg.Target) == 0 {
+		return ErrInvalidTarget()
+	}
+
+	_, err := types.LinoToCoin(msg.Amount)
+	if err != nil {
+		return err
+	}
+
+	if utf8.RuneCountInString(msg.Reference) > types.MaximumLengthOfTipReference {
+		return ErrInvalidTipReference()
+	}
+	return nil
+}
+
 // ValidateBasic - implements sdk.Msg
 func (msg ReportOrUpvoteMsg) ValidateBasic() sdk.Error {
 	if len(msg.Username) == 0 {
@@ -278,6 +477,22 @@ func (msg ReportOrUpvoteMsg) ValidateBasic() sdk.Error {
 	if len(msg.Author) == 0 || len(msg.PostID) == 0 {
 		return ErrInvalidTarget()
 	}
+	// upvote doesn't have reason and evidence
+	if !msg.IsReport {
+		if msg.Reason != types.ReportReasonUnspecified {
+			return ErrInvalidReportReason()
+		}
+		if len(msg.Evidence) != 0 {
+			return ErrInvalidReportEvidence()
+		}
+		return nil
+	}
+	if msg.Reason < types.ReportReasonUnspecified || msg.Reason > types.ReportReasonOther {
+		return ErrInvalidReportReason()
+	}
+	if utf8.RuneCountInString(msg.Evidence) > types.MaximumLengthOfReportEvidence {
+		return ErrInvalidReportEvidence()
+	}
 	return nil
 }
 
@@ -292,6 +507,66 @@ func (msg ViewMsg) ValidateBasic() sdk.Error {
 	return nil
 }
 
+// ValidateBasic - implements sdk.Msg
+func (msg UnlockPostMsg) ValidateBasic() sdk.Error {
+	if len(msg.Username) == 0 {
+		return ErrNoUsername()
+	}
+	if len(msg.Author) == 0 || len(msg.PostID) == 0 {
+		return ErrInvalidTarget()
+	}
+
+	_, err := types.LinoToCoin(msg.Amount)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// ValidateBasic - implements sdk.Msg
+func (msg ApproveCoAuthorMsg) ValidateBasic() sdk.Error {
+	if len(msg.Username) == 0 {
+		return ErrNoUsername()
+	}
+	if len(msg.Author) == 0 || len(msg.PostID) == 0 {
+		return ErrInvalidTarget()
+	}
+	return nil
+}
+
+// ValidateBasic - implements sdk.Msg
+func (msg ViewReceiptsMsg) ValidateBasic() sdk.Error {
+	if len(msg.App) == 0 {
+		return ErrNoUsername()
+	}
+	if len(msg.Receipts) == 0 {
+		return ErrInvalidViewReceipts("no receipt")
+	}
+	if len(msg.Receipts) > types.MaximumNumOfViewReceipts {
+		return ErrInvalidViewReceipts("too many receipts")
+	}
+	for _, receipt := range msg.Receipts {
+		if len(receipt.Username) == 0 {
+			return ErrInvalidViewReceipts("no username")
+		}
+		if len(receipt.Author) == 0 || len(receipt.PostID) == 0 {
+			return ErrInvalidViewReceipts("invalid target")
+		}
+	}
+	return nil
+}
+
+// ValidateBasic - implements sdk.Msg
+func (msg HideCommentMsg) ValidateBasic() sdk.Error {
+	if len(msg.Username) == 0 {
+		return ErrNoUsername()
+	}
+	if len(msg.CommentAuthor) == 0 || len(msg.CommentPostID) == 0 {
+		return ErrInvalidTarget()
+	}
+	return nil
+}
+
 // GetPermission - implements types.Msg
 func (msg CreatePostMsg) GetPermission() types.Permission {
 	return types.AppPermission
@@ -312,6 +587,11 @@ func (msg DonateMsg) GetPermission() types.Permission {
 	return types.PreAuthorizationPermission
 }
 
+// GetPermission - implements types.Msg
+func (msg TipMsg) GetPermission() types.Permission {
+	return types.PreAuthorizationPermission
+}
+
 // GetPermission - implements types.Msg
 func (msg ReportOrUpvoteMsg) GetPermission() types.Permission {
 	return types.AppPermission
@@ -322,6 +602,26 @@ func (msg ViewMsg) GetPermission() types.Permission {
 	return types.AppPermission
 }
 
+// GetPermission - implements types.Msg
+func (msg UnlockPostMsg) GetPermission() types.Permission {
+	return types.PreAuthorizationPermission
+}
+
+// GetPermission - implements types.Msg
+func (msg ApproveCoAuthorMsg) GetPermission() types.Permission {
+	return types.AppPermission
+}
+
+// GetPermission - implements types.Msg
+func (msg HideCommentMsg) GetPermission() types.Permission {
+	return types.AppPermission
+}
+
+// GetPermission - implements types.Msg
+func (msg ViewReceiptsMsg) GetPermission() types.Permission {
+	return types.TransactionPermission
+}
+
 // GetSignBytes - implements sdk.Msg
 func (msg CreatePostMsg) GetSignBytes() []byte {
 	return getSignBytes(msg)
@@ -342,6 +642,11 @@ func (msg DonateMsg) GetSignBytes() []byte {
 	return getSignBytes(msg)
 }
 
+// GetSignBytes - implements sdk.Msg
+func (msg TipMsg) GetSignBytes() []byte {
+	return getSignBytes(msg)
+}
+
 // GetSignBytes - implements sdk.Msg
 func (msg ReportOrUpvoteMsg) GetSignBytes() []byte {
 	return getSignBytes(msg)
@@ -352,6 +657,99 @@ func (msg ViewMsg) GetSignBytes() []byte {
 	return getSignBytes(msg)
 }
 
+// GetSignBytes - implements sdk.Msg
+func (msg UnlockPostMsg) GetSignBytes() []byte {
+	return getSignBytes(msg)
+}
+
+// GetSignBytes - implements sdk.Msg
+func (msg ApproveCoAuthorMsg) GetSignBytes() []byte {
+	return getSignBytes(msg)
+}
+
+// GetSignBytes - implements sdk.Msg
+func (msg HideCommentMsg) GetSignBytes() []byte {
+	return getSignBytes(msg)
+}
+
+// GetSignBytes - implements sdk.Msg
+func (msg ViewReceiptsMsg) GetSignBytes() []byte {
+	return getSignBytes(msg)
+}
+
+// NormalizeTags - lower case and trim tags, drop the leading "#", empty and duplicate tags
+func NormalizeTags(tags []string) []string {
+	if tags == nil {
+		return nil
+	}
+	res := []string{}
+	seen := map[string]bool{}
+	for _, tag := range tags {
+		tag = strings.TrimPrefix(strings.ToLower(strings.TrimSpace(tag)), "#")
+		if tag == "" || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		res = append(res, tag)
+	}
+	return res
+}
+
+// validateTags - tags must be normalized and only contain letters, digits, "-" and "_"
+func validateTags(tags []string) sdk.Error {
+	if len(tags) > types.MaximumNumOfTags {
+		return ErrTooManyTags()
+	}
+	seen := map[string]bool{}
+	for _, tag := range tags {
+		if len(tag) == 0 || utf8.RuneCountInString(tag) > types.MaximumLengthOfTag || seen[tag] {
+			return ErrInvalidTag(tag)
+		}
+		for _, r := range tag {
+			if unicode.IsUpper(r) ||
+				!(unicode.IsLetter(r) || unicode.IsDigit(r) || r == '-' || r == '_') {
+				return ErrInvalidTag(tag)
+			}
+		}
+		seen[tag] = true
+	}
+	return nil
+}
+
+// validateCoAuthors - co-authors must include the post author without duplicate,
+// each weight must be positive and all weights must sum to 1
+func validateCoAuthors(author types.AccountKey, coAuthors []CoAuthorWeight) sdk.Error {
+	if len(coAuthors) == 0 {
+		return nil
+	}
+	if len(coAuthors) > types.MaximumNumOfCoAuthors {
+		return ErrInvalidCoAuthors("too many co-authors")
+	}
+	seen := map[types.AccountKey]bool{}
+	total := sdk.ZeroRat()
+	for _, coAuthor := range coAuthors {
+		if len(coAuthor.Username) == 0 || seen[coAuthor.Username] {
+			return ErrInvalidCoAuthors(fmt.Sprintf("invalid co-author %v", coAuthor.Username))
+		}
+		if len(coAuthor.Weight) > types.MaximumSdkRatLength {
+			return ErrInvalidCoAuthors(fmt.Sprintf("weight of %v is too long", coAuthor.Username))
+		}
+		weight, err := sdk.NewRatFromDecimal(coAuthor.Weight, types.NewRatFromDecimalPrecision)
+		if err != nil || !weight.GT(sdk.ZeroRat()) {
+			return ErrInvalidCoAuthors(fmt.Sprintf("invalid weight of %v", coAuthor.Username))
+		}
+		seen[coAuthor.Username] = true
+		total = total.Add(weight)
+	}
+	if !seen[author] {
+		return ErrInvalidCoAuthors("post author is not in co-authors")
+	}
+	if !total.Equal(sdk.OneRat()) {
+		return ErrInvalidCoAuthors("weights don't sum to 1")
+	}
+	return nil
+}
+
 func getSignBytes(msg sdk.Msg) []byte {
 	b, err := msgCdc.MarshalJSON(msg) // XXX: ensure some canonical form
 	if err != nil {
@@ -380,6 +778,11 @@ func (msg DonateMsg) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{sdk.AccAddress(msg.Username)}
 }
 
+// GetSigners - implements sdk.Msg
+func (msg TipMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Username)}
+}
+
 // GetSigners - implements sdk.Msg
 func (msg ReportOrUpvoteMsg) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{sdk.AccAddress(msg.Username)}
@@ -390,17 +793,42 @@ func (msg ViewMsg) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{sdk.AccAddress(msg.Username)}
 }
 
+// GetSigners - implements sdk.Msg
+func (msg UnlockPostMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Username)}
+}
+
+// GetSigners - implements sdk.Msg
+func (msg ApproveCoAuthorMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Username)}
+}
+
+// GetSigners - implements sdk.Msg
+func (msg HideCommentMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Username)}
+}
+
+// GetSigners - implements sdk.Msg
+func (msg ViewReceiptsMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.App)}
+}
+
 // String implements Stringer
 func (msg CreatePostMsg) String() string {
 	return fmt.Sprintf("Post.CreatePostMsg{author:%v, postID:%v, title:%v, content:%v, parentAuthor:%v,"+
-		"parentPostID:%v, sourceAuthor:%v, sourcePostID:%v,links:%v, redistribution split rate:%v}",
+		"parentPostID:%v, sourceAuthor:%v, sourcePostID:%v,links:%v, redistribution split rate:%v, tags:%v,"+
+		"access mode:%v, unlock price:%v, co-authors:%v, reply mode:%v}",
 		msg.Author, msg.PostID, msg.Title, msg.Content, msg.ParentAuthor, msg.ParentPostID, msg.SourceAuthor, msg.SourcePostID,
-		msg.Links, msg.RedistributionSplitRate)
+		msg.Links, msg.RedistributionSplitRate, msg.Tags, msg.AccessMode, msg.UnlockPrice, msg.CoAuthors, msg.ReplyMode)
 }
 
 func (msg UpdatePostMsg) String() string {
-	return fmt.Sprintf("Post.UpdatePostMsg{author:%v, postID:%v, title:%v, content:%v, links:%v}",
-		msg.Author, msg.PostID, msg.Title, msg.Content, msg.Links)
+	replyMode := "unchanged"
+	if msg.ReplyMode != nil {
+		replyMode = fmt.Sprintf("%v", *msg.ReplyMode)
+	}
+	return fmt.Sprintf("Post.UpdatePostMsg{author:%v, postID:%v, title:%v, content:%v, links:%v, tags:%v, reply mode:%v}",
+		msg.Author, msg.PostID, msg.Title, msg.Content, msg.Links, msg.Tags, replyMode)
 }
 
 func (msg DeletePostMsg) String() string {
@@ -413,10 +841,16 @@ func (msg DonateMsg) String() string {
 		msg.Username, msg.Amount, msg.Author, msg.PostID)
 }
 
+func (msg TipMsg) String() string {
+	return fmt.Sprintf(
+		"Post.TipMsg{tip from: %v, amount: %v, target:%v, reference: %v}",
+		msg.Username, msg.Amount, msg.Target, msg.Reference)
+}
+
 func (msg ReportOrUpvoteMsg) String() string {
 	return fmt.Sprintf(
-		"Post.ReportOrUpvoteMsg{from: %v, post author:%v, post id: %v}",
-		msg.Username, msg.Author, msg.PostID)
+		"Post.ReportOrUpvoteMsg{from: %v, post author:%v, post id: %v, is report: %v, reason: %v, evidence: %v}",
+		msg.Username, msg.Author, msg.PostID, msg.IsReport, msg.Reason, msg.Evidence)
 }
 
 func (msg ViewMsg) String() string {
@@ -425,6 +859,29 @@ func (msg ViewMsg) String() string {
 		msg.Username, msg.Author, msg.PostID)
 }
 
+func (msg UnlockPostMsg) String() string {
+	return fmt.Sprintf(
+		"Post.UnlockPostMsg{from: %v, amount: %v, post author:%v, post id: %v}",
+		msg.Username, msg.Amount, msg.Author, msg.PostID)
+}
+
+func (msg ApproveCoAuthorMsg) String() string {
+	return fmt.Sprintf(
+		"Post.ApproveCoAuthorMsg{from: %v, post author:%v, post id: %v}",
+		msg.Username, msg.Author, msg.PostID)
+}
+
+func (msg ViewReceiptsMsg) String() string {
+	return fmt.Sprintf(
+		"Post.ViewReceiptsMsg{app: %v, receipts: %v}", msg.App, msg.Receipts)
+}
+
+func (msg HideCommentMsg) String() string {
+	return fmt.Sprintf(
+		"Post.HideCommentMsg{from: %v, comment author:%v, comment post id: %v, is hidden: %v}",
+		msg.Username, msg.CommentAuthor, msg.CommentPostID, msg.IsHidden)
+}
+
 // GetConsumeAmount - implements types.Msg
 func (msg CreatePostMsg) GetConsumeAmount() types.Coin {
 	return types.NewCoinFromInt64(0)
@@ -446,6 +903,12 @@ func (msg DonateMsg) GetConsumeAmount() types.Coin {
 	return coin
 }
 
+// GetConsumeAmount - implements types.Msg
+func (msg TipMsg) GetConsumeAmount() types.Coin {
+	coin, _ := types.LinoToCoin(msg.Amount)
+	return coin
+}
+
 // GetConsumeAmount - implements types.Msg
 func (msg ReportOrUpvoteMsg) GetConsumeAmount() types.Coin {
 	return types.NewCoinFromInt64(0)
@@ -455,3 +918,24 @@ func (msg ReportOrUpvoteMsg) GetConsumeAmount() types.Coin {
 func (msg ViewMsg) GetConsumeAmount() types.Coin {
 	return types.NewCoinFromInt64(0)
 }
+
+// GetConsumeAmount - implements types.Msg
+func (msg UnlockPostMsg) GetConsumeAmount() types.Coin {
+	coin, _ := types.LinoToCoin(msg.Amount)
+	return coin
+}
+
+// GetConsumeAmount - implements types.Msg
+func (msg ApproveCoAuthorMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
+
+// GetConsumeAmount - implements types.Msg
+func (msg HideCommentMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
+
+// GetConsumeAmount - implements types.Msg
+func (msg ViewReceiptsMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
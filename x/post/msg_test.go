@@ -56,7 +56,7 @@ func getCommentAndRepost(
 	t *testing.T, parentAuthor, parentPostID, sourceAuthor, sourcePostID string) CreatePostMsg {
 	return NewCreatePostMsg(
 		"author", "TestPostID", string(make([]byte, 50)), string(make([]byte, 1000)),
-		parentAuthor, parentPostID, sourceAuthor, sourcePostID, "0", nil)
+		parentAuthor, parentPostID, sourceAuthor, sourcePostID, "0", nil, nil)
 }
 
 func TestCreatePostMsg(t *testing.T) {
@@ -267,6 +267,236 @@ func TestCreatePostMsg(t *testing.T) {
 			},
 			expectedResult: ErrURLLengthTooLong(),
 		},
+		{
+			testName: "post with tags",
+			msg: NewCreatePostMsg(
+				"author", "TestPostID", "title", "content", "", "", "", "", "0",
+				nil, []string{"#Lino", " music ", "lino", "中文_tag-1"}),
+			expectedResult: nil,
+		},
+		{
+			testName: "tag is not normalized",
+			msg: CreatePostMsg{
+				PostID:                  "TestPostID",
+				Title:                   "title",
+				Content:                 "content",
+				Author:                  author,
+				RedistributionSplitRate: "0",
+				Tags:                    []string{"Lino"},
+			},
+			expectedResult: ErrInvalidTag("Lino"),
+		},
+		{
+			testName: "tag contains invalid character",
+			msg: CreatePostMsg{
+				PostID:                  "TestPostID",
+				Title:                   "title",
+				Content:                 "content",
+				Author:                  author,
+				RedistributionSplitRate: "0",
+				Tags:                    []string{"lino/music"},
+			},
+			expectedResult: ErrInvalidTag("lino/music"),
+		},
+		{
+			testName: "duplicate tags",
+			msg: CreatePostMsg{
+				PostID:                  "TestPostID",
+				Title:                   "title",
+				Content:                 "content",
+				Author:                  author,
+				RedistributionSplitRate: "0",
+				Tags:                    []string{"lino", "lino"},
+			},
+			expectedResult: ErrInvalidTag("lino"),
+		},
+		{
+			testName: "tag is too long",
+			msg: NewCreatePostMsg(
+				"author", "TestPostID", "title", "content", "", "", "", "", "0",
+				nil, []string{"abcdefghijabcdefghijk"}),
+			expectedResult: ErrInvalidTag("abcdefghijabcdefghijk"),
+		},
+		{
+			testName: "too many tags",
+			msg: NewCreatePostMsg(
+				"author", "TestPostID", "title", "content", "", "", "", "", "0",
+				nil, []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11"}),
+			expectedResult: ErrTooManyTags(),
+		},
+		{
+			testName: "unlock access with price",
+			msg: CreatePostMsg{
+				PostID:                  "TestPostID",
+				Title:                   "title",
+				Content:                 "content",
+				Author:                  author,
+				RedistributionSplitRate: "0",
+				AccessMode:              types.UnlockAccess,
+				UnlockPrice:             types.LNO("1"),
+			},
+			expectedResult: nil,
+		},
+		{
+			testName: "subscriber access",
+			msg: CreatePostMsg{
+				PostID:                  "TestPostID",
+				Title:                   "title",
+				Content:                 "content",
+				Author:                  author,
+				RedistributionSplitRate: "0",
+				AccessMode:              types.SubscriberAccess,
+			},
+			expectedResult: nil,
+		},
+		{
+			testName: "unlock access without price",
+			msg: CreatePostMsg{
+				PostID:                  "TestPostID",
+				Title:                   "title",
+				Content:                 "content",
+				Author:                  author,
+				RedistributionSplitRate: "0",
+				AccessMode:              types.UnlockAccess,
+			},
+			expectedResult: ErrInvalidUnlockPrice(),
+		},
+		{
+			testName: "unlock access with zero price",
+			msg: CreatePostMsg{
+				PostID:                  "TestPostID",
+				Title:                   "title",
+				Content:                 "content",
+				Author:                  author,
+				RedistributionSplitRate: "0",
+				AccessMode:              types.UnlockAccess,
+				UnlockPrice:             types.LNO("0"),
+			},
+			expectedResult: ErrInvalidUnlockPrice(),
+		},
+		{
+			testName: "public access with price",
+			msg: CreatePostMsg{
+				PostID:                  "TestPostID",
+				Title:                   "title",
+				Content:                 "content",
+				Author:                  author,
+				RedistributionSplitRate: "0",
+				AccessMode:              types.PublicAccess,
+				UnlockPrice:             types.LNO("1"),
+			},
+			expectedResult: ErrInvalidUnlockPrice(),
+		},
+		{
+			testName: "invalid access mode",
+			msg: CreatePostMsg{
+				PostID:                  "TestPostID",
+				Title:                   "title",
+				Content:                 "content",
+				Author:                  author,
+				RedistributionSplitRate: "0",
+				AccessMode:              types.PostAccessMode(3),
+			},
+			expectedResult: ErrInvalidPostAccessMode(),
+		},
+		{
+			testName: "followers only reply mode",
+			msg: CreatePostMsg{
+				PostID:                  "TestPostID",
+				Title:                   "title",
+				Content:                 "content",
+				Author:                  author,
+				RedistributionSplitRate: "0",
+				ReplyMode:               types.ReplyFollowersOnly,
+			},
+			expectedResult: nil,
+		},
+		{
+			testName: "invalid reply mode",
+			msg: CreatePostMsg{
+				PostID:                  "TestPostID",
+				Title:                   "title",
+				Content:                 "content",
+				Author:                  author,
+				RedistributionSplitRate: "0",
+				ReplyMode:               types.ReplyMode(3),
+			},
+			expectedResult: ErrInvalidReplyMode(),
+		},
+		{
+			testName: "post with co-authors",
+			msg: CreatePostMsg{
+				PostID:                  "TestPostID",
+				Title:                   "title",
+				Content:                 "content",
+				Author:                  author,
+				RedistributionSplitRate: "0",
+				CoAuthors: []CoAuthorWeight{
+					{Username: author, Weight: "0.6"},
+					{Username: "coauthor", Weight: "0.4"},
+				},
+			},
+			expectedResult: nil,
+		},
+		{
+			testName: "co-authors without post author",
+			msg: CreatePostMsg{
+				PostID:                  "TestPostID",
+				Title:                   "title",
+				Content:                 "content",
+				Author:                  author,
+				RedistributionSplitRate: "0",
+				CoAuthors: []CoAuthorWeight{
+					{Username: "coauthor", Weight: "1"},
+				},
+			},
+			expectedResult: ErrInvalidCoAuthors("post author is not in co-authors"),
+		},
+		{
+			testName: "duplicate co-authors",
+			msg: CreatePostMsg{
+				PostID:                  "TestPostID",
+				Title:                   "title",
+				Content:                 "content",
+				Author:                  author,
+				RedistributionSplitRate: "0",
+				CoAuthors: []CoAuthorWeight{
+					{Username: author, Weight: "0.5"},
+					{Username: author, Weight: "0.5"},
+				},
+			},
+			expectedResult: ErrInvalidCoAuthors("invalid co-author TestAuthor"),
+		},
+		{
+			testName: "co-author with zero weight",
+			msg: CreatePostMsg{
+				PostID:                  "TestPostID",
+				Title:                   "title",
+				Content:                 "content",
+				Author:                  author,
+				RedistributionSplitRate: "0",
+				CoAuthors: []CoAuthorWeight{
+					{Username: author, Weight: "1"},
+					{Username: "coauthor", Weight: "0"},
+				},
+			},
+			expectedResult: ErrInvalidCoAuthors("invalid weight of coauthor"),
+		},
+		{
+			testName: "co-author weights don't sum to 1",
+			msg: CreatePostMsg{
+				PostID:                  "TestPostID",
+				Title:                   "title",
+				Content:                 "content",
+				Author:                  author,
+				RedistributionSplitRate: "0",
+				CoAuthors: []CoAuthorWeight{
+					{Username: author, Weight: "0.5"},
+					{Username: "coauthor", Weight: "0.4"},
+				},
+			},
+			expectedResult: ErrInvalidCoAuthors("weights don't sum to 1"),
+		},
 	}
 	for _, tc := range testCases {
 		result := tc.msg.ValidateBasic()
@@ -277,6 +507,8 @@ func TestCreatePostMsg(t *testing.T) {
 }
 
 func TestUpdatePostMsg(t *testing.T) {
+	replyNobody := types.ReplyNobody
+	invalidReplyMode := types.ReplyMode(-1)
 	testCases := []struct {
 		testName       string
 		updatePostMsg  UpdatePostMsg
@@ -285,65 +517,104 @@ func TestUpdatePostMsg(t *testing.T) {
 		{
 			testName: "normal case 1",
 			updatePostMsg: NewUpdatePostMsg(
-				"author", "postID", "title", "content", []types.IDToURLMapping{}),
+				"author", "postID", "title", "content", []types.IDToURLMapping{}, nil),
 			expectedResult: nil,
 		},
 		{
 			testName: "normal case 2",
 			updatePostMsg: NewUpdatePostMsg(
-				"author", "postID", "title", "content", []types.IDToURLMapping{}),
+				"author", "postID", "title", "content", []types.IDToURLMapping{}, nil),
 			expectedResult: nil,
 		},
 		{
 			testName: "utf8 title",
 			updatePostMsg: NewUpdatePostMsg(
-				"author", "postID", maxLenOfUTF8Title, "content", []types.IDToURLMapping{}),
+				"author", "postID", maxLenOfUTF8Title, "content", []types.IDToURLMapping{}, nil),
 			expectedResult: nil,
 		},
 		{
 			testName: "utf8 content",
 			updatePostMsg: NewUpdatePostMsg(
-				"author", "postID", "title", maxLenOfUTF8Content, []types.IDToURLMapping{}),
+				"author", "postID", "title", maxLenOfUTF8Content, []types.IDToURLMapping{}, nil),
 			expectedResult: nil,
 		},
 		{
 			testName: "no author",
 			updatePostMsg: NewUpdatePostMsg(
-				"", "postID", "title", "content", []types.IDToURLMapping{}),
+				"", "postID", "title", "content", []types.IDToURLMapping{}, nil),
 			expectedResult: ErrNoAuthor(),
 		},
 		{
 			testName: "no post id",
 			updatePostMsg: NewUpdatePostMsg(
-				"author", "", "title", "content", []types.IDToURLMapping{}),
+				"author", "", "title", "content", []types.IDToURLMapping{}, nil),
 			expectedResult: ErrNoPostID(),
 		},
 		{
 			testName: "post tile is too long",
 			updatePostMsg: NewUpdatePostMsg(
-				"author", "postID", string(make([]byte, 51)), "content", []types.IDToURLMapping{}),
+				"author", "postID", string(make([]byte, 51)), "content", []types.IDToURLMapping{}, nil),
 			expectedResult: ErrPostTitleExceedMaxLength(),
 		},
 		{
 			testName: "post utf8 tile is too long",
 			updatePostMsg: NewUpdatePostMsg(
-				"author", "postID", tooLongOfUTF8Title, "content", []types.IDToURLMapping{}),
+				"author", "postID", tooLongOfUTF8Title, "content", []types.IDToURLMapping{}, nil),
 			expectedResult: ErrPostTitleExceedMaxLength(),
 		},
 		{
 			testName: "post content is too long",
 			updatePostMsg: NewUpdatePostMsg(
 				"author", "postID", string(make([]byte, 50)), string(make([]byte, 1001)),
-				[]types.IDToURLMapping{}),
+				[]types.IDToURLMapping{}, nil),
 			expectedResult: ErrPostContentExceedMaxLength(),
 		},
 		{
 			testName: "post utf8 content is too long",
 			updatePostMsg: NewUpdatePostMsg(
 				"author", "postID", string(make([]byte, 50)), tooLongOfUTF8Content,
-				[]types.IDToURLMapping{}),
+				[]types.IDToURLMapping{}, nil),
 			expectedResult: ErrPostContentExceedMaxLength(),
 		},
+		{
+			testName: "update tags",
+			updatePostMsg: NewUpdatePostMsg(
+				"author", "postID", "title", "content", []types.IDToURLMapping{}, []string{"Lino"}),
+			expectedResult: nil,
+		},
+		{
+			testName: "tag contains invalid character",
+			updatePostMsg: UpdatePostMsg{
+				Author:  "author",
+				PostID:  "postID",
+				Title:   "title",
+				Content: "content",
+				Tags:    []string{"lino music"},
+			},
+			expectedResult: ErrInvalidTag("lino music"),
+		},
+		{
+			testName: "update reply mode",
+			updatePostMsg: UpdatePostMsg{
+				Author:    "author",
+				PostID:    "postID",
+				Title:     "title",
+				Content:   "content",
+				ReplyMode: &replyNobody,
+			},
+			expectedResult: nil,
+		},
+		{
+			testName: "invalid reply mode",
+			updatePostMsg: UpdatePostMsg{
+				Author:    "author",
+				PostID:    "postID",
+				Title:     "title",
+				Content:   "content",
+				ReplyMode: &invalidReplyMode,
+			},
+			expectedResult: ErrInvalidReplyMode(),
+		},
 	}
 	for _, tc := range testCases {
 		result := tc.updatePostMsg.ValidateBasic()
@@ -353,6 +624,36 @@ func TestUpdatePostMsg(t *testing.T) {
 	}
 }
 
+func TestNormalizeTags(t *testing.T) {
+	testCases := []struct {
+		testName   string
+		tags       []string
+		expectTags []string
+	}{
+		{
+			testName:   "nil tags",
+			tags:       nil,
+			expectTags: nil,
+		},
+		{
+			testName:   "lower case and trim",
+			tags:       []string{" Lino ", "#Music"},
+			expectTags: []string{"lino", "music"},
+		},
+		{
+			testName:   "drop empty and duplicate tags",
+			tags:       []string{"lino", "", "#", "LINO"},
+			expectTags: []string{"lino"},
+		},
+	}
+	for _, tc := range testCases {
+		tags := NormalizeTags(tc.tags)
+		if !assert.Equal(t, tc.expectTags, tags) {
+			t.Errorf("%s: diff tags, got %v, want %v", tc.testName, tags, tc.expectTags)
+		}
+	}
+}
+
 func TestDeletePostMsg(t *testing.T) {
 	testCases := []struct {
 		testName    string
@@ -523,6 +824,93 @@ func TestDonationMsg(t *testing.T) {
 	}
 }
 
+func TestTipMsg(t *testing.T) {
+	testCases := []struct {
+		testName      string
+		tipMsg        TipMsg
+		expectedError sdk.Error
+	}{
+		{
+			testName:      "normal case",
+			tipMsg:        NewTipMsg("test", types.LNO("1"), "target", "live", ""),
+			expectedError: nil,
+		},
+		{
+			testName:      "tip without reference",
+			tipMsg:        NewTipMsg("test", types.LNO("1"), "target", "", ""),
+			expectedError: nil,
+		},
+		{
+			testName:      "no username",
+			tipMsg:        NewTipMsg("", types.LNO("1"), "target", "live", ""),
+			expectedError: ErrNoUsername(),
+		},
+		{
+			testName:      "invalid target - no target",
+			tipMsg:        NewTipMsg("test", types.LNO("1"), "", "live", ""),
+			expectedError: ErrInvalidTarget(),
+		},
+		{
+			testName:      "zero coin is less than lower bound",
+			tipMsg:        NewTipMsg("test", types.LNO("0"), "target", "live", ""),
+			expectedError: types.ErrInvalidCoins("LNO can't be less than lower bound"),
+		},
+		{
+			testName:      "reference is too long",
+			tipMsg:        NewTipMsg("test", types.LNO("1"), "target", invalidMemo, ""),
+			expectedError: ErrInvalidTipReference(),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := tc.tipMsg.ValidateBasic()
+		if !assert.Equal(t, tc.expectedError, result) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectedError)
+		}
+	}
+}
+
+func TestUnlockPostMsg(t *testing.T) {
+	testCases := []struct {
+		testName      string
+		unlockMsg     UnlockPostMsg
+		expectedError sdk.Error
+	}{
+		{
+			testName:      "normal case",
+			unlockMsg:     NewUnlockPostMsg("test", "author", "postID", types.LNO("1"), ""),
+			expectedError: nil,
+		},
+		{
+			testName:      "no username",
+			unlockMsg:     NewUnlockPostMsg("", "author", "postID", types.LNO("1"), ""),
+			expectedError: ErrNoUsername(),
+		},
+		{
+			testName:      "invalid target - no author",
+			unlockMsg:     NewUnlockPostMsg("test", "", "postID", types.LNO("1"), ""),
+			expectedError: ErrInvalidTarget(),
+		},
+		{
+			testName:      "invalid target - no post id",
+			unlockMsg:     NewUnlockPostMsg("test", "author", "", types.LNO("1"), ""),
+			expectedError: ErrInvalidTarget(),
+		},
+		{
+			testName:      "zero coin is less than lower bound",
+			unlockMsg:     NewUnlockPostMsg("test", "author", "postID", types.LNO("0"), ""),
+			expectedError: types.ErrInvalidCoins("LNO can't be less than lower bound"),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := tc.unlockMsg.ValidateBasic()
+		if !assert.Equal(t, tc.expectedError, result) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectedError)
+		}
+	}
+}
+
 func TestReportOrUpvoteMsg(t *testing.T) {
 	testCases := []struct {
 		testName          string
@@ -559,6 +947,46 @@ func TestReportOrUpvoteMsg(t *testing.T) {
 			reportOrUpvoteMsg: NewReportOrUpvoteMsg("test", "", "", false),
 			expectedError:     ErrInvalidTarget(),
 		},
+		{
+			testName: "normal case - report with reason and evidence",
+			reportOrUpvoteMsg: ReportOrUpvoteMsg{
+				Username: "test", Author: "author", PostID: "postID", IsReport: true,
+				Reason: types.ReportReasonCopyright, Evidence: "https://lino.network/original",
+			},
+			expectedError: nil,
+		},
+		{
+			testName: "invalid report reason",
+			reportOrUpvoteMsg: ReportOrUpvoteMsg{
+				Username: "test", Author: "author", PostID: "postID", IsReport: true,
+				Reason: types.ReportReason(100),
+			},
+			expectedError: ErrInvalidReportReason(),
+		},
+		{
+			testName: "upvote with reason",
+			reportOrUpvoteMsg: ReportOrUpvoteMsg{
+				Username: "test", Author: "author", PostID: "postID", IsReport: false,
+				Reason: types.ReportReasonSpam,
+			},
+			expectedError: ErrInvalidReportReason(),
+		},
+		{
+			testName: "upvote with evidence",
+			reportOrUpvoteMsg: ReportOrUpvoteMsg{
+				Username: "test", Author: "author", PostID: "postID", IsReport: false,
+				Evidence: "evidence",
+			},
+			expectedError: ErrInvalidReportEvidence(),
+		},
+		{
+			testName: "report evidence is too long",
+			reportOrUpvoteMsg: ReportOrUpvoteMsg{
+				Username: "test", Author: "author", PostID: "postID", IsReport: true,
+				Reason: types.ReportReasonSpam, Evidence: string(make([]byte, 201)),
+			},
+			expectedError: ErrInvalidReportEvidence(),
+		},
 	}
 
 	for _, tc := range testCases {
@@ -605,6 +1033,146 @@ func TestViewMsg(t *testing.T) {
 	}
 }
 
+func TestApproveCoAuthorMsg(t *testing.T) {
+	testCases := []struct {
+		testName      string
+		approveMsg    ApproveCoAuthorMsg
+		expectedError sdk.Error
+	}{
+		{
+			testName:      "normal case",
+			approveMsg:    NewApproveCoAuthorMsg("test", "author", "postID"),
+			expectedError: nil,
+		},
+		{
+			testName:      "no username",
+			approveMsg:    NewApproveCoAuthorMsg("", "author", "postID"),
+			expectedError: ErrNoUsername(),
+		},
+		{
+			testName:      "invalid target - no author",
+			approveMsg:    NewApproveCoAuthorMsg("test", "", "postID"),
+			expectedError: ErrInvalidTarget(),
+		},
+		{
+			testName:      "invalid target - no post id",
+			approveMsg:    NewApproveCoAuthorMsg("test", "author", ""),
+			expectedError: ErrInvalidTarget(),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := tc.approveMsg.ValidateBasic()
+		if !assert.Equal(t, tc.expectedError, result) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectedError)
+		}
+	}
+}
+
+func TestViewReceiptsMsg(t *testing.T) {
+	receipt := ViewReceipt{Username: "user", Author: "author", PostID: "postID", InfraProvider: "infra"}
+	tooManyReceipts := []ViewReceipt{}
+	for i := 0; i <= types.MaximumNumOfViewReceipts; i++ {
+		tooManyReceipts = append(tooManyReceipts, receipt)
+	}
+
+	testCases := []struct {
+		testName        string
+		viewReceiptsMsg ViewReceiptsMsg
+		expectedError   sdk.Error
+	}{
+		{
+			testName:        "normal case",
+			viewReceiptsMsg: NewViewReceiptsMsg("app", []ViewReceipt{receipt}),
+			expectedError:   nil,
+		},
+		{
+			testName: "receipt without infra provider",
+			viewReceiptsMsg: NewViewReceiptsMsg("app", []ViewReceipt{
+				{Username: "user", Author: "author", PostID: "postID"},
+			}),
+			expectedError: nil,
+		},
+		{
+			testName:        "no app",
+			viewReceiptsMsg: NewViewReceiptsMsg("", []ViewReceipt{receipt}),
+			expectedError:   ErrNoUsername(),
+		},
+		{
+			testName:        "no receipt",
+			viewReceiptsMsg: NewViewReceiptsMsg("app", nil),
+			expectedError:   ErrInvalidViewReceipts("no receipt"),
+		},
+		{
+			testName:        "too many receipts",
+			viewReceiptsMsg: NewViewReceiptsMsg("app", tooManyReceipts),
+			expectedError:   ErrInvalidViewReceipts("too many receipts"),
+		},
+		{
+			testName: "receipt without username",
+			viewReceiptsMsg: NewViewReceiptsMsg("app", []ViewReceipt{
+				{Author: "author", PostID: "postID"},
+			}),
+			expectedError: ErrInvalidViewReceipts("no username"),
+		},
+		{
+			testName: "receipt without post id",
+			viewReceiptsMsg: NewViewReceiptsMsg("app", []ViewReceipt{
+				{Username: "user", Author: "author"},
+			}),
+			expectedError: ErrInvalidViewReceipts("invalid target"),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := tc.viewReceiptsMsg.ValidateBasic()
+		if !assert.Equal(t, tc.expectedError, result) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectedError)
+		}
+	}
+}
+
+func TestHideCommentMsg(t *testing.T) {
+	testCases := []struct {
+		testName       string
+		hideCommentMsg HideCommentMsg
+		expectedError  sdk.Error
+	}{
+		{
+			testName:       "normal case - hide",
+			hideCommentMsg: NewHideCommentMsg("test", "commenter", "comment", true),
+			expectedError:  nil,
+		},
+		{
+			testName:       "normal case - unhide",
+			hideCommentMsg: NewHideCommentMsg("test", "commenter", "comment", false),
+			expectedError:  nil,
+		},
+		{
+			testName:       "no username",
+			hideCommentMsg: NewHideCommentMsg("", "commenter", "comment", true),
+			expectedError:  ErrNoUsername(),
+		},
+		{
+			testName:       "invalid target - no comment author",
+			hideCommentMsg: NewHideCommentMsg("test", "", "comment", true),
+			expectedError:  ErrInvalidTarget(),
+		},
+		{
+			testName:       "invalid target - no comment post id",
+			hideCommentMsg: NewHideCommentMsg("test", "commenter", "", true),
+			expectedError:  ErrInvalidTarget(),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := tc.hideCommentMsg.ValidateBasic()
+		if !assert.Equal(t, tc.expectedError, result) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectedError)
+		}
+	}
+}
+
 func TestMsgPermission(t *testing.T) {
 	testCases := []struct {
 		testName           string
@@ -618,6 +1186,33 @@ func TestMsgPermission(t *testing.T) {
 				"author", "postID", "", memo1),
 			expectedPermission: types.PreAuthorizationPermission,
 		},
+		{
+			testName:           "unlock post",
+			msg:                NewUnlockPostMsg("test", "author", "postID", types.LNO("1"), ""),
+			expectedPermission: types.PreAuthorizationPermission,
+		},
+		{
+			testName:           "tip",
+			msg:                NewTipMsg("test", types.LNO("1"), "target", "live", ""),
+			expectedPermission: types.PreAuthorizationPermission,
+		},
+		{
+			testName:           "approve co-author",
+			msg:                NewApproveCoAuthorMsg("test", "author", "postID"),
+			expectedPermission: types.AppPermission,
+		},
+		{
+			testName:           "hide comment",
+			msg:                NewHideCommentMsg("test", "commenter", "comment", true),
+			expectedPermission: types.AppPermission,
+		},
+		{
+			testName: "view receipts",
+			msg: NewViewReceiptsMsg("app", []ViewReceipt{
+				{Username: "user", Author: "author", PostID: "postID"},
+			}),
+			expectedPermission: types.TransactionPermission,
+		},
 		{
 			testName: "create post",
 			msg: CreatePostMsg{
@@ -660,7 +1255,7 @@ func TestMsgPermission(t *testing.T) {
 		{
 			testName: "update post",
 			msg: NewUpdatePostMsg(
-				"author", "postID", "title", "content", []types.IDToURLMapping{}),
+				"author", "postID", "title", "content", []types.IDToURLMapping{}, nil),
 			expectedPermission: types.AppPermission,
 		},
 	}
@@ -684,6 +1279,22 @@ func TestGetSignBytes(t *testing.T) {
 				"test", types.LNO("1"),
 				"author", "postID", "", memo1),
 		},
+		{
+			testName: "unlock post",
+			msg:      NewUnlockPostMsg("test", "author", "postID", types.LNO("1"), ""),
+		},
+		{
+			testName: "tip",
+			msg:      NewTipMsg("test", types.LNO("1"), "target", "live", ""),
+		},
+		{
+			testName: "approve co-author",
+			msg:      NewApproveCoAuthorMsg("test", "author", "postID"),
+		},
+		{
+			testName: "hide comment",
+			msg:      NewHideCommentMsg("test", "commenter", "comment", true),
+		},
 		{
 			testName: "create post",
 			msg: CreatePostMsg{
@@ -722,7 +1333,7 @@ func TestGetSignBytes(t *testing.T) {
 		{
 			testName: "update post",
 			msg: NewUpdatePostMsg(
-				"author", "postID", "title", "content", []types.IDToURLMapping{}),
+				"author", "postID", "title", "content", []types.IDToURLMapping{}, nil),
 		},
 	}
 
@@ -744,6 +1355,33 @@ func TestGetSigners(t *testing.T) {
 				"author", "postID", "", memo1),
 			expectSigners: []types.AccountKey{"test"},
 		},
+		{
+			testName:      "unlock post",
+			msg:           NewUnlockPostMsg("test", "author", "postID", types.LNO("1"), ""),
+			expectSigners: []types.AccountKey{"test"},
+		},
+		{
+			testName:      "tip",
+			msg:           NewTipMsg("test", types.LNO("1"), "target", "live", ""),
+			expectSigners: []types.AccountKey{"test"},
+		},
+		{
+			testName:      "approve co-author",
+			msg:           NewApproveCoAuthorMsg("test", "author", "postID"),
+			expectSigners: []types.AccountKey{"test"},
+		},
+		{
+			testName:      "hide comment",
+			msg:           NewHideCommentMsg("test", "commenter", "comment", true),
+			expectSigners: []types.AccountKey{"test"},
+		},
+		{
+			testName: "view receipts",
+			msg: NewViewReceiptsMsg("app", []ViewReceipt{
+				{Username: "user", Author: "author", PostID: "postID"},
+			}),
+			expectSigners: []types.AccountKey{"app"},
+		},
 		{
 			testName: "create post",
 			msg: CreatePostMsg{
@@ -786,7 +1424,7 @@ func TestGetSigners(t *testing.T) {
 		{
 			testName: "update post",
 			msg: NewUpdatePostMsg(
-				"author", "postID", "title", "content", []types.IDToURLMapping{}),
+				"author", "postID", "title", "content", []types.IDToURLMapping{}, nil),
 			expectSigners: []types.AccountKey{"author"},
 		},
 	}
@@ -818,6 +1456,16 @@ func TestGetConsumeAmount(t *testing.T) {
 				"author", "postID", "", memo1),
 			expectAmount: types.NewCoinFromInt64(1 * types.Decimals),
 		},
+		{
+			testName:     "unlock post",
+			msg:          NewUnlockPostMsg("test", "author", "postID", types.LNO("2"), ""),
+			expectAmount: types.NewCoinFromInt64(2 * types.Decimals),
+		},
+		{
+			testName:     "tip",
+			msg:          NewTipMsg("test", types.LNO("3"), "target", "live", ""),
+			expectAmount: types.NewCoinFromInt64(3 * types.Decimals),
+		},
 		{
 			testName: "create post",
 			msg: CreatePostMsg{
@@ -860,7 +1508,7 @@ func TestGetConsumeAmount(t *testing.T) {
 		{
 			testName: "update post",
 			msg: NewUpdatePostMsg(
-				"author", "postID", "title", "content", []types.IDToURLMapping{}),
+				"author", "postID", "title", "content", []types.IDToURLMapping{}, nil),
 			expectAmount: types.NewCoinFromInt64(0),
 		},
 	}
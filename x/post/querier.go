@@ -0,0 +1,173 @@
+package post
+
+import (
+	"fmt"
+
+	"github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/x/global"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	acc "github.com/lino-network/lino/x/account"
+)
+
+// query endpoints supported by post querier
+const (
+	QueryPostAccess             = "access"
+	QueryPostsByTag             = "tag"
+	QueryPostComments           = "comments"
+	QueryPenaltyScore           = "penalty-score"
+	QueryRewardEstimate         = "reward-estimate"
+	QueryDonationRewardEstimate = "donation-reward-estimate"
+)
+
+// QueryPostAccessParams - params of post access query
+type QueryPostAccessParams struct {
+	Username types.AccountKey `json:"username"`
+	Author   types.AccountKey `json:"author"`
+	PostID   string           `json:"post_id"`
+}
+
+// QueryPostsByTagParams - params of posts by tag query
+type QueryPostsByTagParams struct {
+	Tag   string `json:"tag"`
+	Limit int    `json:"limit"`
+}
+
+// QueryPostCommentsParams - params of post comments query, comments hidden
+// by the post author are only returned if include hidden is set
+type QueryPostCommentsParams struct {
+	Author        types.AccountKey `json:"author"`
+	PostID        string           `json:"post_id"`
+	IncludeHidden bool             `json:"include_hidden"`
+}
+
+// QueryPenaltyScoreParams - params of penalty score query
+type QueryPenaltyScoreParams struct {
+	Author types.AccountKey `json:"author"`
+	PostID string           `json:"post_id"`
+}
+
+// QueryRewardEstimateParams - params of reward estimate queries, donator and
+// amount are only used to estimate a hypothetical donation
+type QueryRewardEstimateParams struct {
+	Author  types.AccountKey `json:"author"`
+	PostID  string           `json:"post_id"`
+	Donator types.AccountKey `json:"donator"`
+	Amount  types.LNO        `json:"amount"`
+}
+
+// NewQuerier - create querier for custom queries of post module
+func NewQuerier(pm PostManager, am acc.AccountManager, gm global.GlobalManager) types.Querier {
+	return func(ctx sdk.Context, path []string, data []byte) ([]byte, sdk.Error) {
+		switch path[0] {
+		case QueryPostAccess:
+			return queryPostAccess(ctx, data, pm, am)
+		case QueryPostsByTag:
+			return queryPostsByTag(ctx, data, pm)
+		case QueryPostComments:
+			return queryPostComments(ctx, data, pm)
+		case QueryPenaltyScore:
+			return queryPenaltyScore(ctx, data, pm, am)
+		case QueryRewardEstimate:
+			return queryRewardEstimate(ctx, data, pm, am, gm)
+		case QueryDonationRewardEstimate:
+			return queryDonationRewardEstimate(ctx, data, pm, am, gm)
+		default:
+			return nil, sdk.ErrUnknownRequest(fmt.Sprintf("unknown post query endpoint: %v", path[0]))
+		}
+	}
+}
+
+func queryPostAccess(
+	ctx sdk.Context, data []byte, pm PostManager, am acc.AccountManager) ([]byte, sdk.Error) {
+	params := QueryPostAccessParams{}
+	if err := msgCdc.UnmarshalJSON(data, &params); err != nil {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("failed to parse post access params: %s", err))
+	}
+	result, err := pm.GetUserPostAccess(
+		ctx, params.Username, types.GetPermlink(params.Author, params.PostID), am)
+	if err != nil {
+		return nil, err
+	}
+	return marshalQueryResult(result)
+}
+
+func queryPostsByTag(ctx sdk.Context, data []byte, pm PostManager) ([]byte, sdk.Error) {
+	params := QueryPostsByTagParams{}
+	if err := msgCdc.UnmarshalJSON(data, &params); err != nil {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("failed to parse posts by tag params: %s", err))
+	}
+	tags := NormalizeTags([]string{params.Tag})
+	if len(tags) != 1 || params.Limit <= 0 {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("invalid posts by tag params: %v", params))
+	}
+	return marshalQueryResult(pm.GetPostsByTag(ctx, tags[0], params.Limit))
+}
+
+func queryPostComments(ctx sdk.Context, data []byte, pm PostManager) ([]byte, sdk.Error) {
+	params := QueryPostCommentsParams{}
+	if err := msgCdc.UnmarshalJSON(data, &params); err != nil {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("failed to parse post comments params: %s", err))
+	}
+	result, err := pm.GetComments(
+		ctx, types.GetPermlink(params.Author, params.PostID), params.IncludeHidden)
+	if err != nil {
+		return nil, err
+	}
+	return marshalQueryResult(result)
+}
+
+func queryPenaltyScore(
+	ctx sdk.Context, data []byte, pm PostManager, am acc.AccountManager) ([]byte, sdk.Error) {
+	params := QueryPenaltyScoreParams{}
+	if err := msgCdc.UnmarshalJSON(data, &params); err != nil {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("failed to parse penalty score params: %s", err))
+	}
+	result, err := pm.AuditPenaltyScore(ctx, types.GetPermlink(params.Author, params.PostID), am)
+	if err != nil {
+		return nil, err
+	}
+	return marshalQueryResult(result)
+}
+
+func queryRewardEstimate(
+	ctx sdk.Context, data []byte, pm PostManager, am acc.AccountManager,
+	gm global.GlobalManager) ([]byte, sdk.Error) {
+	params := QueryRewardEstimateParams{}
+	if err := msgCdc.UnmarshalJSON(data, &params); err != nil {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("failed to parse reward estimate params: %s", err))
+	}
+	result, err := pm.GetPendingRewardEstimates(
+		ctx, types.GetPermlink(params.Author, params.PostID), am, gm)
+	if err != nil {
+		return nil, err
+	}
+	return marshalQueryResult(result)
+}
+
+func queryDonationRewardEstimate(
+	ctx sdk.Context, data []byte, pm PostManager, am acc.AccountManager,
+	gm global.GlobalManager) ([]byte, sdk.Error) {
+	params := QueryRewardEstimateParams{}
+	if err := msgCdc.UnmarshalJSON(data, &params); err != nil {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("failed to parse reward estimate params: %s", err))
+	}
+	coin, err := types.LinoToCoin(params.Amount)
+	if err != nil {
+		return nil, err
+	}
+	result, err := pm.EstimateDonationReward(
+		ctx, types.GetPermlink(params.Author, params.PostID), params.Donator, coin, am, gm)
+	if err != nil {
+		return nil, err
+	}
+	return marshalQueryResult(result)
+}
+
+func marshalQueryResult(result interface{}) ([]byte, sdk.Error) {
+	res, err := msgCdc.MarshalJSON(result)
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to marshal query result: %s", err))
+	}
+	return res, nil
+}
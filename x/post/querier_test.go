@@ -0,0 +1,173 @@
+package post
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/x/post/model"
+	"github.com/stretchr/testify/assert"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func TestQueryPostsByTag(t *testing.T) {
+	ctx, am, _, pm, gm, _ := setupTest(t, 1)
+	querier := NewQuerier(pm, am, gm)
+	user1 := createTestAccount(t, ctx, am, "user1")
+	user2 := createTestAccount(t, ctx, am, "user2")
+
+	baseTime := time.Now().Unix()
+	ctx = ctx.WithBlockHeader(abci.Header{ChainID: "Lino", Time: time.Unix(baseTime, 0)})
+	err := pm.CreatePost(
+		ctx, user1, "postID", "", "", "", "", "content", "title",
+		sdk.ZeroRat(), nil, []string{"lino"})
+	assert.Nil(t, err)
+	ctx = ctx.WithBlockHeader(abci.Header{ChainID: "Lino", Time: time.Unix(baseTime+100, 0)})
+	err = pm.CreatePost(
+		ctx, user2, "postID", "", "", "", "", "content", "title",
+		sdk.ZeroRat(), nil, []string{"lino"})
+	assert.Nil(t, err)
+
+	testCases := []struct {
+		testName      string
+		params        QueryPostsByTagParams
+		expectErr     bool
+		expectResults []types.Permlink
+	}{
+		{
+			testName:      "tag is normalized",
+			params:        QueryPostsByTagParams{Tag: "#Lino", Limit: 10},
+			expectResults: []types.Permlink{types.GetPermlink(user2, "postID"), types.GetPermlink(user1, "postID")},
+		},
+		{
+			testName:      "limit number of posts",
+			params:        QueryPostsByTagParams{Tag: "lino", Limit: 1},
+			expectResults: []types.Permlink{types.GetPermlink(user2, "postID")},
+		},
+		{
+			testName:  "invalid limit",
+			params:    QueryPostsByTagParams{Tag: "lino", Limit: 0},
+			expectErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		data, marshalErr := msgCdc.MarshalJSON(tc.params)
+		assert.Nil(t, marshalErr)
+		res, err := querier(ctx, []string{QueryPostsByTag}, data)
+		if tc.expectErr {
+			if err == nil {
+				t.Errorf("%s: expect error, got nil", tc.testName)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: failed to query, got err %v", tc.testName, err)
+			continue
+		}
+		permlinks := []types.Permlink{}
+		assert.Nil(t, msgCdc.UnmarshalJSON(res, &permlinks))
+		if !assert.Equal(t, tc.expectResults, permlinks) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, permlinks, tc.expectResults)
+		}
+	}
+}
+
+func TestQueryPenaltyScore(t *testing.T) {
+	ctx, am, _, pm, gm, _ := setupTest(t, 1)
+	querier := NewQuerier(pm, am, gm)
+	user1, postID1 := createTestPost(t, ctx, "user1", "postID1", am, pm, "0")
+	user2, postID2 := createTestRepost(t, ctx, "user2", "repost", am, pm, user1, postID1)
+	user3 := createTestAccount(t, ctx, am, "user3")
+	// reporter without account has no current stake
+	reporter := types.AccountKey("reporter")
+	permlink := types.GetPermlink(user1, postID1)
+
+	err := pm.ReportOrUpvoteToPost(ctx, permlink, reporter, types.NewCoinFromInt64(100), true)
+	assert.Nil(t, err)
+	err = pm.ReportOrUpvoteToPost(ctx, permlink, user3, types.NewCoinFromInt64(1), false)
+	assert.Nil(t, err)
+
+	query := func(author types.AccountKey, postID string) *model.PenaltyScoreAudit {
+		data, marshalErr := msgCdc.MarshalJSON(QueryPenaltyScoreParams{Author: author, PostID: postID})
+		assert.Nil(t, marshalErr)
+		res, err := querier(ctx, []string{QueryPenaltyScore}, data)
+		assert.Nil(t, err)
+		result := new(model.PenaltyScoreAudit)
+		assert.Nil(t, msgCdc.UnmarshalJSON(res, result))
+		return result
+	}
+
+	result := query(user1, postID1)
+	assert.Equal(t, permlink, result.Permlink)
+	assert.Nil(t, result.Cached)
+	assert.True(t, result.Recomputed.IsZero())
+	assert.Equal(t, ctx.BlockHeader().Time.Unix(), result.RecomputeAt)
+
+	// repost is scored by its source post
+	_, err = pm.RefreshPenaltyScore(ctx, permlink, am)
+	assert.Nil(t, err)
+	result = query(user2, postID2)
+	assert.Equal(t, permlink, result.Permlink)
+	if assert.NotNil(t, result.Cached) {
+		assert.True(t, result.Cached.Score.IsZero())
+		assert.Equal(t, ctx.BlockHeader().Time.Unix(), result.Cached.UpdatedAt)
+	}
+	assert.True(t, result.Recomputed.IsZero())
+}
+
+func TestQueryPostComments(t *testing.T) {
+	ctx, am, _, pm, gm, _ := setupTest(t, 1)
+	querier := NewQuerier(pm, am, gm)
+	author, postID := createTestPost(t, ctx, "author", "postID", am, pm, "0")
+	user1 := createTestAccount(t, ctx, am, "user1")
+	user2 := createTestAccount(t, ctx, am, "user2")
+	permlink := types.GetPermlink(author, postID)
+	for _, user := range []types.AccountKey{user1, user2} {
+		err := pm.CreatePost(
+			ctx, user, "comment", "", "", author, postID, "content", "title",
+			sdk.ZeroRat(), nil, nil)
+		assert.Nil(t, err)
+		err = pm.AddComment(ctx, permlink, user, "comment")
+		assert.Nil(t, err)
+	}
+	err := pm.SetCommentHidden(ctx, types.GetPermlink(user2, "comment"), true)
+	assert.Nil(t, err)
+
+	testCases := []struct {
+		testName      string
+		includeHidden bool
+		expectAuthors []types.AccountKey
+	}{
+		{
+			testName:      "hidden comment is omitted",
+			includeHidden: false,
+			expectAuthors: []types.AccountKey{user1},
+		},
+		{
+			testName:      "hidden comment is included if requested",
+			includeHidden: true,
+			expectAuthors: []types.AccountKey{user1, user2},
+		},
+	}
+	for _, tc := range testCases {
+		data, marshalErr := msgCdc.MarshalJSON(QueryPostCommentsParams{
+			Author: author, PostID: postID, IncludeHidden: tc.includeHidden})
+		assert.Nil(t, marshalErr)
+		res, err := querier(ctx, []string{QueryPostComments}, data)
+		if err != nil {
+			t.Errorf("%s: failed to query, got err %v", tc.testName, err)
+			continue
+		}
+		comments := []model.Comment{}
+		assert.Nil(t, msgCdc.UnmarshalJSON(res, &comments))
+		authors := []types.AccountKey{}
+		for _, comment := range comments {
+			authors = append(authors, comment.Author)
+		}
+		if !assert.Equal(t, tc.expectAuthors, authors) {
+			t.Errorf("%s: diff comment authors, got %v, want %v", tc.testName, authors, tc.expectAuthors)
+		}
+	}
+}
@@ -10,6 +10,7 @@ import (
 	acc "github.com/lino-network/lino/x/account"
 	dev "github.com/lino-network/lino/x/developer"
 	"github.com/lino-network/lino/x/global"
+	"github.com/lino-network/lino/x/infra"
 	"github.com/lino-network/lino/x/post/model"
 	"github.com/stretchr/testify/assert"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
@@ -27,6 +28,7 @@ var (
 	testGlobalKVStoreKey    = sdk.NewKVStoreKey("global")
 	testDeveloperKVStoreKey = sdk.NewKVStoreKey("developer")
 	testParamKVStoreKey     = sdk.NewKVStoreKey("param")
+	testInfraKVStoreKey     = sdk.NewKVStoreKey("infra")
 
 	initCoin = types.NewCoinFromInt64(1 * types.Decimals)
 	referrer = types.AccountKey("referrer")
@@ -53,12 +55,18 @@ func setupTest(
 	cdc := globalManager.WireCodec()
 	cdc.RegisterInterface((*types.Event)(nil), nil)
 	cdc.RegisterConcrete(RewardEvent{}, "event/reward", nil)
+	cdc.RegisterConcrete(TipRewardEvent{}, "event/tipReward", nil)
 
 	err := InitGlobalManager(ctx, globalManager)
 	assert.Nil(t, err)
 	return ctx, accManager, ph, postManager, globalManager, devManager
 }
 
+// newTestInfraManager - infra manager sharing the param store with managers from setupTest
+func newTestInfraManager() infra.InfraManager {
+	return infra.NewInfraManager(testInfraKVStoreKey, param.NewParamHolder(testParamKVStoreKey))
+}
+
 func getContext(height int64) sdk.Context {
 	db := dbm.NewMemDB()
 	ms := store.NewCommitMultiStore(db)
@@ -67,6 +75,7 @@ func getContext(height int64) sdk.Context {
 	ms.MountStoreWithDB(testGlobalKVStoreKey, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(testParamKVStoreKey, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(testDeveloperKVStoreKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(testInfraKVStoreKey, sdk.StoreTypeIAVL, db)
 	ms.LoadLatestVersion()
 
 	return sdk.NewContext(
@@ -110,7 +119,7 @@ func createTestPost(
 	err = pm.CreatePost(
 		ctx, types.AccountKey(user), postID, "", "", "", "",
 		string(make([]byte, 1000)), string(make([]byte, 50)),
-		splitRate, []types.IDToURLMapping{})
+		splitRate, []types.IDToURLMapping{}, nil)
 	assert.Nil(t, err)
 	return user, postID
 }
@@ -124,7 +133,7 @@ func createTestRepost(
 	err := pm.CreatePost(
 		ctx, types.AccountKey(user), postID, sourceUser, sourcePostID, "", "",
 		string(make([]byte, 1000)), string(make([]byte, 50)),
-		sdk.ZeroRat(), []types.IDToURLMapping{})
+		sdk.ZeroRat(), []types.IDToURLMapping{}, nil)
 	assert.Nil(t, err)
 	return user, postID
 }
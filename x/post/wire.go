@@ -12,6 +12,11 @@ func RegisterWire(cdc *wire.Codec) {
 	cdc.RegisterConcrete(DonateMsg{}, "lino/donate", nil)
 	cdc.RegisterConcrete(ViewMsg{}, "lino/view", nil)
 	cdc.RegisterConcrete(ReportOrUpvoteMsg{}, "lino/reportOrUpvote", nil)
+	cdc.RegisterConcrete(UnlockPostMsg{}, "lino/unlockPost", nil)
+	cdc.RegisterConcrete(ApproveCoAuthorMsg{}, "lino/approveCoAuthor", nil)
+	cdc.RegisterConcrete(HideCommentMsg{}, "lino/hideComment", nil)
+	cdc.RegisterConcrete(ViewReceiptsMsg{}, "lino/viewReceipts", nil)
+	cdc.RegisterConcrete(TipMsg{}, "lino/tip", nil)
 }
 
 var msgCdc = wire.NewCodec()
@@ -58,8 +58,16 @@ func (dpe DecideProposalEvent) Execute(
 		return err
 	}
 
+	// votes are tallied in the proposal and no longer needed
+	if err := voteManager.DeleteProposalVotes(ctx, dpe.ProposalID); err != nil {
+		return err
+	}
+
 	// majority disagree this proposal
 	if proposalRes == types.ProposalNotPass {
+		if dpe.ProposalType == types.ContentCensorship {
+			return dpe.RejectContentCensorship(ctx, dpe.ProposalID, proposalManager, postManager)
+		}
 		return nil
 	}
 
@@ -95,7 +103,7 @@ func (dpe DecideProposalEvent) ExecuteChangeParam(
 	return nil
 }
 
-// ExecuteContentCensorship - delete target post
+// ExecuteContentCensorship - censor target post and record the proposal
 func (dpe DecideProposalEvent) ExecuteContentCensorship(
 	ctx sdk.Context, curID types.ProposalKey, proposalManager ProposalManager,
 	postManager post.PostManager) sdk.Error {
@@ -104,16 +112,29 @@ func (dpe DecideProposalEvent) ExecuteContentCensorship(
 		return err
 	}
 
-	// TODO add content censorship logic
 	if exist := postManager.DoesPostExist(ctx, permlink); !exist {
 		return ErrCensorshipPostNotFound()
 	}
-	if err := postManager.DeletePost(ctx, permlink); err != nil {
+	if err := postManager.CensorPost(ctx, permlink, curID); err != nil {
 		return err
 	}
 	return nil
 }
 
+// RejectContentCensorship - reject all reports to the target post
+func (dpe DecideProposalEvent) RejectContentCensorship(
+	ctx sdk.Context, curID types.ProposalKey, proposalManager ProposalManager,
+	postManager post.PostManager) sdk.Error {
+	permlink, err := proposalManager.GetPermlink(ctx, curID)
+	if err != nil {
+		return err
+	}
+	if exist := postManager.DoesPostExist(ctx, permlink); !exist {
+		return nil
+	}
+	return postManager.RejectReports(ctx, permlink)
+}
+
 // ExecuteProtocolUpgrade - since execute protocol upgrade engage code change, the process need to be done manually
 func (dpe DecideProposalEvent) ExecuteProtocolUpgrade(
 	ctx sdk.Context, curID types.ProposalKey, proposalManager ProposalManager) sdk.Error {
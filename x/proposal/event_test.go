@@ -191,6 +191,9 @@ func TestDecideProposal(t *testing.T) {
 			assert.Equal(t, cs.expectProposalRes, proposalInfo.Result)
 			assert.Equal(t, cs.expectAgreeVotes, proposalInfo.AgreeVotes)
 			assert.Equal(t, cs.expectDisagreeVotes, proposalInfo.DisagreeVotes)
+			for _, voter := range []types.AccountKey{user1, user2, user3, user4} {
+				assert.False(t, voteManager.DoesVoteExist(ctx, cs.proposalID, voter))
+			}
 
 		} else {
 			voteManager.AddVote(ctx, cs.proposalID, cs.voter, cs.voterRes)
@@ -163,7 +163,8 @@ func handleContentCensorshipMsg(
 }
 
 func handleVoteProposalMsg(ctx sdk.Context, proposalManager ProposalManager, vm vote.VoteManager, msg VoteProposalMsg) sdk.Result {
-	if !vm.DoesVoterExist(ctx, msg.Voter) {
+	// delegators can vote by themselves to override their voters
+	if !vm.DoesVoterExist(ctx, msg.Voter) && !vm.IsDelegator(ctx, msg.Voter) {
 		return ErrVoterNotFound().Result()
 	}
 
@@ -171,9 +172,16 @@ func handleVoteProposalMsg(ctx sdk.Context, proposalManager ProposalManager, vm
 		return ErrNotOngoingProposal().Result()
 	}
 
-	if err := vm.AddVote(ctx, msg.ProposalID, msg.Voter, msg.Result); err != nil {
+	overridden, err := vm.AddVote(ctx, msg.ProposalID, msg.Voter, msg.Result)
+	if err != nil {
 		return err.Result()
 	}
+	for _, o := range overridden {
+		if err := proposalManager.ReduceProposalVotingStatus(
+			ctx, msg.ProposalID, o.Voter, o.Result, o.VotingPower); err != nil {
+			return err.Result()
+		}
+	}
 
 	v, err := vm.GetVote(ctx, msg.ProposalID, msg.Voter)
 	if err != nil {
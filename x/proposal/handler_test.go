@@ -460,3 +460,58 @@ func TestVoteProposalBasic(t *testing.T) {
 		}
 	}
 }
+
+func TestDelegatorVoteProposal(t *testing.T) {
+	ctx, am, proposalManager, postManager, vm, _, gm := setupTest(t, 0)
+	handler := NewHandler(am, proposalManager, postManager, gm, vm)
+	proposalManager.InitGenesis(ctx)
+
+	user1 := createTestAccount(ctx, am, "user1", c4600)
+	user2 := createTestAccount(ctx, am, "user2", c46)
+	_ = vm.AddVoter(ctx, user1, c4600)
+	_ = vm.AddDelegation(ctx, user1, user2, c46)
+
+	proposal := &model.ContentCensorshipProposal{
+		Permlink: types.Permlink("postlink"),
+		Reason:   "reason",
+	}
+	proposalID1, _ := proposalManager.AddProposal(ctx, user1, proposal, 100)
+	proposalID2, _ := proposalManager.AddProposal(ctx, user1, proposal, 100)
+
+	testCases := []struct {
+		testName   string
+		proposalID types.ProposalKey
+		votes      []VoteProposalMsg
+	}{
+		{
+			testName:   "delegator votes after voter",
+			proposalID: proposalID1,
+			votes: []VoteProposalMsg{
+				{Voter: user1, ProposalID: proposalID1, Result: true},
+				{Voter: user2, ProposalID: proposalID1, Result: false},
+			},
+		},
+		{
+			testName:   "delegator votes before voter",
+			proposalID: proposalID2,
+			votes: []VoteProposalMsg{
+				{Voter: user2, ProposalID: proposalID2, Result: false},
+				{Voter: user1, ProposalID: proposalID2, Result: true},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		for _, msg := range tc.votes {
+			res := handler(ctx, msg)
+			assert.Equal(t, sdk.Result{}, res, tc.testName)
+		}
+		p, _ := proposalManager.storage.GetOngoingProposal(ctx, tc.proposalID)
+		proposalInfo := p.GetProposalInfo()
+		if !c4600.IsEqual(proposalInfo.AgreeVotes) {
+			t.Errorf("%s: diff agree votes, got %v, want %v", tc.testName, proposalInfo.AgreeVotes, c4600)
+		}
+		if !c46.IsEqual(proposalInfo.DisagreeVotes) {
+			t.Errorf("%s: diff disagree votes, got %v, want %v", tc.testName, proposalInfo.DisagreeVotes, c46)
+		}
+	}
+}
@@ -166,6 +166,30 @@ func (pm ProposalManager) UpdateProposalVotingStatus(ctx sdk.Context, proposalID
 	return nil
 }
 
+// ReduceProposalVotingStatus - remove voting power overridden by delegators from
+// votes already added to a proposal
+func (pm ProposalManager) ReduceProposalVotingStatus(ctx sdk.Context, proposalID types.ProposalKey,
+	voter types.AccountKey, voteResult bool, votingPower types.Coin) sdk.Error {
+	proposal, err := pm.storage.GetOngoingProposal(ctx, proposalID)
+	if err != nil {
+		return err
+	}
+	proposalInfo := proposal.GetProposalInfo()
+
+	if voteResult == true {
+		proposalInfo.AgreeVotes = proposalInfo.AgreeVotes.Minus(votingPower)
+	} else {
+		proposalInfo.DisagreeVotes = proposalInfo.DisagreeVotes.Minus(votingPower)
+	}
+
+	proposal.SetProposalInfo(proposalInfo)
+	if err := pm.storage.SetOngoingProposal(ctx, proposalID, proposal); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // UpdateProposalPassStatus - update proposal pass status when proposal change from ongoing to expired
 func (pm ProposalManager) UpdateProposalPassStatus(
 	ctx sdk.Context, proposalType types.ProposalType,
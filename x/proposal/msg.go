@@ -543,7 +543,9 @@ func (msg ChangeVoteParamMsg) ValidateBasic() sdk.Error {
 	if msg.Parameter.DelegatorCoinReturnIntervalSec <= 0 ||
 		msg.Parameter.VoterCoinReturnIntervalSec <= 0 ||
 		msg.Parameter.DelegatorCoinReturnTimes <= 0 ||
-		msg.Parameter.VoterCoinReturnTimes <= 0 {
+		msg.Parameter.VoterCoinReturnTimes <= 0 ||
+		msg.Parameter.RedelegationCooldownSec <= 0 ||
+		msg.Parameter.MaxRedelegations <= 0 {
 		return ErrIllegalParameter()
 	}
 
@@ -782,8 +784,23 @@ func (msg ChangeValidatorParamMsg) ValidateBasic() sdk.Error {
 
 	if msg.Parameter.ValidatorCoinReturnIntervalSec <= 0 ||
 		msg.Parameter.ValidatorCoinReturnTimes <= 0 ||
-		msg.Parameter.AbsentCommitLimitation <= 0 ||
-		msg.Parameter.ValidatorListSize <= 0 {
+		msg.Parameter.SignedBlocksWindow <= 0 ||
+		msg.Parameter.ValidatorListSize <= 0 ||
+		msg.Parameter.JailDurationSec <= 0 ||
+		msg.Parameter.MaxEvidenceAgeSec <= 0 {
+		return ErrIllegalParameter()
+	}
+
+	if msg.Parameter.MinSignedPerWindow.LT(sdk.ZeroRat()) ||
+		msg.Parameter.MinSignedPerWindow.GT(sdk.OneRat()) ||
+		msg.Parameter.SlashFractionByzantine.LT(sdk.ZeroRat()) ||
+		msg.Parameter.SlashFractionByzantine.GT(sdk.OneRat()) ||
+		msg.Parameter.MaxCommissionRate.LT(sdk.ZeroRat()) ||
+		msg.Parameter.MaxCommissionRate.GT(sdk.OneRat()) ||
+		msg.Parameter.MaxCommissionChangeRate.LT(sdk.ZeroRat()) ||
+		msg.Parameter.MaxCommissionChangeRate.GT(sdk.OneRat()) ||
+		!msg.Parameter.MaxPowerChangeRate.GT(sdk.ZeroRat()) ||
+		msg.Parameter.MaxPowerChangeRate.GT(sdk.OneRat()) {
 		return ErrIllegalParameter()
 	}
 
@@ -792,7 +809,7 @@ func (msg ChangeValidatorParamMsg) ValidateBasic() sdk.Error {
 		!msg.Parameter.ValidatorMinCommittingDeposit.IsPositive() ||
 		!msg.Parameter.PenaltyMissVote.IsPositive() ||
 		!msg.Parameter.PenaltyMissCommit.IsPositive() ||
-		!msg.Parameter.PenaltyByzantine.IsPositive() {
+		!msg.Parameter.PenaltyByzantine.IsNotNegative() {
 		return ErrIllegalParameter()
 	}
 
@@ -933,7 +950,14 @@ func (msg ChangePostParamMsg) ValidateBasic() sdk.Error {
 	if utf8.RuneCountInString(msg.Reason) > types.MaximumLengthOfProposalReason {
 		return ErrReasonTooLong()
 	}
-	if msg.Parameter.PostIntervalSec < 0 || msg.Parameter.ReportOrUpvoteIntervalSec < 0 {
+	if msg.Parameter.PostIntervalSec < 0 || msg.Parameter.ReportOrUpvoteIntervalSec < 0 ||
+		msg.Parameter.MaxNumOfTags < 0 || msg.Parameter.ReportHalfLifeSec < 0 ||
+		msg.Parameter.ViewDedupIntervalSec < 0 || msg.Parameter.PenaltyScoreRefreshSec < 0 ||
+		!msg.Parameter.ConsumptionPerView.IsNotNegative() ||
+		msg.Parameter.CurationRewardRatio.LT(sdk.ZeroRat()) ||
+		msg.Parameter.CurationRewardRatio.GT(sdk.OneRat()) ||
+		msg.Parameter.RepostRoyaltyRate.LT(sdk.ZeroRat()) ||
+		msg.Parameter.RepostRoyaltyRate.GT(sdk.OneRat()) || msg.Parameter.MaxRepostChainDepth < 1 {
 		return ErrIllegalParameter()
 	}
 	return nil
@@ -194,6 +194,8 @@ func TestChangeVoteParamMsg(t *testing.T) {
 		VoterCoinReturnTimes:           int64(7),
 		DelegatorCoinReturnIntervalSec: int64(7 * 24 * 3600),
 		DelegatorCoinReturnTimes:       int64(7),
+		RedelegationCooldownSec:        int64(7 * 24 * 3600),
+		MaxRedelegations:               int64(7),
 	}
 
 	p2 := p1
@@ -217,6 +219,12 @@ func TestChangeVoteParamMsg(t *testing.T) {
 	p8 := p1
 	p8.DelegatorCoinReturnTimes = int64(0)
 
+	p9 := p1
+	p9.RedelegationCooldownSec = int64(0)
+
+	p10 := p1
+	p10.MaxRedelegations = int64(-1)
+
 	testCases := []struct {
 		testName           string
 		ChangeVoteParamMsg ChangeVoteParamMsg
@@ -262,6 +270,16 @@ func TestChangeVoteParamMsg(t *testing.T) {
 			ChangeVoteParamMsg: NewChangeVoteParamMsg("user1", p8, ""),
 			expectedError:      ErrIllegalParameter(),
 		},
+		{
+			testName:           "zero RedelegationCooldownSec is illegal",
+			ChangeVoteParamMsg: NewChangeVoteParamMsg("user1", p9, ""),
+			expectedError:      ErrIllegalParameter(),
+		},
+		{
+			testName:           "negative MaxRedelegations is illegal",
+			ChangeVoteParamMsg: NewChangeVoteParamMsg("user1", p10, ""),
+			expectedError:      ErrIllegalParameter(),
+		},
 		{
 			testName:           "empty username is illegal",
 			ChangeVoteParamMsg: NewChangeVoteParamMsg("", p1, ""),
@@ -366,9 +384,16 @@ func TestChangeValidatorParamMsg(t *testing.T) {
 		ValidatorCoinReturnTimes:       int64(7),
 		PenaltyMissVote:                types.NewCoinFromInt64(200 * types.Decimals),
 		PenaltyMissCommit:              types.NewCoinFromInt64(200 * types.Decimals),
-		PenaltyByzantine:               types.NewCoinFromInt64(1000 * types.Decimals),
+		PenaltyByzantine:               types.NewCoinFromInt64(0),
+		SlashFractionByzantine:         sdk.NewRat(1, 10),
 		ValidatorListSize:              int64(21),
-		AbsentCommitLimitation:         int64(100),
+		SignedBlocksWindow:             int64(100),
+		MinSignedPerWindow:             sdk.NewRat(1, 2),
+		JailDurationSec:                int64(24 * 3600),
+		MaxEvidenceAgeSec:              int64(7 * 7 * 24 * 3600),
+		MaxCommissionRate:              sdk.NewRat(1, 5),
+		MaxCommissionChangeRate:        sdk.NewRat(1, 100),
+		MaxPowerChangeRate:             sdk.NewRat(1, 10),
 	}
 
 	p2 := p1
@@ -390,17 +415,41 @@ func TestChangeValidatorParamMsg(t *testing.T) {
 	p7.PenaltyMissVote = types.NewCoinFromInt64(-200 * types.Decimals)
 
 	p8 := p1
-	p8.PenaltyByzantine = types.NewCoinFromInt64(-10233232300 * types.Decimals)
+	p8.SlashFractionByzantine = sdk.NewRat(-1, 10)
 
 	p9 := p1
 	p9.PenaltyMissCommit = types.NewCoinFromInt64(0 * types.Decimals)
 
 	p10 := p1
-	p10.AbsentCommitLimitation = int64(0)
+	p10.SignedBlocksWindow = int64(0)
 
 	p11 := p1
 	p11.ValidatorListSize = int64(-1)
 
+	p12 := p1
+	p12.JailDurationSec = int64(0)
+
+	p13 := p1
+	p13.MinSignedPerWindow = sdk.NewRat(3, 2)
+
+	p14 := p1
+	p14.SlashFractionByzantine = sdk.NewRat(11, 10)
+
+	p15 := p1
+	p15.MaxEvidenceAgeSec = int64(0)
+
+	p16 := p1
+	p16.MaxCommissionRate = sdk.NewRat(3, 2)
+
+	p17 := p1
+	p17.MaxCommissionChangeRate = sdk.NewRat(-1, 100)
+
+	p18 := p1
+	p18.MaxPowerChangeRate = sdk.ZeroRat()
+
+	p19 := p1
+	p19.PenaltyByzantine = types.NewCoinFromInt64(-1)
+
 	testCases := []struct {
 		testName                string
 		ChangeValidatorParamMsg ChangeValidatorParamMsg
@@ -442,7 +491,7 @@ func TestChangeValidatorParamMsg(t *testing.T) {
 			expectedError:           ErrIllegalParameter(),
 		},
 		{
-			testName:                "negative PenaltyByzantine is illegal",
+			testName:                "negative SlashFractionByzantine is illegal",
 			ChangeValidatorParamMsg: NewChangeValidatorParamMsg("user1", p8, ""),
 			expectedError:           ErrIllegalParameter(),
 		},
@@ -452,7 +501,7 @@ func TestChangeValidatorParamMsg(t *testing.T) {
 			expectedError:           ErrIllegalParameter(),
 		},
 		{
-			testName:                "zero AbsentCommitLimitation is illegal",
+			testName:                "zero SignedBlocksWindow is illegal",
 			ChangeValidatorParamMsg: NewChangeValidatorParamMsg("user1", p10, ""),
 			expectedError:           ErrIllegalParameter(),
 		},
@@ -461,6 +510,46 @@ func TestChangeValidatorParamMsg(t *testing.T) {
 			ChangeValidatorParamMsg: NewChangeValidatorParamMsg("user1", p11, ""),
 			expectedError:           ErrIllegalParameter(),
 		},
+		{
+			testName:                "zero JailDurationSec is illegal",
+			ChangeValidatorParamMsg: NewChangeValidatorParamMsg("user1", p12, ""),
+			expectedError:           ErrIllegalParameter(),
+		},
+		{
+			testName:                "MinSignedPerWindow larger than 1 is illegal",
+			ChangeValidatorParamMsg: NewChangeValidatorParamMsg("user1", p13, ""),
+			expectedError:           ErrIllegalParameter(),
+		},
+		{
+			testName:                "SlashFractionByzantine larger than 1 is illegal",
+			ChangeValidatorParamMsg: NewChangeValidatorParamMsg("user1", p14, ""),
+			expectedError:           ErrIllegalParameter(),
+		},
+		{
+			testName:                "zero MaxEvidenceAgeSec is illegal",
+			ChangeValidatorParamMsg: NewChangeValidatorParamMsg("user1", p15, ""),
+			expectedError:           ErrIllegalParameter(),
+		},
+		{
+			testName:                "MaxCommissionRate larger than 1 is illegal",
+			ChangeValidatorParamMsg: NewChangeValidatorParamMsg("user1", p16, ""),
+			expectedError:           ErrIllegalParameter(),
+		},
+		{
+			testName:                "negative MaxCommissionChangeRate is illegal",
+			ChangeValidatorParamMsg: NewChangeValidatorParamMsg("user1", p17, ""),
+			expectedError:           ErrIllegalParameter(),
+		},
+		{
+			testName:                "zero MaxPowerChangeRate is illegal",
+			ChangeValidatorParamMsg: NewChangeValidatorParamMsg("user1", p18, ""),
+			expectedError:           ErrIllegalParameter(),
+		},
+		{
+			testName:                "negative PenaltyByzantine is illegal",
+			ChangeValidatorParamMsg: NewChangeValidatorParamMsg("user1", p19, ""),
+			expectedError:           ErrIllegalParameter(),
+		},
 		{
 			testName:                "empty username is illegal",
 			ChangeValidatorParamMsg: NewChangeValidatorParamMsg("", p1, ""),
@@ -780,6 +869,14 @@ func TestChangePostParamMsg(t *testing.T) {
 	p1 := param.PostParam{
 		ReportOrUpvoteIntervalSec: 1,
 		PostIntervalSec:           1,
+		MaxNumOfTags:              1,
+		CurationRewardRatio:       sdk.NewRat(1, 10),
+		ReportHalfLifeSec:         30 * 24 * 3600,
+		ViewDedupIntervalSec:      3600,
+		ConsumptionPerView:        types.NewCoinFromInt64(types.Decimals / 1000),
+		RepostRoyaltyRate:         sdk.NewRat(1, 5),
+		MaxRepostChainDepth:       5,
+		PenaltyScoreRefreshSec:    3600,
 	}
 
 	p2 := p1
@@ -788,6 +885,33 @@ func TestChangePostParamMsg(t *testing.T) {
 	p3 := p1
 	p3.PostIntervalSec = int64(-1)
 
+	p4 := p1
+	p4.MaxNumOfTags = int64(-1)
+
+	p5 := p1
+	p5.CurationRewardRatio = sdk.NewRat(-1, 10)
+
+	p6 := p1
+	p6.CurationRewardRatio = sdk.NewRat(11, 10)
+
+	p7 := p1
+	p7.ReportHalfLifeSec = int64(-1)
+
+	p8 := p1
+	p8.ViewDedupIntervalSec = int64(-1)
+
+	p9 := p1
+	p9.ConsumptionPerView = types.NewCoinFromInt64(-1)
+
+	p10 := p1
+	p10.RepostRoyaltyRate = sdk.NewRat(11, 10)
+
+	p11 := p1
+	p11.MaxRepostChainDepth = int64(0)
+
+	p12 := p1
+	p12.PenaltyScoreRefreshSec = int64(-1)
+
 	testCases := []struct {
 		testName           string
 		changePostParamMsg ChangePostParamMsg
@@ -808,6 +932,51 @@ func TestChangePostParamMsg(t *testing.T) {
 			changePostParamMsg: NewChangePostParamMsg("user1", p3, ""),
 			expectedError:      ErrIllegalParameter(),
 		},
+		{
+			testName:           "illegal max number of tags",
+			changePostParamMsg: NewChangePostParamMsg("user1", p4, ""),
+			expectedError:      ErrIllegalParameter(),
+		},
+		{
+			testName:           "negative curation reward ratio",
+			changePostParamMsg: NewChangePostParamMsg("user1", p5, ""),
+			expectedError:      ErrIllegalParameter(),
+		},
+		{
+			testName:           "curation reward ratio larger than 1",
+			changePostParamMsg: NewChangePostParamMsg("user1", p6, ""),
+			expectedError:      ErrIllegalParameter(),
+		},
+		{
+			testName:           "negative report half life",
+			changePostParamMsg: NewChangePostParamMsg("user1", p7, ""),
+			expectedError:      ErrIllegalParameter(),
+		},
+		{
+			testName:           "negative view dedup interval",
+			changePostParamMsg: NewChangePostParamMsg("user1", p8, ""),
+			expectedError:      ErrIllegalParameter(),
+		},
+		{
+			testName:           "negative consumption per view",
+			changePostParamMsg: NewChangePostParamMsg("user1", p9, ""),
+			expectedError:      ErrIllegalParameter(),
+		},
+		{
+			testName:           "repost royalty rate larger than 1",
+			changePostParamMsg: NewChangePostParamMsg("user1", p10, ""),
+			expectedError:      ErrIllegalParameter(),
+		},
+		{
+			testName:           "zero max repost chain depth",
+			changePostParamMsg: NewChangePostParamMsg("user1", p11, ""),
+			expectedError:      ErrIllegalParameter(),
+		},
+		{
+			testName:           "negative penalty score refresh interval",
+			changePostParamMsg: NewChangePostParamMsg("user1", p12, ""),
+			expectedError:      ErrIllegalParameter(),
+		},
 		{
 			testName:           "username too short",
 			changePostParamMsg: NewChangePostParamMsg("us", p1, ""),
@@ -6,11 +6,16 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/wire"
 	"github.com/lino-network/lino/client"
+	"github.com/lino-network/lino/param"
 	"github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/x/validator"
 	"github.com/lino-network/lino/x/validator/model"
+
+	vote "github.com/lino-network/lino/x/vote/model"
 )
 
 // GetValidatorsCmd returns all validators relative information
@@ -39,6 +44,88 @@ func GetValidatorCmd(storeName string, cdc *wire.Codec) *cobra.Command {
 	}
 }
 
+// GetValidatorListCmd returns validators ranked by committing deposit with
+// the deposit needed to enter oncall validators
+func GetValidatorListCmd(storeName string, cdc *wire.Codec) *cobra.Command {
+	cmdr := commander{
+		storeName,
+		cdc,
+	}
+	return &cobra.Command{
+		Use:   "list",
+		Short: "Query ranked validators and oncall validators entry deposit",
+		RunE:  cmdr.getValidatorListCmd,
+	}
+}
+
+// GetSigningInfoCmd returns recent signing bitmap and uptime of a validator,
+// or of all validators if username is not provided
+func GetSigningInfoCmd(storeName string, cdc *wire.Codec) *cobra.Command {
+	cmdr := commander{
+		storeName,
+		cdc,
+	}
+	return &cobra.Command{
+		Use:   "validator-signing [username]",
+		Short: "Query recent signing bitmap and uptime of validators",
+		RunE:  cmdr.getSigningInfoCmd,
+	}
+}
+
+// GetEvidenceCmd returns double sign evidence handled and tombstone of a validator key
+func GetEvidenceCmd(storeName string, cdc *wire.Codec) *cobra.Command {
+	cmdr := commander{
+		storeName,
+		cdc,
+	}
+	return &cobra.Command{
+		Use:   "validator-evidence <username>",
+		Short: "Query double sign evidence and tombstone of validator",
+		RunE:  cmdr.getEvidenceCmd,
+	}
+}
+
+// GetLedgerCmd returns slashes and rewards of a validator, latest first
+func GetLedgerCmd(storeName string, cdc *wire.Codec) *cobra.Command {
+	cmdr := commander{
+		storeName,
+		cdc,
+	}
+	cmd := &cobra.Command{
+		Use:   "validator-ledger <username>",
+		Short: "Query slashes and rewards of validator",
+		RunE:  cmdr.getLedgerCmd,
+	}
+	cmd.Flags().Int(client.FlagOffset, 0, "number of latest entries to skip")
+	cmd.Flags().Int(client.FlagLimit, 20, "maximum number of entries to display")
+	return cmd
+}
+
+// LedgerPage - a page of validator ledger entries, latest first
+type LedgerPage struct {
+	Username     types.AccountKey    `json:"username"`
+	NumOfEntries int64               `json:"num_of_entries"`
+	Entries      []model.LedgerEntry `json:"entries"`
+}
+
+// EvidenceInfo - double sign evidence of validator key, tombstone is nil if
+// the key is not tombstoned
+type EvidenceInfo struct {
+	Username  types.AccountKey `json:"username"`
+	Evidences []model.Evidence `json:"evidences"`
+	Tombstone *model.Tombstone `json:"tombstone"`
+}
+
+// SigningInfo - signing of blocks in validator signing window, bitmap is from
+// the oldest block to the latest one, "1" if signed and "0" if missed
+type SigningInfo struct {
+	Username         types.AccountKey `json:"username"`
+	IsJailed         bool             `json:"is_jailed"`
+	SigningWindow    int64            `json:"signing_window"`
+	Bitmap           string           `json:"bitmap"`
+	UptimePercentage float64          `json:"uptime_percentage"`
+}
+
 type commander struct {
 	storeName string
 	cdc       *wire.Codec
@@ -66,6 +153,233 @@ func (c commander) getValidatorsCmd(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+func (c commander) getValidatorListCmd(cmd *cobra.Command, args []string) error {
+	ctx := client.NewCoreContextFromViper()
+	res, err := ctx.Query(model.GetValidatorListKey(), c.storeName)
+	if err != nil {
+		return err
+	}
+	validatorList := new(model.ValidatorList)
+	if err := c.cdc.UnmarshalJSON(res, validatorList); err != nil {
+		return err
+	}
+	res, err = ctx.Query(param.GetValidatorParamKey(), types.ParamKVStoreKey)
+	if err != nil {
+		return err
+	}
+	validatorParam := new(param.ValidatorParam)
+	if err := c.cdc.UnmarshalJSON(res, validatorParam); err != nil {
+		return err
+	}
+
+	validators := map[types.AccountKey]*model.Validator{}
+	votingPowers := map[types.AccountKey]types.Coin{}
+	for _, username := range validatorList.AllValidators {
+		res, err := ctx.Query(model.GetValidatorKey(username), c.storeName)
+		if err != nil {
+			return err
+		}
+		if len(res) == 0 {
+			return errors.Errorf("validator %s doesn't exist", username)
+		}
+		val := new(model.Validator)
+		if err := c.cdc.UnmarshalJSON(res, val); err != nil {
+			return err
+		}
+		validators[username] = val
+
+		res, err = ctx.Query(vote.GetVoterKey(username), types.VoteKVStoreKey)
+		if err != nil {
+			return err
+		}
+		if len(res) == 0 {
+			continue
+		}
+		voter := new(vote.Voter)
+		if err := c.cdc.UnmarshalJSON(res, voter); err != nil {
+			return err
+		}
+		votingPowers[username] = voter.Deposit.Plus(voter.DelegatedPower)
+	}
+
+	ranking := model.RankCandidates(*validatorList, validators, votingPowers, validatorParam)
+	if err := client.PrintIndent(ranking); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c commander) getSigningInfoCmd(cmd *cobra.Command, args []string) error {
+	ctx := client.NewCoreContextFromViper()
+	usernames := []types.AccountKey{}
+	if len(args) > 0 && len(args[0]) != 0 {
+		usernames = append(usernames, types.AccountKey(args[0]))
+	} else {
+		res, err := ctx.Query(model.GetValidatorListKey(), c.storeName)
+		if err != nil {
+			return err
+		}
+		validatorList := new(model.ValidatorList)
+		if err := c.cdc.UnmarshalJSON(res, validatorList); err != nil {
+			return err
+		}
+		usernames = validatorList.AllValidators
+	}
+
+	signingInfos := []SigningInfo{}
+	for _, username := range usernames {
+		res, err := ctx.Query(model.GetValidatorKey(username), c.storeName)
+		if err != nil {
+			return err
+		}
+		if len(res) == 0 {
+			return errors.Errorf("validator %s doesn't exist", username)
+		}
+		val := new(model.Validator)
+		if err := c.cdc.UnmarshalJSON(res, val); err != nil {
+			return err
+		}
+
+		signingInfo := SigningInfo{
+			Username:      username,
+			IsJailed:      val.IsJailed,
+			SigningWindow: val.SigningWindow,
+		}
+		signed := 0
+		bitmap := validator.GetSigningBitmap(*val)
+		for _, isSigned := range bitmap {
+			if isSigned {
+				signingInfo.Bitmap += "1"
+				signed++
+			} else {
+				signingInfo.Bitmap += "0"
+			}
+		}
+		if len(bitmap) > 0 {
+			signingInfo.UptimePercentage = float64(signed) * 100 / float64(len(bitmap))
+		}
+		signingInfos = append(signingInfos, signingInfo)
+	}
+
+	if err := client.PrintIndent(signingInfos); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c commander) getLedgerCmd(cmd *cobra.Command, args []string) error {
+	ctx := client.NewCoreContextFromViper()
+	if len(args) != 1 || len(args[0]) == 0 {
+		return errors.New("You must provide a username")
+	}
+	username := types.AccountKey(args[0])
+	offset := int64(viper.GetInt(client.FlagOffset))
+	limit := int64(viper.GetInt(client.FlagLimit))
+	if offset < 0 || limit < 0 {
+		return errors.New("offset and limit can't be negative")
+	}
+
+	meta := new(model.LedgerMeta)
+	res, err := ctx.Query(model.GetLedgerMetaKey(username), c.storeName)
+	if err != nil {
+		return err
+	}
+	if len(res) != 0 {
+		if err := c.cdc.UnmarshalJSON(res, meta); err != nil {
+			return err
+		}
+	}
+
+	page := LedgerPage{
+		Username:     username,
+		NumOfEntries: meta.NumOfEntries,
+		Entries:      []model.LedgerEntry{},
+	}
+	// entries with index in [start, end) are displayed, from end to start
+	end := meta.NumOfEntries - offset
+	start := end - limit
+	if start < 0 {
+		start = 0
+	}
+	ledgers := map[int64]*model.Ledger{}
+	for idx := end - 1; idx >= start; idx-- {
+		bundleSlot := idx / types.ValidatorLedgerBundleSize
+		if _, exist := ledgers[bundleSlot]; !exist {
+			res, err := ctx.Query(model.GetLedgerKey(username, bundleSlot), c.storeName)
+			if err != nil {
+				return err
+			}
+			ledger := new(model.Ledger)
+			if err := c.cdc.UnmarshalJSON(res, ledger); err != nil {
+				return err
+			}
+			ledgers[bundleSlot] = ledger
+		}
+		entries := ledgers[bundleSlot].Entries
+		if pos := idx % types.ValidatorLedgerBundleSize; pos < int64(len(entries)) {
+			page.Entries = append(page.Entries, entries[pos])
+		}
+	}
+
+	if err := client.PrintIndent(page); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c commander) getEvidenceCmd(cmd *cobra.Command, args []string) error {
+	ctx := client.NewCoreContextFromViper()
+	if len(args) != 1 || len(args[0]) == 0 {
+		return errors.New("You must provide a username")
+	}
+	username := types.AccountKey(args[0])
+
+	res, err := ctx.Query(model.GetValidatorKey(username), c.storeName)
+	if err != nil {
+		return err
+	}
+	if len(res) == 0 {
+		return errors.Errorf("validator %s doesn't exist", username)
+	}
+	val := new(model.Validator)
+	if err := c.cdc.UnmarshalJSON(res, val); err != nil {
+		return err
+	}
+	address := val.ABCIValidator.Address
+
+	evidenceInfo := EvidenceInfo{
+		Username:  username,
+		Evidences: []model.Evidence{},
+	}
+	resKVs, err := ctx.QuerySubspace(c.cdc, model.GetEvidencePrefix(address), c.storeName)
+	if err != nil {
+		return err
+	}
+	for _, KV := range resKVs {
+		var evidence model.Evidence
+		if err := c.cdc.UnmarshalJSON(KV.Value, &evidence); err != nil {
+			return err
+		}
+		evidenceInfo.Evidences = append(evidenceInfo.Evidences, evidence)
+	}
+
+	res, err = ctx.Query(model.GetTombstoneKey(address), c.storeName)
+	if err != nil {
+		return err
+	}
+	if len(res) != 0 {
+		evidenceInfo.Tombstone = new(model.Tombstone)
+		if err := c.cdc.UnmarshalJSON(res, evidenceInfo.Tombstone); err != nil {
+			return err
+		}
+	}
+
+	if err := client.PrintIndent(evidenceInfo); err != nil {
+		return err
+	}
+	return nil
+}
+
 func (c commander) getValidatorCmd(cmd *cobra.Command, args []string) error {
 	ctx := client.NewCoreContextFromViper()
 	if len(args) != 1 || len(args[0]) == 0 {
@@ -0,0 +1,55 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/lino-network/lino/client"
+	"github.com/lino-network/lino/x/validator"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/wire"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	pvm "github.com/tendermint/tendermint/privval"
+)
+
+// RotateKeyTxCmd will create a validator key rotation tx and sign it with the given key
+func RotateKeyTxCmd(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-rotate-key",
+		Short: "change consensus key of validator to key in priv validator file",
+		RunE:  sendRotateKeyTx(cdc),
+	}
+	cmd.Flags().String(client.FlagUser, "", "user of this transaction")
+	cmd.Flags().String(client.FlagPrivValidator, "", "priv validator file of the new key")
+	return cmd
+}
+
+// send validator key rotation transaction to the blockchain
+func sendRotateKeyTx(cdc *wire.Codec) client.CommandTxCallback {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := client.NewCoreContextFromViper()
+		name := viper.GetString(client.FlagUser)
+
+		privValFile := viper.GetString(client.FlagPrivValidator)
+		if !cmn.FileExists(privValFile) {
+			return fmt.Errorf("priv validator file %s doesn't exist", privValFile)
+		}
+		pubKey := pvm.LoadFilePV(privValFile).GetPubKey()
+
+		// create the message
+		msg := validator.NewValidatorRotateKeyMsg(name, pubKey)
+
+		// build and sign the transaction, then broadcast to Tendermint
+		res, err := ctx.SignBuildBroadcast([]sdk.Msg{msg}, cdc)
+
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Committed at block %d. Hash: %s\n", res.Height, res.Hash.String())
+		return nil
+	}
+}
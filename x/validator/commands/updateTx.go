@@ -0,0 +1,54 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/lino-network/lino/client"
+	"github.com/lino-network/lino/x/validator"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/wire"
+)
+
+// UpdateValidatorTxCmd will create a validator update tx and sign it with the given key
+func UpdateValidatorTxCmd(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-update",
+		Short: "update validator information and commission rate",
+		RunE:  sendUpdateValidatorTx(cdc),
+	}
+	cmd.Flags().String(client.FlagUser, "", "user of this transaction")
+	cmd.Flags().String(client.FlagMoniker, "", "moniker of the validator")
+	cmd.Flags().String(client.FlagWebsite, "", "website of the validator")
+	cmd.Flags().String(client.FlagDescription, "", "description of the validator")
+	cmd.Flags().String(client.FlagContact, "", "contact details of the validator")
+	cmd.Flags().String(client.FlagCommissionRate, "", "commission rate, unchanged if empty")
+	return cmd
+}
+
+// send validator update transaction to the blockchain
+func sendUpdateValidatorTx(cdc *wire.Codec) client.CommandTxCallback {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := client.NewCoreContextFromViper()
+		name := viper.GetString(client.FlagUser)
+
+		// create the message
+		msg := validator.NewValidatorUpdateMsg(
+			name, viper.GetString(client.FlagMoniker), viper.GetString(client.FlagWebsite),
+			viper.GetString(client.FlagDescription), viper.GetString(client.FlagContact),
+			viper.GetString(client.FlagCommissionRate))
+
+		// build and sign the transaction, then broadcast to Tendermint
+		res, err := ctx.SignBuildBroadcast([]sdk.Msg{msg}, cdc)
+
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Committed at block %d. Hash: %s\n", res.Height, res.Hash.String())
+		return nil
+	}
+}
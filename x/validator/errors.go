@@ -42,6 +42,61 @@ func ErrInvalidWebsite() sdk.Error {
 	return types.NewError(types.CodeInvalidWebsite, fmt.Sprintf("Invalida website"))
 }
 
+// ErrValidatorNotJailed - error if unjail a validator which is not jailed
+func ErrValidatorNotJailed() sdk.Error {
+	return types.NewError(types.CodeValidatorNotJailed, fmt.Sprintf("validator is not jailed"))
+}
+
+// ErrValidatorStillJailed - error if unjail a validator before jail duration ends
+func ErrValidatorStillJailed(jailedUntil int64) sdk.Error {
+	return types.NewError(types.CodeValidatorStillJailed, fmt.Sprintf("validator is jailed until %v", jailedUntil))
+}
+
+// ErrValidatorTombstoned - error if validator public key is tombstoned for double signing
+func ErrValidatorTombstoned() sdk.Error {
+	return types.NewError(types.CodeValidatorTombstoned, fmt.Sprintf("validator public key is tombstoned"))
+}
+
+// ErrInvalidCommissionRate - error if commission rate is not between 0 and 1
+func ErrInvalidCommissionRate() sdk.Error {
+	return types.NewError(types.CodeInvalidCommissionRate, fmt.Sprintf("invalid commission rate"))
+}
+
+// ErrCommissionRateTooHigh - error if commission rate is higher than max commission rate
+func ErrCommissionRateTooHigh() sdk.Error {
+	return types.NewError(types.CodeCommissionRateTooHigh, fmt.Sprintf("commission rate is higher than max commission rate"))
+}
+
+// ErrCommissionChangeTooLarge - error if commission rate change is larger than max daily change
+func ErrCommissionChangeTooLarge() sdk.Error {
+	return types.NewError(types.CodeCommissionChangeTooLarge, fmt.Sprintf("commission rate change is larger than max change"))
+}
+
+// ErrCommissionChangeTooFrequent - error if commission rate is changed twice in a day
+func ErrCommissionChangeTooFrequent(lastUpdatedAt int64) sdk.Error {
+	return types.NewError(types.CodeCommissionChangeTooFrequent, fmt.Sprintf("commission rate has been changed at %v", lastUpdatedAt))
+}
+
+// ErrInvalidMoniker - error if validator moniker is too long
+func ErrInvalidMoniker() sdk.Error {
+	return types.NewError(types.CodeInvalidMoniker, fmt.Sprintf("invalid moniker"))
+}
+
+// ErrInvalidValidatorDescription - error if validator description is too long
+func ErrInvalidValidatorDescription() sdk.Error {
+	return types.NewError(types.CodeInvalidValidatorDescription, fmt.Sprintf("invalid description"))
+}
+
+// ErrInvalidContact - error if validator contact details is too long
+func ErrInvalidContact() sdk.Error {
+	return types.NewError(types.CodeInvalidContact, fmt.Sprintf("invalid contact"))
+}
+
+// ErrInvalidValidatorPubKey - error if validator public key is missing
+func ErrInvalidValidatorPubKey() sdk.Error {
+	return types.NewError(types.CodeInvalidValidatorPubKey, fmt.Sprintf("invalid validator public key"))
+}
+
 // ErrValidatorPubKeyAlreadyExist - error if validator public key is already exist
 func ErrValidatorPubKeyAlreadyExist() sdk.Error {
 	return types.NewError(types.CodeValidatorPubKeyAlreadyExist, fmt.Sprintf("validator public key has been registered"))
@@ -0,0 +1,32 @@
+package validator
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lino-network/lino/types"
+
+	acc "github.com/lino-network/lino/x/account"
+)
+
+// ReturnUnbondingEvent - return a piece of deposit withdrawn or revoked by validator
+// at created at, the piece is taken from what's left after byzantine slashes
+type ReturnUnbondingEvent struct {
+	Username  types.AccountKey `json:"username"`
+	CreatedAt int64            `json:"created_at"`
+}
+
+// Execute - execute unbonding return event
+func (event ReturnUnbondingEvent) Execute(
+	ctx sdk.Context, vm ValidatorManager, am acc.AccountManager) sdk.Error {
+	piece, err := vm.ReturnUnbondingPiece(ctx, event.Username, event.CreatedAt)
+	if err != nil {
+		return err
+	}
+	if piece.IsZero() {
+		return nil
+	}
+	if err := am.AddSavingCoin(
+		ctx, event.Username, piece, "", "", types.ValidatorReturnCoin); err != nil {
+		return err
+	}
+	return nil
+}
@@ -5,9 +5,11 @@ import (
 	"reflect"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lino-network/lino/param"
 	"github.com/lino-network/lino/types"
 	acc "github.com/lino-network/lino/x/account"
 	"github.com/lino-network/lino/x/global"
+	"github.com/lino-network/lino/x/validator/model"
 	vote "github.com/lino-network/lino/x/vote"
 )
 
@@ -21,6 +23,14 @@ func NewHandler(am acc.AccountManager, valManager ValidatorManager, voteManager
 			return handleWithdrawMsg(ctx, valManager, gm, am, msg)
 		case ValidatorRevokeMsg:
 			return handleRevokeMsg(ctx, valManager, gm, am, msg)
+		case ValidatorUnjailMsg:
+			return handleUnjailMsg(ctx, valManager, msg)
+		case ValidatorSetCommissionMsg:
+			return handleSetCommissionMsg(ctx, valManager, msg)
+		case ValidatorUpdateMsg:
+			return handleUpdateMsg(ctx, valManager, msg)
+		case ValidatorRotateKeyMsg:
+			return handleRotateKeyMsg(ctx, valManager, msg)
 		default:
 			errMsg := fmt.Sprintf("Unrecognized validator msg type: %v", reflect.TypeOf(msg).Name())
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -102,9 +112,7 @@ func handleWithdrawMsg(
 		return err.Result()
 	}
 
-	if err := returnCoinTo(
-		ctx, msg.Username, gm, am, param.ValidatorCoinReturnTimes,
-		param.ValidatorCoinReturnIntervalSec, coin); err != nil {
+	if err := unbondDeposit(ctx, msg.Username, vm, gm, am, param, coin); err != nil {
 		return err.Result()
 	}
 	return sdk.Result{}
@@ -127,30 +135,94 @@ func handleRevokeMsg(
 		return err.Result()
 	}
 
-	if err := returnCoinTo(
-		ctx, msg.Username, gm, am, param.ValidatorCoinReturnTimes,
-		param.ValidatorCoinReturnIntervalSec, coin); err != nil {
+	if err := unbondDeposit(ctx, msg.Username, vm, gm, am, param, coin); err != nil {
 		return err.Result()
 	}
 	return sdk.Result{}
 }
 
-func returnCoinTo(
-	ctx sdk.Context, name types.AccountKey, gm global.GlobalManager, am acc.AccountManager,
-	times int64, interval int64, coin types.Coin) sdk.Error {
+// Handle Unjail Msg
+func handleUnjailMsg(ctx sdk.Context, vm ValidatorManager, msg ValidatorUnjailMsg) sdk.Result {
+	if err := vm.Unjail(ctx, msg.Username); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{}
+}
 
-	if err := am.AddFrozenMoney(
-		ctx, name, coin, ctx.BlockHeader().Time.Unix(), interval, times); err != nil {
-		return err
+// Handle SetCommission Msg
+func handleSetCommissionMsg(ctx sdk.Context, vm ValidatorManager, msg ValidatorSetCommissionMsg) sdk.Result {
+	rate, err := sdk.NewRatFromDecimal(msg.CommissionRate, types.NewRatFromDecimalPrecision)
+	if err != nil {
+		return err.Result()
+	}
+	if err := vm.SetCommissionRate(ctx, msg.Username, rate); err != nil {
+		return err.Result()
 	}
+	return sdk.Result{}
+}
 
-	events, err := acc.CreateCoinReturnEvents(name, times, interval, coin, types.ValidatorReturnCoin)
+func handleUpdateMsg(ctx sdk.Context, vm ValidatorManager, msg ValidatorUpdateMsg) sdk.Result {
+	if err := vm.UpdateValidator(ctx, msg.Username, model.Description{
+		Moniker: msg.Moniker,
+		Website: msg.Website,
+		Details: msg.Description,
+		Contact: msg.Contact,
+	}); err != nil {
+		return err.Result()
+	}
+	if len(msg.CommissionRate) == 0 {
+		return sdk.Result{}
+	}
+	rate, err := sdk.NewRatFromDecimal(msg.CommissionRate, types.NewRatFromDecimalPrecision)
 	if err != nil {
-		return err
+		return err.Result()
 	}
+	// unchanged commission rate doesn't count as a commission change
+	commissionRate, err := vm.GetCommissionRate(ctx, msg.Username)
+	if err != nil {
+		return err.Result()
+	}
+	if rate.Equal(commissionRate) {
+		return sdk.Result{}
+	}
+	if err := vm.SetCommissionRate(ctx, msg.Username, rate); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{}
+}
 
-	if err := gm.RegisterCoinReturnEvent(ctx, events, times, interval); err != nil {
+func handleRotateKeyMsg(ctx sdk.Context, vm ValidatorManager, msg ValidatorRotateKeyMsg) sdk.Result {
+	if err := vm.RotateValidatorKey(ctx, msg.Username, msg.ValPubKey); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{}
+}
+
+// unbondDeposit - hold withdrawn deposit as unbonding which is returned in pieces,
+// no piece is returned before evidence of byzantine behavior before the withdraw
+// expires so the whole deposit stays slashable
+func unbondDeposit(
+	ctx sdk.Context, name types.AccountKey, vm ValidatorManager, gm global.GlobalManager,
+	am acc.AccountManager, valParam *param.ValidatorParam, coin types.Coin) sdk.Error {
+	now := ctx.BlockHeader().Time.Unix()
+	times := valParam.ValidatorCoinReturnTimes
+	interval := valParam.ValidatorCoinReturnIntervalSec
+	if err := am.AddFrozenMoney(ctx, name, coin, now, interval, times); err != nil {
+		return err
+	}
+	if err := vm.AddUnbonding(ctx, name, coin, times); err != nil {
 		return err
 	}
+
+	event := ReturnUnbondingEvent{Username: name, CreatedAt: now}
+	for i := int64(0); i < times; i++ {
+		returnAt := now + interval*(i+1)
+		if returnAt < now+valParam.MaxEvidenceAgeSec {
+			returnAt = now + valParam.MaxEvidenceAgeSec
+		}
+		if err := gm.RegisterUnbondingReturnEvent(ctx, returnAt, event); err != nil {
+			return err
+		}
+	}
 	return nil
 }
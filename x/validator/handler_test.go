@@ -3,6 +3,7 @@ package validator
 import (
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/lino-network/lino/types"
 	"github.com/lino-network/lino/x/validator/model"
@@ -12,6 +13,7 @@ import (
 	tmtypes "github.com/tendermint/tendermint/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
 )
 
 func TestRegisterBasic(t *testing.T) {
@@ -468,6 +470,128 @@ func TestRemoveBasic(t *testing.T) {
 	assert.Equal(t, goodUser, verifyList2.AllValidators[0])
 }
 
+func TestUnjail(t *testing.T) {
+	ctx, am, valManager, voteManager, gm := setupTest(t, 0)
+	handler := NewHandler(am, valManager, voteManager, gm)
+	valManager.InitGenesis(ctx)
+
+	valParam, _ := valManager.paramHolder.GetValidatorParam(ctx)
+	minBalance := types.NewCoinFromInt64(1000 * types.Decimals)
+	user1 := createTestAccount(ctx, am, "user1", minBalance.Plus(valParam.ValidatorMinCommittingDeposit))
+	user2 := createTestAccount(ctx, am, "user2", minBalance.Plus(valParam.ValidatorMinCommittingDeposit))
+	voteManager.AddVoter(ctx, user1, valParam.ValidatorMinVotingDeposit)
+	voteManager.AddVoter(ctx, user2, valParam.ValidatorMinVotingDeposit)
+
+	valKey1 := secp256k1.GenPrivKey().PubKey()
+	valKey2 := secp256k1.GenPrivKey().PubKey()
+	handler(ctx, NewValidatorDepositMsg("user1", coinToString(valParam.ValidatorMinCommittingDeposit), valKey1, ""))
+	handler(ctx, NewValidatorDepositMsg("user2", coinToString(valParam.ValidatorMinCommittingDeposit), valKey2, ""))
+
+	// user1 is jailed for missing blocks, deposit becomes insufficient
+	_, err := valManager.PunishOncallValidator(ctx, user1, valParam.PenaltyMissCommit, types.PunishAbsentCommit)
+	assert.Nil(t, err)
+	lst, _ := valManager.storage.GetValidatorList(ctx)
+	assert.Equal(t, []types.AccountKey{user2}, lst.OncallValidators)
+	assert.Equal(t, 2, len(lst.AllValidators))
+	validator, _ := valManager.storage.GetValidator(ctx, user1)
+	jailedUntil := ctx.BlockHeader().Time.Unix() + valParam.JailDurationSec
+	assert.True(t, validator.IsJailed)
+	assert.Equal(t, jailedUntil, validator.JailedUntil)
+
+	result := handler(ctx, NewValidatorUnjailMsg("user2"))
+	assert.Equal(t, ErrValidatorNotJailed().Result(), result)
+	result = handler(ctx, NewValidatorUnjailMsg("user1"))
+	assert.Equal(t, ErrValidatorStillJailed(jailedUntil).Result(), result)
+
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(jailedUntil, 0)})
+	result = handler(ctx, NewValidatorUnjailMsg("user1"))
+	assert.Equal(t, ErrInsufficientDeposit().Result(), result)
+
+	// deposit doesn't bring jailed validator back to oncall list
+	result = handler(ctx, NewValidatorDepositMsg("user1", coinToString(valParam.PenaltyMissCommit), valKey1, ""))
+	assert.Equal(t, sdk.Result{}, result)
+	lst, _ = valManager.storage.GetValidatorList(ctx)
+	assert.Equal(t, []types.AccountKey{user2}, lst.OncallValidators)
+
+	result = handler(ctx, NewValidatorUnjailMsg("user1"))
+	assert.Equal(t, sdk.Result{}, result)
+	lst, _ = valManager.storage.GetValidatorList(ctx)
+	assert.Equal(t, 2, len(lst.OncallValidators))
+	assert.NotEqual(t, -1, types.FindAccountInList(user1, lst.OncallValidators))
+	validator, _ = valManager.storage.GetValidator(ctx, user1)
+	assert.False(t, validator.IsJailed)
+	assert.Equal(t, valParam.ValidatorMinCommittingDeposit, validator.Deposit)
+}
+
+func TestUpdateValidator(t *testing.T) {
+	ctx, am, valManager, voteManager, gm := setupTest(t, 0)
+	handler := NewHandler(am, valManager, voteManager, gm)
+	valManager.InitGenesis(ctx)
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Now()})
+
+	valParam, _ := valManager.paramHolder.GetValidatorParam(ctx)
+	minBalance := types.NewCoinFromInt64(1 * types.Decimals)
+	user1 := createTestAccount(ctx, am, "user1", minBalance.Plus(valParam.ValidatorMinCommittingDeposit))
+	voteManager.AddVoter(ctx, "user1", valParam.ValidatorMinVotingDeposit)
+	msg := NewValidatorDepositMsg(
+		"user1", coinToString(valParam.ValidatorMinCommittingDeposit), secp256k1.GenPrivKey().PubKey(), "")
+	result := handler(ctx, msg)
+	assert.Equal(t, sdk.Result{}, result)
+
+	testCases := []struct {
+		testName             string
+		msg                  ValidatorUpdateMsg
+		expectResult         sdk.Result
+		expectCommissionRate sdk.Rat
+	}{
+		{
+			testName:             "unchanged commission rate doesn't change commission",
+			msg:                  NewValidatorUpdateMsg("user1", "moniker", "https://lino.network", "description", "contact", "0"),
+			expectResult:         sdk.Result{},
+			expectCommissionRate: sdk.ZeroRat(),
+		},
+		{
+			testName:             "update commission rate",
+			msg:                  NewValidatorUpdateMsg("user1", "moniker1", "https://lino.network", "description", "contact", "0.01"),
+			expectResult:         sdk.Result{},
+			expectCommissionRate: sdk.NewRat(1, 100),
+		},
+		{
+			testName:             "empty commission rate only updates information",
+			msg:                  NewValidatorUpdateMsg("user1", "moniker2", "https://lino.network", "description", "contact", ""),
+			expectResult:         sdk.Result{},
+			expectCommissionRate: sdk.NewRat(1, 100),
+		},
+		{
+			testName:             "commission rate can't change twice in a day",
+			msg:                  NewValidatorUpdateMsg("user1", "moniker3", "https://lino.network", "description", "contact", "0.02"),
+			expectResult:         ErrCommissionChangeTooFrequent(ctx.BlockHeader().Time.Unix()).Result(),
+			expectCommissionRate: sdk.NewRat(1, 100),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := handler(ctx, tc.msg)
+		if !assert.Equal(t, tc.expectResult, result) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectResult)
+		}
+		validator, _ := valManager.storage.GetValidator(ctx, user1)
+		if !validator.CommissionRate.Equal(tc.expectCommissionRate) {
+			t.Errorf("%s: diff commission rate, got %v, want %v",
+				tc.testName, validator.CommissionRate, tc.expectCommissionRate)
+		}
+		expectDescription := model.Description{
+			Moniker: tc.msg.Moniker,
+			Website: tc.msg.Website,
+			Details: tc.msg.Description,
+			Contact: tc.msg.Contact,
+		}
+		if !assert.Equal(t, expectDescription, validator.Description) {
+			t.Errorf("%s: diff description, got %v, want %v", tc.testName, validator.Description, expectDescription)
+		}
+	}
+}
+
 func TestRegisterWithDupKey(t *testing.T) {
 	ctx, am, valManager, voteManager, gm := setupTest(t, 0)
 	handler := NewHandler(am, valManager, voteManager, gm)
@@ -494,7 +618,7 @@ func TestRegisterWithDupKey(t *testing.T) {
 
 }
 
-func TestAddFrozenMoney(t *testing.T) {
+func TestUnbondDeposit(t *testing.T) {
 	ctx, am, valManager, _, gm := setupTest(t, 0)
 	valManager.InitGenesis(ctx)
 
@@ -510,6 +634,7 @@ func TestAddFrozenMoney(t *testing.T) {
 		expectedFrozenMoney    types.Coin
 		expectedFrozenTimes    int64
 		expectedFrozenInterval int64
+		expectedUnbonding      model.Unbonding
 	}{
 		{
 			testName:               "return coin to user",
@@ -520,6 +645,12 @@ func TestAddFrozenMoney(t *testing.T) {
 			expectedFrozenMoney:    types.NewCoinFromInt64(100),
 			expectedFrozenTimes:    10,
 			expectedFrozenInterval: 2,
+			expectedUnbonding: model.Unbonding{
+				Amount:        types.NewCoinFromInt64(100),
+				ReturnTimes:   10,
+				CreatedHeight: ctx.BlockHeight(),
+				CreatedAt:     ctx.BlockHeader().Time.Unix(),
+			},
 		},
 		{
 			testName:               "return coin to user again",
@@ -530,12 +661,23 @@ func TestAddFrozenMoney(t *testing.T) {
 			expectedFrozenMoney:    types.NewCoinFromInt64(100000),
 			expectedFrozenTimes:    100000,
 			expectedFrozenInterval: 20000,
+			expectedUnbonding: model.Unbonding{
+				Amount:        types.NewCoinFromInt64(100100),
+				ReturnTimes:   100010,
+				CreatedHeight: ctx.BlockHeight(),
+				CreatedAt:     ctx.BlockHeader().Time.Unix(),
+			},
 		},
 	}
 
 	for _, tc := range testCases {
-		err := returnCoinTo(
-			ctx, "user", gm, am, tc.times, tc.interval, tc.returnedCoin)
+		valParam, err := valManager.paramHolder.GetValidatorParam(ctx)
+		if err != nil {
+			t.Errorf("%s: failed to get param, got err %v", tc.testName, err)
+		}
+		valParam.ValidatorCoinReturnTimes = tc.times
+		valParam.ValidatorCoinReturnIntervalSec = tc.interval
+		err = unbondDeposit(ctx, "user", valManager, gm, am, valParam, tc.returnedCoin)
 		if err != nil {
 			t.Errorf("%s: failed to return coin, got err %v", tc.testName, err)
 		}
@@ -556,5 +698,11 @@ func TestAddFrozenMoney(t *testing.T) {
 		if lst[len(lst)-1].Interval != tc.expectedFrozenInterval {
 			t.Errorf("%s: diff interval, got %v, want %v", tc.testName, lst[len(lst)-1].Interval, tc.expectedFrozenInterval)
 		}
+
+		unbondings, err := valManager.storage.GetUnbondings(ctx, user)
+		if err != nil {
+			t.Errorf("%s: failed to get unbondings, got err %v", tc.testName, err)
+		}
+		assert.Equal(t, []model.Unbonding{tc.expectedUnbonding}, unbondings.Unbondings, tc.testName)
 	}
 }
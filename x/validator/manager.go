@@ -7,6 +7,7 @@ import (
 	"github.com/lino-network/lino/param"
 	"github.com/lino-network/lino/types"
 	"github.com/lino-network/lino/x/validator/model"
+	"github.com/lino-network/lino/x/vote"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -85,12 +86,20 @@ func (vm ValidatorManager) IsBalancedAccount(
 }
 
 // GetUpdateValidatorList - after a block, compare updated validator set with
-// recorded validator set before block execution
-func (vm ValidatorManager) GetUpdateValidatorList(ctx sdk.Context) ([]abci.Validator, sdk.Error) {
+// recorded validator set before block execution. Power of oncall validator is
+// its committing deposit plus voting power of its voter account, power of
+// validator already oncall can only change MaxPowerChangeRate in a block.
+// Key known by tendermint before key rotation is replaced by the new key.
+func (vm ValidatorManager) GetUpdateValidatorList(
+	ctx sdk.Context, voteManager vote.VoteManager) ([]abci.Validator, sdk.Error) {
 	validatorList, err := vm.storage.GetValidatorList(ctx)
 	if err != nil {
 		return nil, err
 	}
+	param, err := vm.paramHolder.GetValidatorParam(ctx)
+	if err != nil {
+		return nil, err
+	}
 	ABCIValList := []abci.Validator{}
 	for _, preValidator := range validatorList.PreBlockValidators {
 		// set power to 0 if a previous validator not in oncall list anymore
@@ -99,12 +108,23 @@ func (vm ValidatorManager) GetUpdateValidatorList(ctx sdk.Context) ([]abci.Valid
 			if err != nil {
 				return nil, err
 			}
+			tmValidator := validator.ABCIValidator
+			if validator.PreviousKey != nil {
+				tmValidator = *validator.PreviousKey
+				validator.PreviousKey = nil
+				if err := vm.storage.SetValidator(ctx, validator.Username, validator); err != nil {
+					return nil, err
+				}
+			}
+			if err := vm.closeOncallPeriod(ctx, tmValidator.Address, param.MaxEvidenceAgeSec); err != nil {
+				return nil, err
+			}
 			if validator.Deposit.IsZero() {
 				vm.storage.DeleteValidator(ctx, validator.Username)
 			}
 
-			validator.ABCIValidator.Power = 0
-			ABCIValList = append(ABCIValList, validator.ABCIValidator)
+			tmValidator.Power = 0
+			ABCIValList = append(ABCIValList, tmValidator)
 		}
 	}
 
@@ -113,11 +133,134 @@ func (vm ValidatorManager) GetUpdateValidatorList(ctx sdk.Context) ([]abci.Valid
 		if err != nil {
 			return nil, err
 		}
+		power, err := vm.getTargetPower(ctx, voteManager, validator)
+		if err != nil {
+			return nil, err
+		}
+		// new oncall validator signs from next block, validator already
+		// oncall without record (genesis validator) signs from this block
+		startHeight := ctx.BlockHeight() + 1
+		if types.FindAccountInList(curValidator, validatorList.PreBlockValidators) != -1 {
+			startHeight = ctx.BlockHeight()
+			power = capPowerChange(validator.ABCIValidator.Power, power, param.MaxPowerChangeRate)
+		}
+		// rotated key signs from next block, previous key leaves validator set
+		isKeyRotated := validator.PreviousKey != nil
+		if isKeyRotated {
+			if err := vm.closeOncallPeriod(
+				ctx, validator.PreviousKey.Address, param.MaxEvidenceAgeSec); err != nil {
+				return nil, err
+			}
+			previousKey := *validator.PreviousKey
+			previousKey.Power = 0
+			ABCIValList = append(ABCIValList, previousKey)
+			validator.PreviousKey = nil
+			startHeight = ctx.BlockHeight() + 1
+		}
+		if err := vm.openOncallPeriod(
+			ctx, validator.Username, validator.ABCIValidator.Address, startHeight); err != nil {
+			return nil, err
+		}
+		if isKeyRotated || validator.ABCIValidator.Power != power {
+			validator.ABCIValidator.Power = power
+			if err := vm.storage.SetValidator(ctx, validator.Username, validator); err != nil {
+				return nil, err
+			}
+		}
 		ABCIValList = append(ABCIValList, validator.ABCIValidator)
 	}
 	return ABCIValList, nil
 }
 
+// getTargetPower - committing deposit plus voting power of validator in LNO
+func (vm ValidatorManager) getTargetPower(
+	ctx sdk.Context, voteManager vote.VoteManager, validator *model.Validator) (int64, sdk.Error) {
+	power := validator.Deposit
+	if voteManager.DoesVoterExist(ctx, validator.Username) {
+		votingPower, err := voteManager.GetVotingPower(ctx, validator.Username)
+		if err != nil {
+			return 0, err
+		}
+		power = power.Plus(votingPower)
+	}
+	return model.CoinToPower(power), nil
+}
+
+// capPowerChange - limit power change of validator in a block to
+// maxChangeRate of its previous power, at least 1 power can be changed
+func capPowerChange(prePower, power int64, maxChangeRate sdk.Rat) int64 {
+	if prePower <= 0 {
+		return power
+	}
+	maxChange := types.RatToCoin(sdk.NewRat(prePower).Mul(maxChangeRate)).ToInt64()
+	if maxChange < 1 {
+		maxChange = 1
+	}
+	if power > prePower+maxChange {
+		return prePower + maxChange
+	}
+	if power < prePower-maxChange {
+		return prePower - maxChange
+	}
+	return power
+}
+
+// openOncallPeriod - start an oncall period of validator key if it's not oncall yet
+func (vm ValidatorManager) openOncallPeriod(
+	ctx sdk.Context, username types.AccountKey, address []byte, startHeight int64) sdk.Error {
+	history, err := vm.storage.GetOncallHistory(ctx, address)
+	if err != nil {
+		return err
+	}
+	if history == nil {
+		history = &model.OncallHistory{Username: username}
+	}
+	if len(history.Periods) > 0 && history.Periods[len(history.Periods)-1].EndHeight == 0 {
+		return nil
+	}
+	history.Periods = append(history.Periods, model.OncallPeriod{StartHeight: startHeight})
+	return vm.storage.SetOncallHistory(ctx, address, history)
+}
+
+// closeOncallPeriod - end the oncall period of validator key at current block,
+// periods ended longer than evidence age ago are pruned
+func (vm ValidatorManager) closeOncallPeriod(
+	ctx sdk.Context, address []byte, maxEvidenceAgeSec int64) sdk.Error {
+	history, err := vm.storage.GetOncallHistory(ctx, address)
+	if err != nil {
+		return err
+	}
+	if history == nil {
+		return nil
+	}
+	now := ctx.BlockHeader().Time.Unix()
+	periods := []model.OncallPeriod{}
+	for _, period := range history.Periods {
+		if period.EndHeight == 0 {
+			period.EndHeight = ctx.BlockHeight()
+			period.EndAt = now
+		}
+		if now-period.EndAt <= maxEvidenceAgeSec {
+			periods = append(periods, period)
+		}
+	}
+	if len(periods) == 0 {
+		return vm.storage.DeleteOncallHistory(ctx, address)
+	}
+	history.Periods = periods
+	return vm.storage.SetOncallHistory(ctx, address, history)
+}
+
+// wasOncallAt - check if validator key was oncall at given height
+func wasOncallAt(history *model.OncallHistory, height int64) bool {
+	for _, period := range history.Periods {
+		if period.StartHeight <= height && (period.EndHeight == 0 || height <= period.EndHeight) {
+			return true
+		}
+	}
+	return false
+}
+
 // GetValidatorList - get validator list from KV Store
 func (vm ValidatorManager) GetValidatorList(ctx sdk.Context) (*model.ValidatorList, sdk.Error) {
 	return vm.storage.GetValidatorList(ctx)
@@ -132,18 +275,32 @@ func (vm ValidatorManager) GetValidatorDeposit(ctx sdk.Context, accKey types.Acc
 	return validator.Deposit, nil
 }
 
+// GetValidatorPower - get tendermint voting power of validator
+func (vm ValidatorManager) GetValidatorPower(ctx sdk.Context, accKey types.AccountKey) (int64, sdk.Error) {
+	validator, err := vm.storage.GetValidator(ctx, accKey)
+	if err != nil {
+		return 0, err
+	}
+	return validator.ABCIValidator.Power, nil
+}
+
 // SetValidatorList - set validator list
 func (vm ValidatorManager) SetValidatorList(ctx sdk.Context, lst *model.ValidatorList) sdk.Error {
 	return vm.storage.SetValidatorList(ctx, lst)
 }
 
 // UpdateSigningValidator - based on info in beginBlocker, record last block singing info
+// in signing window of oncall validators
 func (vm ValidatorManager) UpdateSigningValidator(
 	ctx sdk.Context, signingValidators []abci.SigningValidator) sdk.Error {
 	lst, err := vm.storage.GetValidatorList(ctx)
 	if err != nil {
 		panic(err)
 	}
+	param, err := vm.paramHolder.GetValidatorParam(ctx)
+	if err != nil {
+		panic(err)
+	}
 
 	pkToSigningInfo := make(map[string]bool)
 
@@ -159,14 +316,7 @@ func (vm ValidatorManager) UpdateSigningValidator(
 			panic(getErr)
 		}
 		signedLastBlock, exist := pkToSigningInfo[string(validator.ABCIValidator.Address)]
-		if !exist || !signedLastBlock {
-			validator.AbsentCommit++
-		} else {
-			validator.ProducedBlocks++
-			if validator.AbsentCommit > 0 {
-				validator.AbsentCommit--
-			}
-		}
+		recordSigning(validator, exist && signedLastBlock, param.SignedBlocksWindow)
 		if err := vm.storage.SetValidator(ctx, curValidator, validator); err != nil {
 			panic(err)
 		}
@@ -175,9 +325,76 @@ func (vm ValidatorManager) UpdateSigningValidator(
 	return nil
 }
 
+// recordSigning - record if validator signed the block in its signing window,
+// the window is reset if window size changed
+func recordSigning(validator *model.Validator, signed bool, window int64) {
+	if validator.SigningWindow != window || int64(len(validator.MissedBlocks)) != (window+7)/8 {
+		resetSigningWindow(validator, window)
+	}
+	idx := validator.SigningIndex % window
+	mask := byte(1) << uint(idx%8)
+	missedBefore := validator.MissedBlocks[idx/8]&mask != 0
+	if signed {
+		validator.ProducedBlocks++
+		if missedBefore {
+			validator.MissedBlocks[idx/8] &^= mask
+			validator.AbsentCommit--
+		}
+	} else if !missedBefore {
+		validator.MissedBlocks[idx/8] |= mask
+		validator.AbsentCommit++
+	}
+	validator.SigningIndex++
+}
+
+// resetSigningWindow - clear signing window of validator
+func resetSigningWindow(validator *model.Validator, window int64) {
+	validator.SigningWindow = window
+	validator.SigningIndex = 0
+	validator.MissedBlocks = make([]byte, (window+7)/8)
+	validator.AbsentCommit = 0
+}
+
+// isBelowMinSigned - check if signed blocks ratio in a full signing window is below minimum
+func isBelowMinSigned(validator *model.Validator, param *param.ValidatorParam) bool {
+	if validator.SigningWindow != param.SignedBlocksWindow ||
+		validator.SigningIndex < validator.SigningWindow {
+		return false
+	}
+	signed := validator.SigningWindow - validator.AbsentCommit
+	return sdk.NewRat(signed, validator.SigningWindow).LT(param.MinSignedPerWindow)
+}
+
+// GetSigningBitmap - signing status of blocks in validator signing window
+// from the oldest to the latest, true if the block is signed
+func GetSigningBitmap(validator model.Validator) []bool {
+	if validator.SigningWindow <= 0 ||
+		int64(len(validator.MissedBlocks)) != (validator.SigningWindow+7)/8 {
+		return []bool{}
+	}
+	start := validator.SigningIndex - validator.SigningWindow
+	if start < 0 {
+		start = 0
+	}
+	bitmap := []bool{}
+	for i := start; i < validator.SigningIndex; i++ {
+		idx := i % validator.SigningWindow
+		bitmap = append(bitmap, validator.MissedBlocks[idx/8]&(byte(1)<<uint(idx%8)) == 0)
+	}
+	return bitmap
+}
+
 // PunishOncallValidator - punish oncall validator if 1) byzantine or 2) missing blocks reach limiation
 func (vm ValidatorManager) PunishOncallValidator(
 	ctx sdk.Context, username types.AccountKey, penalty types.Coin, punishType types.PunishType) (types.Coin, sdk.Error) {
+	return vm.punishOncallValidator(ctx, username, penalty, punishType, nil)
+}
+
+// punishOncallValidator - punish validator and record the slash in its ledger,
+// evidence is nil if the slash isn't caused by double sign evidence
+func (vm ValidatorManager) punishOncallValidator(
+	ctx sdk.Context, username types.AccountKey, penalty types.Coin, punishType types.PunishType,
+	evidence *abci.Evidence) (types.Coin, sdk.Error) {
 	actualPenalty := penalty
 	validator, err := vm.storage.GetValidator(ctx, username)
 	if err != nil {
@@ -191,40 +408,286 @@ func (vm ValidatorManager) PunishOncallValidator(
 		validator.Deposit = validator.Deposit.Minus(penalty)
 	}
 
-	if punishType == types.PunishAbsentCommit {
-		validator.AbsentCommit = 0
-	}
-
 	param, err := vm.paramHolder.GetValidatorParam(ctx)
 	if err != nil {
 		return actualPenalty, err
 	}
 
-	// remove this validator if its remaining deposit is not enough
-	// OR, we explicitly want to fire this validator
+	if punishType == types.PunishAbsentCommit {
+		resetSigningWindow(validator, param.SignedBlocksWindow)
+	}
+
+	// byzantine validator is removed from all lists, its key is tombstoned
+	// so it can't be validator again, remaining deposit can be revoked.
+	// Otherwise remove this validator if its deposit is used up, OR its remaining
+	// deposit is not enough and it's not punished for missing blocks,
 	// all deposit will be added back to inflation pool
-	if punishType == types.PunishByzantine || !validator.Deposit.IsGTE(param.ValidatorMinCommittingDeposit) {
+	if punishType == types.PunishByzantine {
+		if err := vm.RemoveValidatorFromAllLists(ctx, validator.Username); err != nil {
+			return actualPenalty, err
+		}
+		validator.ByzantineCommit++
+	} else if validator.Deposit.IsZero() ||
+		(punishType != types.PunishAbsentCommit &&
+			!validator.Deposit.IsGTE(param.ValidatorMinCommittingDeposit)) {
 		if err := vm.RemoveValidatorFromAllLists(ctx, validator.Username); err != nil {
 			return actualPenalty, err
 		}
 		actualPenalty = actualPenalty.Plus(validator.Deposit)
 		validator.Deposit = types.NewCoinFromInt64(0)
+	} else if punishType == types.PunishAbsentCommit {
+		// validator missing blocks is jailed instead, it stays registered and
+		// can unjail after jail duration
+		if err := vm.removeValidatorFromOncallList(ctx, validator.Username); err != nil {
+			return actualPenalty, err
+		}
+		validator.IsJailed = true
+		validator.JailedUntil = ctx.BlockHeader().Time.Unix() + param.JailDurationSec
 	}
 
 	if err := vm.storage.SetValidator(ctx, username, validator); err != nil {
 		return actualPenalty, err
 	}
 
+	entry := model.LedgerEntry{
+		Type:       types.ValidatorSlash,
+		PunishType: punishType,
+		Amount:     actualPenalty,
+		Height:     ctx.BlockHeight(),
+		CreatedAt:  ctx.BlockHeader().Time.Unix(),
+	}
+	if evidence != nil {
+		entry.EvidenceHeight = evidence.Height
+		entry.EvidenceAddress = evidence.Validator.Address
+	}
+	if err := vm.addLedgerEntry(ctx, username, entry); err != nil {
+		return actualPenalty, err
+	}
+
 	if err := vm.AdjustValidatorList(ctx); err != nil {
 		return actualPenalty, err
 	}
 	return actualPenalty, nil
 }
 
-// FireIncompetentValidator - fire oncall validator if 1) deposit insufficient 2) byzantine
+// AddRewardToLedger - record hourly inflation distributed to validator in its
+// ledger, including the part shared with its delegators
+func (vm ValidatorManager) AddRewardToLedger(
+	ctx sdk.Context, username types.AccountKey, reward types.Coin) sdk.Error {
+	return vm.addLedgerEntry(ctx, username, model.LedgerEntry{
+		Type:      types.ValidatorReward,
+		Amount:    reward,
+		Height:    ctx.BlockHeight(),
+		CreatedAt: ctx.BlockHeader().Time.Unix(),
+	})
+}
+
+// addLedgerEntry - append entry to the last bundle of validator ledger
+func (vm ValidatorManager) addLedgerEntry(
+	ctx sdk.Context, username types.AccountKey, entry model.LedgerEntry) sdk.Error {
+	meta, err := vm.storage.GetLedgerMeta(ctx, username)
+	if err != nil {
+		return err
+	}
+	bundleSlot := meta.NumOfEntries / types.ValidatorLedgerBundleSize
+	ledger, err := vm.storage.GetLedger(ctx, username, bundleSlot)
+	if err != nil {
+		return err
+	}
+	if ledger == nil {
+		ledger = &model.Ledger{Entries: []model.LedgerEntry{}}
+	}
+	ledger.Entries = append(ledger.Entries, entry)
+	if err := vm.storage.SetLedger(ctx, username, bundleSlot, ledger); err != nil {
+		return err
+	}
+	meta.NumOfEntries++
+	return vm.storage.SetLedgerMeta(ctx, username, meta)
+}
+
+// HandleDoubleSignEvidence - slash SlashFractionByzantine of deposit and PenaltyByzantine from validator
+// who was oncall at evidence height and tombstone its key. Deposit withdrawn and delegation
+// redelegated away from the validator since evidence height, which are still unbonding, are
+// slashed by SlashFractionByzantine as well. Evidence older than
+// MaxEvidenceAgeSec, about key never oncall at that height or tombstoned key is ignored
+func (vm ValidatorManager) HandleDoubleSignEvidence(
+	ctx sdk.Context, evidence abci.Evidence, voteManager vote.VoteManager) (types.Coin, sdk.Error) {
+	penalty := types.NewCoinFromInt64(0)
+	param, err := vm.paramHolder.GetValidatorParam(ctx)
+	if err != nil {
+		return penalty, err
+	}
+	address := evidence.Validator.Address
+	now := ctx.BlockHeader().Time.Unix()
+	if now-evidence.Time.Unix() > param.MaxEvidenceAgeSec {
+		return penalty, nil
+	}
+	if vm.storage.IsTombstoned(ctx, address) {
+		return penalty, nil
+	}
+	history, err := vm.storage.GetOncallHistory(ctx, address)
+	if err != nil {
+		return penalty, err
+	}
+	if history == nil || !wasOncallAt(history, evidence.Height) {
+		return penalty, nil
+	}
+
+	// validator may have revoked after double signing, validator rotated
+	// its key is still slashed
+	validator, err := vm.storage.GetValidator(ctx, history.Username)
+	if err == nil {
+		slash := types.RatToCoin(validator.Deposit.ToRat().Mul(param.SlashFractionByzantine)).Plus(param.PenaltyByzantine)
+		penalty, err = vm.punishOncallValidator(ctx, validator.Username, slash, types.PunishByzantine, &evidence)
+		if err != nil {
+			return penalty, err
+		}
+	}
+	unbondingSlash, err := vm.SlashUnbondings(
+		ctx, history.Username, evidence, param.SlashFractionByzantine)
+	if err != nil {
+		return penalty, err
+	}
+	penalty = penalty.Plus(unbondingSlash)
+	redelegationSlash, err := voteManager.SlashRedelegations(
+		ctx, history.Username, evidence.Height, param.SlashFractionByzantine)
+	if err != nil {
+		return penalty, err
+	}
+	penalty = penalty.Plus(redelegationSlash)
+
+	if err := vm.storage.SetEvidence(ctx, &model.Evidence{
+		Username: history.Username,
+		Address:  address,
+		Height:   evidence.Height,
+		Time:     evidence.Time.Unix(),
+		Penalty:  penalty,
+	}); err != nil {
+		return penalty, err
+	}
+	if err := vm.storage.SetTombstone(ctx, address, &model.Tombstone{
+		Username: history.Username,
+		Height:   evidence.Height,
+		Time:     now,
+	}); err != nil {
+		return penalty, err
+	}
+	return penalty, nil
+}
+
+// AddUnbonding - hold deposit withdrawn by validator until it's returned in return times pieces
+func (vm ValidatorManager) AddUnbonding(
+	ctx sdk.Context, username types.AccountKey, coin types.Coin, returnTimes int64) sdk.Error {
+	unbondings, err := vm.storage.GetUnbondings(ctx, username)
+	if err != nil {
+		return err
+	}
+	now := ctx.BlockHeader().Time.Unix()
+	// withdraws in the same block share the return schedule, each withdraw
+	// registers its own return events so the return times add up
+	for i := range unbondings.Unbondings {
+		if unbondings.Unbondings[i].CreatedAt == now {
+			unbondings.Unbondings[i].Amount = unbondings.Unbondings[i].Amount.Plus(coin)
+			unbondings.Unbondings[i].ReturnTimes += returnTimes
+			return vm.storage.SetUnbondings(ctx, username, unbondings)
+		}
+	}
+	unbondings.Unbondings = append(unbondings.Unbondings, model.Unbonding{
+		Amount:        coin,
+		ReturnTimes:   returnTimes,
+		CreatedHeight: ctx.BlockHeight(),
+		CreatedAt:     now,
+	})
+	return vm.storage.SetUnbondings(ctx, username, unbondings)
+}
+
+// ReturnUnbondingPiece - remove next piece of the unbonding created at given time,
+// the remaining amount is split evenly among remaining return times
+func (vm ValidatorManager) ReturnUnbondingPiece(
+	ctx sdk.Context, username types.AccountKey, createdAt int64) (types.Coin, sdk.Error) {
+	piece := types.NewCoinFromInt64(0)
+	unbondings, err := vm.storage.GetUnbondings(ctx, username)
+	if err != nil {
+		return piece, err
+	}
+	for i, unbonding := range unbondings.Unbondings {
+		if unbonding.CreatedAt != createdAt {
+			continue
+		}
+		if unbonding.ReturnTimes <= 1 {
+			piece = unbonding.Amount
+			unbondings.Unbondings = append(unbondings.Unbondings[:i], unbondings.Unbondings[i+1:]...)
+		} else {
+			piece = types.RatToCoin(
+				unbonding.Amount.ToRat().Quo(sdk.NewRat(unbonding.ReturnTimes)).Round(types.PrecisionFactor))
+			unbondings.Unbondings[i].Amount = unbonding.Amount.Minus(piece)
+			unbondings.Unbondings[i].ReturnTimes--
+		}
+		if err := vm.storage.SetUnbondings(ctx, username, unbondings); err != nil {
+			return types.NewCoinFromInt64(0), err
+		}
+		return piece, nil
+	}
+	return piece, nil
+}
+
+// SlashUnbondings - slash fraction of deposit withdrawn at or after infraction
+// height which hasn't been returned, deposit withdrawn before the infraction
+// wasn't at stake
+func (vm ValidatorManager) SlashUnbondings(
+	ctx sdk.Context, username types.AccountKey, evidence abci.Evidence,
+	fraction sdk.Rat) (types.Coin, sdk.Error) {
+	totalSlash := types.NewCoinFromInt64(0)
+	unbondings, err := vm.storage.GetUnbondings(ctx, username)
+	if err != nil {
+		return totalSlash, err
+	}
+	for i, unbonding := range unbondings.Unbondings {
+		if unbonding.CreatedHeight < evidence.Height {
+			continue
+		}
+		slash := types.RatToCoin(unbonding.Amount.ToRat().Mul(fraction))
+		unbondings.Unbondings[i].Amount = unbonding.Amount.Minus(slash)
+		totalSlash = totalSlash.Plus(slash)
+	}
+	if totalSlash.IsZero() {
+		return totalSlash, nil
+	}
+	if err := vm.storage.SetUnbondings(ctx, username, unbondings); err != nil {
+		return totalSlash, err
+	}
+	if err := vm.addLedgerEntry(ctx, username, model.LedgerEntry{
+		Type:            types.ValidatorSlash,
+		PunishType:      types.PunishByzantine,
+		Amount:          totalSlash,
+		Height:          ctx.BlockHeight(),
+		CreatedAt:       ctx.BlockHeader().Time.Unix(),
+		EvidenceHeight:  evidence.Height,
+		EvidenceAddress: evidence.Validator.Address,
+	}); err != nil {
+		return totalSlash, err
+	}
+	return totalSlash, nil
+}
+
+// IsTombstoned - check if validator key is tombstoned for double signing
+func (vm ValidatorManager) IsTombstoned(ctx sdk.Context, address []byte) bool {
+	return vm.storage.IsTombstoned(ctx, address)
+}
+
+// FireIncompetentValidator - slash byzantine validators by evidence and
+// punish oncall validator missing too many blocks in signing window
 func (vm ValidatorManager) FireIncompetentValidator(
-	ctx sdk.Context, byzantineValidators []abci.Evidence) (types.Coin, sdk.Error) {
+	ctx sdk.Context, byzantineValidators []abci.Evidence, voteManager vote.VoteManager) (types.Coin, sdk.Error) {
 	totalPenalty := types.NewCoinFromInt64(0)
+	for _, evidence := range byzantineValidators {
+		actualPenalty, err := vm.HandleDoubleSignEvidence(ctx, evidence, voteManager)
+		if err != nil {
+			return totalPenalty, err
+		}
+		totalPenalty = totalPenalty.Plus(actualPenalty)
+	}
+
 	lst, err := vm.storage.GetValidatorList(ctx)
 	if err != nil {
 		return totalPenalty, err
@@ -241,19 +704,7 @@ func (vm ValidatorManager) FireIncompetentValidator(
 			return totalPenalty, err
 		}
 
-		for _, evidence := range byzantineValidators {
-			if reflect.DeepEqual(validator.ABCIValidator.Address, evidence.Validator.Address) {
-				actualPenalty, err := vm.PunishOncallValidator(
-					ctx, validator.Username, param.PenaltyByzantine, types.PunishByzantine)
-				if err != nil {
-					return totalPenalty, err
-				}
-				totalPenalty = totalPenalty.Plus(actualPenalty)
-				break
-			}
-		}
-
-		if validator.AbsentCommit > param.AbsentCommitLimitation {
+		if isBelowMinSigned(validator, param) {
 			actualPenalty, err := vm.PunishOncallValidator(
 				ctx, validator.Username, param.PenaltyMissCommit, types.PunishAbsentCommit)
 			if err != nil {
@@ -287,6 +738,83 @@ func (vm ValidatorManager) PunishValidatorsDidntVote(
 	return totalPenalty, nil
 }
 
+// Unjail - release a jailed validator after jail duration and try to bring it back to oncall list
+func (vm ValidatorManager) Unjail(ctx sdk.Context, username types.AccountKey) sdk.Error {
+	validator, err := vm.storage.GetValidator(ctx, username)
+	if err != nil {
+		return err
+	}
+	if !validator.IsJailed {
+		return ErrValidatorNotJailed()
+	}
+	if ctx.BlockHeader().Time.Unix() < validator.JailedUntil {
+		return ErrValidatorStillJailed(validator.JailedUntil)
+	}
+	param, err := vm.paramHolder.GetValidatorParam(ctx)
+	if err != nil {
+		return err
+	}
+	if !validator.Deposit.IsGTE(param.ValidatorMinCommittingDeposit) {
+		return ErrInsufficientDeposit()
+	}
+	validator.IsJailed = false
+	validator.JailedUntil = 0
+	resetSigningWindow(validator, param.SignedBlocksWindow)
+	if err := vm.storage.SetValidator(ctx, username, validator); err != nil {
+		return err
+	}
+	return vm.TryBecomeOncallValidator(ctx, username)
+}
+
+// SetCommissionRate - change commission rate of validator, the rate can't exceed
+// MaxCommissionRate and can be changed by at most MaxCommissionChangeRate once a day
+func (vm ValidatorManager) SetCommissionRate(
+	ctx sdk.Context, username types.AccountKey, rate sdk.Rat) sdk.Error {
+	validator, err := vm.storage.GetValidator(ctx, username)
+	if err != nil {
+		return err
+	}
+	param, err := vm.paramHolder.GetValidatorParam(ctx)
+	if err != nil {
+		return err
+	}
+	if rate.GT(param.MaxCommissionRate) {
+		return ErrCommissionRateTooHigh()
+	}
+	now := ctx.BlockHeader().Time.Unix()
+	if validator.CommissionUpdatedAt != 0 && now-validator.CommissionUpdatedAt < 24*3600 {
+		return ErrCommissionChangeTooFrequent(validator.CommissionUpdatedAt)
+	}
+	change := rate.Sub(validator.CommissionRate)
+	if change.GT(param.MaxCommissionChangeRate) ||
+		change.LT(sdk.ZeroRat().Sub(param.MaxCommissionChangeRate)) {
+		return ErrCommissionChangeTooLarge()
+	}
+	validator.CommissionRate = rate
+	validator.CommissionUpdatedAt = now
+	return vm.storage.SetValidator(ctx, username, validator)
+}
+
+// GetCommissionRate - get commission rate of validator
+func (vm ValidatorManager) GetCommissionRate(
+	ctx sdk.Context, username types.AccountKey) (sdk.Rat, sdk.Error) {
+	validator, err := vm.storage.GetValidator(ctx, username)
+	if err != nil {
+		return sdk.ZeroRat(), err
+	}
+	return validator.CommissionRate, nil
+}
+
+// GetCommission - commission validator takes from its inflation
+func (vm ValidatorManager) GetCommission(
+	ctx sdk.Context, username types.AccountKey, inflation types.Coin) (types.Coin, sdk.Error) {
+	validator, err := vm.storage.GetValidator(ctx, username)
+	if err != nil {
+		return types.NewCoinFromInt64(0), err
+	}
+	return types.RatToCoin(inflation.ToRat().Mul(validator.CommissionRate)), nil
+}
+
 // RegisterValidator - register validator
 func (vm ValidatorManager) RegisterValidator(
 	ctx sdk.Context, username types.AccountKey, pubKey crypto.PubKey, coin types.Coin, link string) sdk.Error {
@@ -299,32 +827,102 @@ func (vm ValidatorManager) RegisterValidator(
 		return ErrInsufficientDeposit()
 	}
 
+	// double signing key can never be validator again
+	if vm.storage.IsTombstoned(ctx, pubKey.Address()) {
+		return ErrValidatorTombstoned()
+	}
+
 	// make sure the pub key has not been registered
 	lst, err := vm.GetValidatorList(ctx)
 	if err != nil {
 		return err
 	}
 
-	for _, validatorName := range lst.AllValidators {
+	isInUse, err := vm.isPubKeyInUse(ctx, lst.AllValidators, pubKey)
+	if err != nil {
+		return err
+	}
+	if isInUse {
+		return ErrValidatorPubKeyAlreadyExist()
+	}
+	curValidator := &model.Validator{
+		ABCIValidator:  abci.Validator{Address: pubKey.Address(), PubKey: tmtypes.TM2PB.PubKey(pubKey), Power: model.CoinToPower(coin)},
+		Username:       username,
+		Deposit:        coin,
+		Link:           link,
+		CommissionRate: sdk.ZeroRat(),
+	}
+
+	if err := vm.storage.SetValidator(ctx, username, curValidator); err != nil {
+		return err
+	}
+	return nil
+}
+
+// isPubKeyInUse - check if public key is used by any validator, including key
+// rotated in this block which is still in tendermint validator set
+func (vm ValidatorManager) isPubKeyInUse(
+	ctx sdk.Context, validators []types.AccountKey, pubKey crypto.PubKey) (bool, sdk.Error) {
+	abciPubKey := tmtypes.TM2PB.PubKey(pubKey)
+	for _, validatorName := range validators {
 		validator, err := vm.storage.GetValidator(ctx, validatorName)
 		if err != nil {
-			return err
+			return false, err
 		}
-		if reflect.DeepEqual(validator.ABCIValidator.PubKey, tmtypes.TM2PB.PubKey(pubKey)) {
-			return ErrValidatorPubKeyAlreadyExist()
+		if reflect.DeepEqual(validator.ABCIValidator.PubKey, abciPubKey) {
+			return true, nil
+		}
+		if validator.PreviousKey != nil && reflect.DeepEqual(validator.PreviousKey.PubKey, abciPubKey) {
+			return true, nil
 		}
 	}
-	curValidator := &model.Validator{
-		ABCIValidator: abci.Validator{Address: pubKey.Address(), PubKey: tmtypes.TM2PB.PubKey(pubKey), Power: 1000},
-		Username:      username,
-		Deposit:       coin,
-		Link:          link,
+	return false, nil
+}
+
+// UpdateValidator - update validator description
+func (vm ValidatorManager) UpdateValidator(
+	ctx sdk.Context, username types.AccountKey, description model.Description) sdk.Error {
+	validator, err := vm.storage.GetValidator(ctx, username)
+	if err != nil {
+		return err
 	}
+	validator.Description = description
+	return vm.storage.SetValidator(ctx, username, validator)
+}
 
-	if err := vm.storage.SetValidator(ctx, username, curValidator); err != nil {
+// RotateValidatorKey - change consensus key of validator. If tendermint knows
+// the current key, it's kept as previous key until the end of block when the
+// new key takes its place in validator set
+func (vm ValidatorManager) RotateValidatorKey(
+	ctx sdk.Context, username types.AccountKey, pubKey crypto.PubKey) sdk.Error {
+	validator, err := vm.storage.GetValidator(ctx, username)
+	if err != nil {
 		return err
 	}
-	return nil
+	if vm.storage.IsTombstoned(ctx, pubKey.Address()) {
+		return ErrValidatorTombstoned()
+	}
+	lst, err := vm.GetValidatorList(ctx)
+	if err != nil {
+		return err
+	}
+	isInUse, err := vm.isPubKeyInUse(ctx, lst.AllValidators, pubKey)
+	if err != nil {
+		return err
+	}
+	if isInUse {
+		return ErrValidatorPubKeyAlreadyExist()
+	}
+
+	// key rotated twice in a block never reaches tendermint
+	if validator.PreviousKey == nil &&
+		types.FindAccountInList(username, lst.PreBlockValidators) != -1 {
+		previousKey := validator.ABCIValidator
+		validator.PreviousKey = &previousKey
+	}
+	validator.ABCIValidator.Address = pubKey.Address()
+	validator.ABCIValidator.PubKey = tmtypes.TM2PB.PubKey(pubKey)
+	return vm.storage.SetValidator(ctx, username, validator)
 }
 
 // Deposit - deposit money to validator
@@ -380,6 +978,13 @@ func (vm ValidatorManager) TryBecomeOncallValidator(ctx sdk.Context, username ty
 	if err != nil {
 		return err
 	}
+	if vm.storage.IsTombstoned(ctx, curValidator.ABCIValidator.Address) {
+		return ErrValidatorTombstoned()
+	}
+	// jailed validator can't be oncall until unjailed
+	if curValidator.IsJailed {
+		return nil
+	}
 
 	param, err := vm.paramHolder.GetValidatorParam(ctx)
 	if err != nil {
@@ -454,6 +1059,16 @@ func (vm ValidatorManager) RemoveValidatorFromAllLists(ctx sdk.Context, username
 	return nil
 }
 
+// remove the user from oncall list only, it stays in all validators list
+func (vm ValidatorManager) removeValidatorFromOncallList(ctx sdk.Context, username types.AccountKey) sdk.Error {
+	lst, err := vm.storage.GetValidatorList(ctx)
+	if err != nil {
+		return err
+	}
+	lst.OncallValidators = remove(username, lst.OncallValidators)
+	return vm.storage.SetValidatorList(ctx, lst)
+}
+
 // if any change happens in oncall validator(remove, punish),
 // we should call this function to adjust validator list
 func (vm ValidatorManager) AdjustValidatorList(ctx sdk.Context) sdk.Error {
@@ -535,8 +1150,9 @@ func (vm ValidatorManager) getBestCandidate(ctx sdk.Context) (types.AccountKey,
 		if err != nil {
 			return bestCandidate, err
 		}
-		// not in the oncall list and has a larger power
-		if types.FindAccountInList(validatorName, lst.OncallValidators) == -1 &&
+		// not jailed, not in the oncall list and has a larger power
+		if !validator.IsJailed &&
+			types.FindAccountInList(validatorName, lst.OncallValidators) == -1 &&
 			validator.Deposit.IsGT(bestCandidatePower) {
 			bestCandidate = validator.Username
 			bestCandidatePower = validator.Deposit
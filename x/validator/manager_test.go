@@ -4,7 +4,9 @@ import (
 	"math/rand"
 	"strconv"
 	"testing"
+	"time"
 
+	"github.com/lino-network/lino/param"
 	"github.com/lino-network/lino/types"
 	"github.com/lino-network/lino/x/validator/model"
 	"github.com/stretchr/testify/assert"
@@ -39,17 +41,25 @@ func TestByzantines(t *testing.T) {
 		result := handler(ctx, msg)
 		assert.Equal(t, sdk.Result{}, result)
 	}
+	// validators are oncall from next block
+	_, err := valManager.GetUpdateValidatorList(ctx, voteManager)
+	assert.Nil(t, err)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
 
 	// byzantine
 	byzantineList := []int32{3, 8, 14}
 	byzantines := []abci.Evidence{}
 	for _, idx := range byzantineList {
-		byzantines = append(byzantines, abci.Evidence{Validator: abci.Validator{
-			Address: valKeys[idx].Address(),
-			PubKey:  tmtypes.TM2PB.PubKey(valKeys[idx]),
-			Power:   1000}})
+		byzantines = append(byzantines, abci.Evidence{
+			Validator: abci.Validator{
+				Address: valKeys[idx].Address(),
+				PubKey:  tmtypes.TM2PB.PubKey(valKeys[idx]),
+				Power:   1000},
+			Height: ctx.BlockHeight(),
+			Time:   ctx.BlockHeader().Time,
+		})
 	}
-	_, err := valManager.FireIncompetentValidator(ctx, byzantines)
+	_, err = valManager.FireIncompetentValidator(ctx, byzantines, voteManager)
 	assert.Nil(t, err)
 
 	validatorList3, _ := valManager.storage.GetValidatorList(ctx)
@@ -59,11 +69,12 @@ func TestByzantines(t *testing.T) {
 	for _, idx := range byzantineList {
 		assert.Equal(t, -1, types.FindAccountInList(users[idx], validatorList3.OncallValidators))
 		assert.Equal(t, -1, types.FindAccountInList(users[idx], validatorList3.AllValidators))
+		assert.True(t, valManager.IsTombstoned(ctx, valKeys[idx].Address()))
 	}
 
 }
 
-func TestAbsentValidatorWillBeFired(t *testing.T) {
+func TestAbsentValidatorWillBeJailed(t *testing.T) {
 	ctx, am, valManager, voteManager, gm := setupTest(t, 0)
 	handler := NewHandler(am, valManager, voteManager, gm)
 	valManager.InitGenesis(ctx)
@@ -127,8 +138,8 @@ func TestAbsentValidatorWillBeFired(t *testing.T) {
 	}
 
 	param, _ := valManager.paramHolder.GetValidatorParam(ctx)
-	// absent exceeds limitation
-	for i := int64(0); i < param.AbsentCommitLimitation; i++ {
+	// signed blocks in a full window is below minimum
+	for i := int64(0); i < param.SignedBlocksWindow; i++ {
 		err := valManager.UpdateSigningValidator(ctx, signingList)
 		assert.Nil(t, err)
 	}
@@ -137,25 +148,33 @@ func TestAbsentValidatorWillBeFired(t *testing.T) {
 	for i := 0; i < 21; i++ {
 		validator, _ := valManager.storage.GetValidator(ctx, types.AccountKey("user"+strconv.Itoa(i)))
 		if index < len(absentList) && i == absentList[index] {
-			assert.Equal(t, int64(601), validator.AbsentCommit)
+			assert.Equal(t, param.SignedBlocksWindow, validator.AbsentCommit)
 			assert.Equal(t, int64(0), validator.ProducedBlocks)
 			index++
 		} else {
 			assert.Equal(t, int64(0), validator.AbsentCommit)
-			assert.Equal(t, int64(601), validator.ProducedBlocks)
+			assert.Equal(t, param.SignedBlocksWindow+1, validator.ProducedBlocks)
 		}
 	}
 
-	_, err = valManager.FireIncompetentValidator(ctx, []abci.Evidence{})
+	_, err = valManager.FireIncompetentValidator(ctx, []abci.Evidence{}, voteManager)
 	assert.Nil(t, err)
 	validatorList2, _ := valManager.storage.GetValidatorList(ctx)
 
 	assert.Equal(t, 18, len(validatorList2.OncallValidators))
-	assert.Equal(t, 18, len(validatorList2.AllValidators))
+	assert.Equal(t, 21, len(validatorList2.AllValidators))
 
+	// jailed validators keep their remaining deposit even it's not enough
 	for _, idx := range absentList {
-		assert.Equal(t, -1, types.FindAccountInList(types.AccountKey("user"+strconv.Itoa(idx)), validatorList2.OncallValidators))
-		assert.Equal(t, -1, types.FindAccountInList(types.AccountKey("user"+strconv.Itoa(idx)), validatorList2.AllValidators))
+		username := types.AccountKey("user" + strconv.Itoa(idx))
+		assert.Equal(t, -1, types.FindAccountInList(username, validatorList2.OncallValidators))
+		assert.NotEqual(t, -1, types.FindAccountInList(username, validatorList2.AllValidators))
+
+		validator, _ := valManager.storage.GetValidator(ctx, username)
+		assert.True(t, validator.IsJailed)
+		assert.Equal(t, ctx.BlockHeader().Time.Unix()+param.JailDurationSec, validator.JailedUntil)
+		num := int64((idx+1)*10) + valParam.ValidatorMinCommittingDeposit.ToInt64()/types.Decimals - 200
+		assert.Equal(t, types.NewCoinFromInt64(num*types.Decimals), validator.Deposit)
 	}
 }
 
@@ -223,8 +242,8 @@ func TestAbsentValidatorWontBeFired(t *testing.T) {
 	}
 
 	param, _ := valManager.paramHolder.GetValidatorParam(ctx)
-	// absent exceeds limitation
-	for i := int64(0); i < param.AbsentCommitLimitation; i++ {
+	// signed blocks in a full window is below minimum
+	for i := int64(0); i < param.SignedBlocksWindow; i++ {
 		err := valManager.UpdateSigningValidator(ctx, signingList)
 		assert.Nil(t, err)
 	}
@@ -233,27 +252,28 @@ func TestAbsentValidatorWontBeFired(t *testing.T) {
 	for i := 0; i < 21; i++ {
 		validator, _ := valManager.storage.GetValidator(ctx, types.AccountKey("user"+strconv.Itoa(i)))
 		if index < len(absentList) && i == absentList[index] {
-			assert.Equal(t, int64(601), validator.AbsentCommit)
+			assert.Equal(t, param.SignedBlocksWindow, validator.AbsentCommit)
 			assert.Equal(t, int64(0), validator.ProducedBlocks)
 			index++
 		} else {
 			assert.Equal(t, int64(0), validator.AbsentCommit)
-			assert.Equal(t, int64(601), validator.ProducedBlocks)
+			assert.Equal(t, param.SignedBlocksWindow+1, validator.ProducedBlocks)
 		}
 	}
 
-	_, err = valManager.FireIncompetentValidator(ctx, []abci.Evidence{})
+	_, err = valManager.FireIncompetentValidator(ctx, []abci.Evidence{}, voteManager)
 	assert.Nil(t, err)
 	validatorList2, _ := valManager.storage.GetValidatorList(ctx)
 
-	assert.Equal(t, 21, len(validatorList2.OncallValidators))
+	assert.Equal(t, 18, len(validatorList2.OncallValidators))
 	assert.Equal(t, 21, len(validatorList2.AllValidators))
 
-	// check deposit has been deducted by 200
+	// check deposit has been deducted by 200 and validator is jailed
 	for _, v := range absentList {
 		validator, _ := valManager.storage.GetValidator(ctx, types.AccountKey("user"+strconv.Itoa(v)))
 
 		assert.Equal(t, int64(0), validator.AbsentCommit)
+		assert.True(t, validator.IsJailed)
 
 		num := int64((v+1)*1000) + valParam.ValidatorMinCommittingDeposit.ToInt64()/types.Decimals
 		num -= 200
@@ -293,6 +313,274 @@ func TestGetOncallList(t *testing.T) {
 
 }
 
+func TestDoubleSignEvidence(t *testing.T) {
+	ctx, am, valManager, voteManager, gm := setupTest(t, 0)
+	handler := NewHandler(am, valManager, voteManager, gm)
+	valManager.InitGenesis(ctx)
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(100000000, 0)})
+
+	valParam, _ := valManager.paramHolder.GetValidatorParam(ctx)
+	minBalance := types.NewCoinFromInt64(1 * types.Decimals)
+	valKeys := make([]crypto.PubKey, 2)
+	for i := 0; i < 2; i++ {
+		name := "user" + strconv.Itoa(i)
+		createTestAccount(ctx, am, name, minBalance.Plus(valParam.ValidatorMinCommittingDeposit))
+		voteManager.AddVoter(ctx, types.AccountKey(name), valParam.ValidatorMinVotingDeposit)
+		valKeys[i] = secp256k1.GenPrivKey().PubKey()
+		msg := NewValidatorDepositMsg(name, coinToString(valParam.ValidatorMinCommittingDeposit), valKeys[i], "")
+		result := handler(ctx, msg)
+		assert.Equal(t, sdk.Result{}, result)
+	}
+	// both validators are oncall from height 1
+	_, err := valManager.GetUpdateValidatorList(ctx, voteManager)
+	assert.Nil(t, err)
+
+	// user1 is jailed and rotated out at height 5
+	ctx = ctx.WithBlockHeight(5)
+	lst, _ := valManager.storage.GetValidatorList(ctx)
+	lst.PreBlockValidators = lst.OncallValidators
+	valManager.storage.SetValidatorList(ctx, lst)
+	_, err = valManager.PunishOncallValidator(ctx, "user1", valParam.PenaltyMissCommit, types.PunishAbsentCommit)
+	assert.Nil(t, err)
+	_, err = valManager.GetUpdateValidatorList(ctx, voteManager)
+	assert.Nil(t, err)
+	depositAfterJail := valParam.ValidatorMinCommittingDeposit.Minus(valParam.PenaltyMissCommit)
+
+	// delegation redelegated away from user1 after it double signed is slashed with it
+	redelegation := types.NewCoinFromInt64(100 * types.Decimals)
+	err = voteManager.AddDelegation(ctx, "user1", "delegator", redelegation)
+	assert.Nil(t, err)
+	err = voteManager.Redelegate(ctx.WithBlockHeight(4), "delegator", "user1", "user0", redelegation)
+	assert.Nil(t, err)
+	redelegationSlash := types.RatToCoin(redelegation.ToRat().Mul(valParam.SlashFractionByzantine))
+
+	ctx = ctx.WithBlockHeight(10)
+	now := ctx.BlockHeader().Time.Unix()
+	address := valKeys[1].Address()
+	testCases := []struct {
+		testName        string
+		height          int64
+		evidenceTime    int64
+		expectPenalty   types.Coin
+		expectDeposit   types.Coin
+		expectTombstone bool
+	}{
+		{
+			testName:        "evidence before validator oncall is ignored",
+			height:          0,
+			evidenceTime:    now,
+			expectPenalty:   types.NewCoinFromInt64(0),
+			expectDeposit:   depositAfterJail,
+			expectTombstone: false,
+		},
+		{
+			testName:        "evidence after validator rotated out is ignored",
+			height:          6,
+			evidenceTime:    now,
+			expectPenalty:   types.NewCoinFromInt64(0),
+			expectDeposit:   depositAfterJail,
+			expectTombstone: false,
+		},
+		{
+			testName:        "evidence older than max evidence age is ignored",
+			height:          3,
+			evidenceTime:    now - valParam.MaxEvidenceAgeSec - 1,
+			expectPenalty:   types.NewCoinFromInt64(0),
+			expectDeposit:   depositAfterJail,
+			expectTombstone: false,
+		},
+		{
+			testName:        "validator rotated out is slashed by evidence when it was oncall",
+			height:          3,
+			evidenceTime:    now,
+			expectPenalty:   types.RatToCoin(depositAfterJail.ToRat().Mul(valParam.SlashFractionByzantine)).Plus(redelegationSlash),
+			expectDeposit:   depositAfterJail.Minus(types.RatToCoin(depositAfterJail.ToRat().Mul(valParam.SlashFractionByzantine))),
+			expectTombstone: true,
+		},
+		{
+			testName:        "tombstoned validator won't be slashed again",
+			height:          4,
+			evidenceTime:    now,
+			expectPenalty:   types.NewCoinFromInt64(0),
+			expectDeposit:   depositAfterJail.Minus(types.RatToCoin(depositAfterJail.ToRat().Mul(valParam.SlashFractionByzantine))),
+			expectTombstone: true,
+		},
+	}
+	for _, tc := range testCases {
+		penalty, err := valManager.HandleDoubleSignEvidence(ctx, abci.Evidence{
+			Validator: abci.Validator{Address: address, PubKey: tmtypes.TM2PB.PubKey(valKeys[1])},
+			Height:    tc.height,
+			Time:      time.Unix(tc.evidenceTime, 0),
+		}, voteManager)
+		if err != nil {
+			t.Errorf("%s: failed to handle evidence, got err %v", tc.testName, err)
+		}
+		if !penalty.IsEqual(tc.expectPenalty) {
+			t.Errorf("%s: diff penalty, got %v, want %v", tc.testName, penalty, tc.expectPenalty)
+		}
+		deposit, _ := valManager.GetValidatorDeposit(ctx, "user1")
+		if !deposit.IsEqual(tc.expectDeposit) {
+			t.Errorf("%s: diff deposit, got %v, want %v", tc.testName, deposit, tc.expectDeposit)
+		}
+		if valManager.IsTombstoned(ctx, address) != tc.expectTombstone {
+			t.Errorf("%s: diff tombstone, got %v, want %v", tc.testName, !tc.expectTombstone, tc.expectTombstone)
+		}
+	}
+
+	evidence, err := valManager.storage.GetEvidence(ctx, address, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, types.AccountKey("user1"), evidence.Username)
+	votingPower, err := voteManager.GetVotingPower(ctx, "user0")
+	assert.Nil(t, err)
+	assert.True(t, votingPower.IsEqual(
+		valParam.ValidatorMinVotingDeposit.Plus(redelegation).Minus(redelegationSlash)))
+
+	// tombstoned validator can never rejoin
+	lst, _ = valManager.storage.GetValidatorList(ctx)
+	assert.Equal(t, -1, types.FindAccountInList("user1", lst.AllValidators))
+	assert.Equal(t, ErrValidatorTombstoned(), valManager.TryBecomeOncallValidator(ctx, "user1"))
+}
+
+func TestDoubleSignEvidenceSlashesUnbonding(t *testing.T) {
+	ctx, am, valManager, voteManager, gm := setupTest(t, 0)
+	handler := NewHandler(am, valManager, voteManager, gm)
+	valManager.InitGenesis(ctx)
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(100000000, 0)})
+
+	valParam, _ := valManager.paramHolder.GetValidatorParam(ctx)
+	minBalance := types.NewCoinFromInt64(1 * types.Decimals)
+	createTestAccount(ctx, am, "user1", minBalance.Plus(valParam.ValidatorMinCommittingDeposit))
+	voteManager.AddVoter(ctx, "user1", valParam.ValidatorMinVotingDeposit)
+	valKey := secp256k1.GenPrivKey().PubKey()
+	msg := NewValidatorDepositMsg("user1", coinToString(valParam.ValidatorMinCommittingDeposit), valKey, "")
+	result := handler(ctx, msg)
+	assert.Equal(t, sdk.Result{}, result)
+	_, err := valManager.GetUpdateValidatorList(ctx, voteManager)
+	assert.Nil(t, err)
+
+	// user1 revokes at height 5 after double signing at height 3
+	ctx = ctx.WithBlockHeight(5)
+	createdAt := ctx.BlockHeader().Time.Unix()
+	result = handler(ctx, NewValidatorRevokeMsg("user1"))
+	assert.Equal(t, sdk.Result{}, result)
+	unbondings, err := valManager.storage.GetUnbondings(ctx, "user1")
+	assert.Nil(t, err)
+	assert.Equal(t, []model.Unbonding{{
+		Amount:        valParam.ValidatorMinCommittingDeposit,
+		ReturnTimes:   valParam.ValidatorCoinReturnTimes,
+		CreatedHeight: 5,
+		CreatedAt:     createdAt,
+	}}, unbondings.Unbondings)
+
+	// no piece is returned before evidence window expires
+	events := gm.GetTimeEventListAtTime(ctx, createdAt+valParam.ValidatorCoinReturnIntervalSec)
+	assert.Nil(t, events)
+	events = gm.GetTimeEventListAtTime(ctx, createdAt+valParam.MaxEvidenceAgeSec)
+	assert.Equal(t, int(valParam.ValidatorCoinReturnTimes), len(events.Events))
+
+	ctx = ctx.WithBlockHeight(10)
+	penalty, err := valManager.HandleDoubleSignEvidence(ctx, abci.Evidence{
+		Validator: abci.Validator{Address: valKey.Address(), PubKey: tmtypes.TM2PB.PubKey(valKey)},
+		Height:    3,
+		Time:      ctx.BlockHeader().Time,
+	}, voteManager)
+	assert.Nil(t, err)
+	slash := types.RatToCoin(
+		valParam.ValidatorMinCommittingDeposit.ToRat().Mul(valParam.SlashFractionByzantine))
+	assert.True(t, slash.IsEqual(penalty))
+	assert.True(t, valManager.IsTombstoned(ctx, valKey.Address()))
+
+	// only the remaining deposit is returned
+	saving, _ := am.GetSavingFromBank(ctx, "user1")
+	for _, event := range events.Events {
+		err := event.(ReturnUnbondingEvent).Execute(ctx, valManager, am)
+		assert.Nil(t, err)
+	}
+	savingAfterReturn, _ := am.GetSavingFromBank(ctx, "user1")
+	assert.True(t, savingAfterReturn.IsEqual(saving.Plus(valParam.ValidatorMinCommittingDeposit.Minus(slash))))
+	unbondings, err = valManager.storage.GetUnbondings(ctx, "user1")
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(unbondings.Unbondings))
+}
+
+func TestSetCommissionRate(t *testing.T) {
+	ctx, am, valManager, voteManager, gm := setupTest(t, 0)
+	handler := NewHandler(am, valManager, voteManager, gm)
+	valManager.InitGenesis(ctx)
+	baseTime := int64(100000000)
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(baseTime, 0)})
+
+	valParam, _ := valManager.paramHolder.GetValidatorParam(ctx)
+	minBalance := types.NewCoinFromInt64(1 * types.Decimals)
+	createTestAccount(ctx, am, "user1", minBalance.Plus(valParam.ValidatorMinCommittingDeposit))
+	voteManager.AddVoter(ctx, "user1", valParam.ValidatorMinVotingDeposit)
+	msg := NewValidatorDepositMsg(
+		"user1", coinToString(valParam.ValidatorMinCommittingDeposit), secp256k1.GenPrivKey().PubKey(), "")
+	result := handler(ctx, msg)
+	assert.Equal(t, sdk.Result{}, result)
+
+	testCases := []struct {
+		testName     string
+		rate         sdk.Rat
+		atWhen       int64
+		expectErr    sdk.Error
+		expectedRate sdk.Rat
+	}{
+		{
+			testName:     "change larger than max change rate",
+			rate:         valParam.MaxCommissionChangeRate.Mul(sdk.NewRat(2)),
+			atWhen:       baseTime,
+			expectErr:    ErrCommissionChangeTooLarge(),
+			expectedRate: sdk.ZeroRat(),
+		},
+		{
+			testName:     "set commission rate",
+			rate:         valParam.MaxCommissionChangeRate,
+			atWhen:       baseTime,
+			expectErr:    nil,
+			expectedRate: valParam.MaxCommissionChangeRate,
+		},
+		{
+			testName:     "change commission rate again in a day",
+			rate:         sdk.ZeroRat(),
+			atWhen:       baseTime + 24*3600 - 1,
+			expectErr:    ErrCommissionChangeTooFrequent(baseTime),
+			expectedRate: valParam.MaxCommissionChangeRate,
+		},
+		{
+			testName:     "change commission rate after a day",
+			rate:         sdk.ZeroRat(),
+			atWhen:       baseTime + 24*3600,
+			expectErr:    nil,
+			expectedRate: sdk.ZeroRat(),
+		},
+		{
+			testName:     "rate higher than max commission rate",
+			rate:         valParam.MaxCommissionRate.Add(sdk.NewRat(1, 100)),
+			atWhen:       baseTime + 3*24*3600,
+			expectErr:    ErrCommissionRateTooHigh(),
+			expectedRate: sdk.ZeroRat(),
+		},
+	}
+	for _, tc := range testCases {
+		ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(tc.atWhen, 0)})
+		err := valManager.SetCommissionRate(ctx, "user1", tc.rate)
+		if !assert.Equal(t, tc.expectErr, err) {
+			t.Errorf("%s: diff err, got %v, want %v", tc.testName, err, tc.expectErr)
+		}
+		validator, _ := valManager.storage.GetValidator(ctx, "user1")
+		if !validator.CommissionRate.Equal(tc.expectedRate) {
+			t.Errorf("%s: diff rate, got %v, want %v", tc.testName, validator.CommissionRate, tc.expectedRate)
+		}
+	}
+
+	valManager.SetCommissionRate(ctx.WithBlockHeader(abci.Header{Time: time.Unix(baseTime+5*24*3600, 0)}),
+		"user1", sdk.NewRat(1, 100))
+	commission, err := valManager.GetCommission(ctx, "user1", types.NewCoinFromInt64(1000))
+	assert.Nil(t, err)
+	assert.Equal(t, types.NewCoinFromInt64(10), commission)
+}
+
 func TestPunishmentBasic(t *testing.T) {
 	ctx, am, valManager, voteManager, gm := setupTest(t, 0)
 	handler := NewHandler(am, valManager, voteManager, gm)
@@ -317,14 +605,17 @@ func TestPunishmentBasic(t *testing.T) {
 	handler(ctx, msg2)
 
 	// punish user2 as byzantine (explicitly remove)
-	valManager.PunishOncallValidator(ctx, types.AccountKey("user2"), valParam.PenaltyByzantine, types.PunishByzantine)
+	slash := types.RatToCoin(valParam.ValidatorMinCommittingDeposit.ToRat().Mul(valParam.SlashFractionByzantine))
+	valManager.PunishOncallValidator(ctx, types.AccountKey("user2"), slash, types.PunishByzantine)
 	lst, _ := valManager.storage.GetValidatorList(ctx)
 	assert.Equal(t, 1, len(lst.OncallValidators))
 	assert.Equal(t, 1, len(lst.AllValidators))
 	assert.Equal(t, types.AccountKey("user1"), lst.OncallValidators[0])
 
+	// remaining deposit of byzantine validator is kept for revoke
 	validator, _ := valManager.storage.GetValidator(ctx, "user2")
-	assert.Equal(t, true, validator.Deposit.IsZero())
+	assert.Equal(t, valParam.ValidatorMinCommittingDeposit.Minus(slash), validator.Deposit)
+	assert.Equal(t, int64(1), validator.ByzantineCommit)
 
 	// punish user1 as missing vote (wont explicitly remove)
 	valManager.PunishOncallValidator(ctx, types.AccountKey("user1"), valParam.PenaltyMissVote, types.PunishDidntVote)
@@ -336,6 +627,54 @@ func TestPunishmentBasic(t *testing.T) {
 	assert.Equal(t, true, validator2.Deposit.IsZero())
 }
 
+func TestValidatorLedger(t *testing.T) {
+	ctx, am, valManager, _, _ := setupTest(t, 10)
+	valManager.InitGenesis(ctx)
+	valParam, _ := valManager.paramHolder.GetValidatorParam(ctx)
+	user1 := createTestAccount(ctx, am, "user1", types.NewCoinFromInt64(1*types.Decimals))
+	valKey := secp256k1.GenPrivKey().PubKey()
+	valManager.RegisterValidator(ctx, user1, valKey, valParam.ValidatorMinCommittingDeposit, "")
+	valManager.TryBecomeOncallValidator(ctx, user1)
+
+	// rewards fill more than one bundle
+	reward := types.NewCoinFromInt64(100)
+	for i := 0; i < types.ValidatorLedgerBundleSize+10; i++ {
+		err := valManager.AddRewardToLedger(ctx, user1, reward)
+		assert.Nil(t, err)
+	}
+	ledger, _ := valManager.storage.GetLedger(ctx, user1, 0)
+	assert.Equal(t, types.ValidatorLedgerBundleSize, len(ledger.Entries))
+	assert.Equal(t, model.LedgerEntry{
+		Type:      types.ValidatorReward,
+		Amount:    reward,
+		Height:    10,
+		CreatedAt: ctx.BlockHeader().Time.Unix(),
+	}, ledger.Entries[0])
+
+	// slash with double sign evidence
+	evidence := abci.Evidence{
+		Validator: abci.Validator{Address: valKey.Address()},
+		Height:    8,
+	}
+	slash := types.NewCoinFromInt64(1000)
+	_, err := valManager.punishOncallValidator(ctx, user1, slash, types.PunishByzantine, &evidence)
+	assert.Nil(t, err)
+
+	meta, _ := valManager.storage.GetLedgerMeta(ctx, user1)
+	assert.Equal(t, int64(types.ValidatorLedgerBundleSize+11), meta.NumOfEntries)
+	ledger, _ = valManager.storage.GetLedger(ctx, user1, 1)
+	assert.Equal(t, 11, len(ledger.Entries))
+	assert.Equal(t, model.LedgerEntry{
+		Type:            types.ValidatorSlash,
+		PunishType:      types.PunishByzantine,
+		Amount:          slash,
+		Height:          10,
+		CreatedAt:       ctx.BlockHeader().Time.Unix(),
+		EvidenceHeight:  8,
+		EvidenceAddress: valKey.Address(),
+	}, ledger.Entries[10])
+}
+
 func TestPunishmentAndSubstitutionExists(t *testing.T) {
 	ctx, am, valManager, voteManager, gm := setupTest(t, 0)
 	handler := NewHandler(am, valManager, voteManager, gm)
@@ -379,7 +718,7 @@ func TestPunishmentAndSubstitutionExists(t *testing.T) {
 }
 
 func TestGetUpdateValidatorList(t *testing.T) {
-	ctx, am, valManager, _, _ := setupTest(t, 0)
+	ctx, am, valManager, voteManager, _ := setupTest(t, 0)
 	valManager.InitGenesis(ctx)
 
 	minBalance := types.NewCoinFromInt64(100 * types.Decimals)
@@ -458,7 +797,7 @@ func TestGetUpdateValidatorList(t *testing.T) {
 			t.Errorf("%s: failed to set validator list, got err %v", tc.testName, err)
 		}
 
-		actualList, err := valManager.GetUpdateValidatorList(ctx)
+		actualList, err := valManager.GetUpdateValidatorList(ctx, voteManager)
 		if err != nil {
 			t.Errorf("%s: failed to get validator list, got err %v", tc.testName, err)
 		}
@@ -468,6 +807,178 @@ func TestGetUpdateValidatorList(t *testing.T) {
 	}
 }
 
+func TestValidatorPowerChange(t *testing.T) {
+	ctx, am, valManager, voteManager, _ := setupTest(t, 0)
+	valManager.InitGenesis(ctx)
+	param, _ := valManager.paramHolder.GetValidatorParam(ctx)
+	minBalance := types.NewCoinFromInt64(100 * types.Decimals)
+
+	user1 := createTestAccount(ctx, am, "user1", minBalance)
+	voteManager.AddVoter(ctx, user1, param.ValidatorMinVotingDeposit)
+	valKey := secp256k1.GenPrivKey().PubKey()
+	valManager.RegisterValidator(ctx, user1, valKey, param.ValidatorMinCommittingDeposit, "")
+
+	// validator registered with power of committing deposit
+	power, err := valManager.GetValidatorPower(ctx, user1)
+	assert.Nil(t, err)
+	assert.Equal(t, param.ValidatorMinCommittingDeposit.ToInt64()/types.Decimals, power)
+
+	// new oncall validator gets power of committing deposit and voting power immediately
+	lst := &model.ValidatorList{OncallValidators: []types.AccountKey{user1}}
+	valManager.storage.SetValidatorList(ctx, lst)
+	actualList, err := valManager.GetUpdateValidatorList(ctx, voteManager)
+	assert.Nil(t, err)
+	expectPower := param.ValidatorMinCommittingDeposit.Plus(param.ValidatorMinVotingDeposit).ToInt64() / types.Decimals
+	assert.Equal(t, 1, len(actualList))
+	assert.Equal(t, expectPower, actualList[0].Power)
+
+	// delegation increases power of validator already oncall gradually
+	lst.PreBlockValidators = []types.AccountKey{user1}
+	valManager.storage.SetValidatorList(ctx, lst)
+	delegation := types.NewCoinFromInt64(10 * expectPower * types.Decimals)
+	voteManager.AddDelegation(ctx, user1, "delegator", delegation)
+	targetPower := expectPower + delegation.ToInt64()/types.Decimals
+	for expectPower != targetPower {
+		maxChange := types.RatToCoin(sdk.NewRat(expectPower).Mul(param.MaxPowerChangeRate)).ToInt64()
+		expectPower = expectPower + maxChange
+		if expectPower > targetPower {
+			expectPower = targetPower
+		}
+		actualList, err = valManager.GetUpdateValidatorList(ctx, voteManager)
+		assert.Nil(t, err)
+		assert.Equal(t, expectPower, actualList[0].Power)
+		power, _ := valManager.GetValidatorPower(ctx, user1)
+		assert.Equal(t, expectPower, power)
+	}
+}
+
+func TestRotateValidatorKey(t *testing.T) {
+	ctx, am, valManager, voteManager, _ := setupTest(t, 1)
+	valManager.InitGenesis(ctx)
+	param, _ := valManager.paramHolder.GetValidatorParam(ctx)
+	minBalance := types.NewCoinFromInt64(100 * types.Decimals)
+
+	user1 := createTestAccount(ctx, am, "user1", minBalance)
+	user2 := createTestAccount(ctx, am, "user2", minBalance)
+	valKey1 := secp256k1.GenPrivKey().PubKey()
+	valKey2 := secp256k1.GenPrivKey().PubKey()
+	valManager.RegisterValidator(ctx, user1, valKey1, param.ValidatorMinCommittingDeposit, "")
+	valManager.RegisterValidator(ctx, user2, valKey2, param.ValidatorMinCommittingDeposit, "")
+	lst, _ := valManager.storage.GetValidatorList(ctx)
+	lst.AllValidators = []types.AccountKey{user1, user2}
+	lst.OncallValidators = []types.AccountKey{user1}
+	valManager.storage.SetValidatorList(ctx, lst)
+	_, err := valManager.GetUpdateValidatorList(ctx, voteManager)
+	assert.Nil(t, err)
+	power, _ := valManager.GetValidatorPower(ctx, user1)
+
+	// key used by other validator can't be used
+	err = valManager.RotateValidatorKey(ctx, user1, valKey2)
+	assert.Equal(t, ErrValidatorPubKeyAlreadyExist(), err)
+
+	// tombstoned key can't be used
+	tombstonedKey := secp256k1.GenPrivKey().PubKey()
+	valManager.storage.SetTombstone(ctx, tombstonedKey.Address(), &model.Tombstone{Username: "byzantine"})
+	err = valManager.RotateValidatorKey(ctx, user1, tombstonedKey)
+	assert.Equal(t, ErrValidatorTombstoned(), err)
+
+	// key of validator not in tendermint validator set is changed immediately
+	newValKey2 := secp256k1.GenPrivKey().PubKey()
+	err = valManager.RotateValidatorKey(ctx, user2, newValKey2)
+	assert.Nil(t, err)
+	val2, _ := valManager.storage.GetValidator(ctx, user2)
+	assert.Nil(t, val2.PreviousKey)
+	assert.Equal(t, tmtypes.TM2PB.PubKey(newValKey2), val2.ABCIValidator.PubKey)
+
+	// oncall validator rotates key twice in a block, previous key tendermint
+	// knows is replaced by the last key at the end of block
+	ctx = ctx.WithBlockHeight(5)
+	lst, _ = valManager.storage.GetValidatorList(ctx)
+	lst.PreBlockValidators = lst.OncallValidators
+	valManager.storage.SetValidatorList(ctx, lst)
+	err = valManager.RotateValidatorKey(ctx, user1, secp256k1.GenPrivKey().PubKey())
+	assert.Nil(t, err)
+	newValKey1 := secp256k1.GenPrivKey().PubKey()
+	err = valManager.RotateValidatorKey(ctx, user1, newValKey1)
+	assert.Nil(t, err)
+	// previous key is still in use until end of block
+	err = valManager.RotateValidatorKey(ctx, user2, valKey1)
+	assert.Equal(t, ErrValidatorPubKeyAlreadyExist(), err)
+
+	actualList, err := valManager.GetUpdateValidatorList(ctx, voteManager)
+	assert.Nil(t, err)
+	expectList := []abci.Validator{
+		{Address: valKey1.Address(), PubKey: tmtypes.TM2PB.PubKey(valKey1), Power: 0},
+		{Address: newValKey1.Address(), PubKey: tmtypes.TM2PB.PubKey(newValKey1), Power: power},
+	}
+	assert.Equal(t, expectList, actualList)
+
+	// validator stays oncall, new key is oncall from next block
+	lst, _ = valManager.storage.GetValidatorList(ctx)
+	assert.Equal(t, []types.AccountKey{user1}, lst.OncallValidators)
+	val1, _ := valManager.storage.GetValidator(ctx, user1)
+	assert.Nil(t, val1.PreviousKey)
+	history, _ := valManager.storage.GetOncallHistory(ctx, valKey1.Address())
+	assert.Equal(t, []model.OncallPeriod{
+		{StartHeight: 2, EndHeight: 5, EndAt: ctx.BlockHeader().Time.Unix()}}, history.Periods)
+	history, _ = valManager.storage.GetOncallHistory(ctx, newValKey1.Address())
+	assert.Equal(t, user1, history.Username)
+	assert.Equal(t, []model.OncallPeriod{{StartHeight: 6}}, history.Periods)
+}
+
+func TestCapPowerChange(t *testing.T) {
+	testCases := []struct {
+		testName      string
+		prePower      int64
+		power         int64
+		maxChangeRate sdk.Rat
+		expectPower   int64
+	}{
+		{
+			testName:      "change within limit",
+			prePower:      1000,
+			power:         1050,
+			maxChangeRate: sdk.NewRat(1, 10),
+			expectPower:   1050,
+		},
+		{
+			testName:      "increase over limit",
+			prePower:      1000,
+			power:         2000,
+			maxChangeRate: sdk.NewRat(1, 10),
+			expectPower:   1100,
+		},
+		{
+			testName:      "decrease over limit",
+			prePower:      1000,
+			power:         10,
+			maxChangeRate: sdk.NewRat(1, 10),
+			expectPower:   900,
+		},
+		{
+			testName:      "at least one power can be changed",
+			prePower:      5,
+			power:         10,
+			maxChangeRate: sdk.NewRat(1, 10),
+			expectPower:   6,
+		},
+		{
+			testName:      "no previous power",
+			prePower:      0,
+			power:         10,
+			maxChangeRate: sdk.NewRat(1, 10),
+			expectPower:   10,
+		},
+	}
+
+	for _, tc := range testCases {
+		power := capPowerChange(tc.prePower, tc.power, tc.maxChangeRate)
+		if power != tc.expectPower {
+			t.Errorf("%s: diff power, got %v, want %v", tc.testName, power, tc.expectPower)
+		}
+	}
+}
+
 func TestIsLegalWithdraw(t *testing.T) {
 	ctx, am, valManager, _, _ := setupTest(t, 0)
 	minBalance := types.NewCoinFromInt64(100 * types.Decimals)
@@ -531,3 +1042,42 @@ func TestIsLegalWithdraw(t *testing.T) {
 		}
 	}
 }
+
+func TestSigningWindow(t *testing.T) {
+	valParam := &param.ValidatorParam{SignedBlocksWindow: 10, MinSignedPerWindow: sdk.NewRat(1, 2)}
+	validator := &model.Validator{}
+
+	// window is not full
+	for i := 0; i < 3; i++ {
+		recordSigning(validator, false, valParam.SignedBlocksWindow)
+	}
+	assert.Equal(t, int64(3), validator.AbsentCommit)
+	assert.False(t, isBelowMinSigned(validator, valParam))
+
+	// only sign one of every three blocks
+	validator = &model.Validator{}
+	expectBitmap := []bool{}
+	for i := 0; i < 10; i++ {
+		recordSigning(validator, i%3 == 0, valParam.SignedBlocksWindow)
+		expectBitmap = append(expectBitmap, i%3 == 0)
+	}
+	assert.Equal(t, int64(6), validator.AbsentCommit)
+	assert.Equal(t, int64(4), validator.ProducedBlocks)
+	assert.Equal(t, expectBitmap, GetSigningBitmap(*validator))
+	assert.True(t, isBelowMinSigned(validator, valParam))
+
+	// window slides, the oldest blocks are dropped
+	for i := 0; i < 5; i++ {
+		recordSigning(validator, true, valParam.SignedBlocksWindow)
+		expectBitmap = append(expectBitmap, true)
+	}
+	assert.Equal(t, int64(3), validator.AbsentCommit)
+	assert.Equal(t, expectBitmap[5:], GetSigningBitmap(*validator))
+	assert.False(t, isBelowMinSigned(validator, valParam))
+
+	// window is reset if window size changes
+	recordSigning(validator, false, 20)
+	assert.Equal(t, int64(1), validator.AbsentCommit)
+	assert.Equal(t, []bool{false}, GetSigningBitmap(*validator))
+	assert.False(t, isBelowMinSigned(validator, valParam))
+}
@@ -25,6 +25,30 @@ func ErrFailedToMarshalValidatorList(err error) sdk.Error {
 	return types.NewError(types.CodeFailedToMarshalValidatorList, fmt.Sprintf("failed to marshal validator list: %s", err.Error()))
 }
 
+func ErrFailedToMarshalOncallHistory(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalOncallHistory, fmt.Sprintf("failed to marshal oncall history: %s", err.Error()))
+}
+
+func ErrFailedToMarshalEvidence(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalEvidence, fmt.Sprintf("failed to marshal evidence: %s", err.Error()))
+}
+
+func ErrFailedToMarshalTombstone(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalTombstone, fmt.Sprintf("failed to marshal tombstone: %s", err.Error()))
+}
+
+func ErrFailedToMarshalLedger(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalLedger, fmt.Sprintf("failed to marshal ledger: %s", err.Error()))
+}
+
+func ErrFailedToMarshalLedgerMeta(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalLedgerMeta, fmt.Sprintf("failed to marshal ledger meta: %s", err.Error()))
+}
+
+func ErrFailedToMarshalUnbondings(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalUnbondings, fmt.Sprintf("failed to marshal unbondings: %s", err.Error()))
+}
+
 // unmarshal error
 func ErrFailedToUnmarshalValidator(err error) sdk.Error {
 	return types.NewError(types.CodeFailedToUnmarshalValidator, fmt.Sprintf("failed to unmarshal validator: %s", err.Error()))
@@ -33,3 +57,27 @@ func ErrFailedToUnmarshalValidator(err error) sdk.Error {
 func ErrFailedToUnmarshalValidatorList(err error) sdk.Error {
 	return types.NewError(types.CodeFailedToUnmarshalValidatorList, fmt.Sprintf("failed to unmarshal validator list: %s", err.Error()))
 }
+
+func ErrFailedToUnmarshalOncallHistory(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalOncallHistory, fmt.Sprintf("failed to unmarshal oncall history: %s", err.Error()))
+}
+
+func ErrFailedToUnmarshalEvidence(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalEvidence, fmt.Sprintf("failed to unmarshal evidence: %s", err.Error()))
+}
+
+func ErrFailedToUnmarshalTombstone(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalTombstone, fmt.Sprintf("failed to unmarshal tombstone: %s", err.Error()))
+}
+
+func ErrFailedToUnmarshalLedger(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalLedger, fmt.Sprintf("failed to unmarshal ledger: %s", err.Error()))
+}
+
+func ErrFailedToUnmarshalLedgerMeta(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalLedgerMeta, fmt.Sprintf("failed to unmarshal ledger meta: %s", err.Error()))
+}
+
+func ErrFailedToUnmarshalUnbondings(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalUnbondings, fmt.Sprintf("failed to unmarshal unbondings: %s", err.Error()))
+}
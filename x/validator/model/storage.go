@@ -1,6 +1,8 @@
 package model
 
 import (
+	"strconv"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/wire"
 	"github.com/lino-network/lino/types"
@@ -9,6 +11,12 @@ import (
 var (
 	validatorSubstore     = []byte{0x00}
 	validatorListSubstore = []byte{0x01}
+	oncallHistorySubstore = []byte{0x02}
+	tombstoneSubstore     = []byte{0x03}
+	evidenceSubstore      = []byte{0x04}
+	ledgerSubstore        = []byte{0x05}
+	ledgerMetaSubstore    = []byte{0x06}
+	unbondingSubstore     = []byte{0x07}
 )
 
 type ValidatorStorage struct {
@@ -94,6 +102,169 @@ func (vs ValidatorStorage) SetValidatorList(ctx sdk.Context, lst *ValidatorList)
 	return nil
 }
 
+// GetOncallHistory - returns nil if the key has never been oncall
+func (vs ValidatorStorage) GetOncallHistory(ctx sdk.Context, address []byte) (*OncallHistory, sdk.Error) {
+	store := ctx.KVStore(vs.key)
+	historyByte := store.Get(GetOncallHistoryKey(address))
+	if historyByte == nil {
+		return nil, nil
+	}
+	history := new(OncallHistory)
+	if err := vs.cdc.UnmarshalJSON(historyByte, history); err != nil {
+		return nil, ErrFailedToUnmarshalOncallHistory(err)
+	}
+	return history, nil
+}
+
+func (vs ValidatorStorage) SetOncallHistory(ctx sdk.Context, address []byte, history *OncallHistory) sdk.Error {
+	store := ctx.KVStore(vs.key)
+	historyByte, err := vs.cdc.MarshalJSON(*history)
+	if err != nil {
+		return ErrFailedToMarshalOncallHistory(err)
+	}
+	store.Set(GetOncallHistoryKey(address), historyByte)
+	return nil
+}
+
+func (vs ValidatorStorage) DeleteOncallHistory(ctx sdk.Context, address []byte) sdk.Error {
+	store := ctx.KVStore(vs.key)
+	store.Delete(GetOncallHistoryKey(address))
+	return nil
+}
+
+// GetTombstone - returns nil if the key is not tombstoned
+func (vs ValidatorStorage) GetTombstone(ctx sdk.Context, address []byte) (*Tombstone, sdk.Error) {
+	store := ctx.KVStore(vs.key)
+	tombstoneByte := store.Get(GetTombstoneKey(address))
+	if tombstoneByte == nil {
+		return nil, nil
+	}
+	tombstone := new(Tombstone)
+	if err := vs.cdc.UnmarshalJSON(tombstoneByte, tombstone); err != nil {
+		return nil, ErrFailedToUnmarshalTombstone(err)
+	}
+	return tombstone, nil
+}
+
+func (vs ValidatorStorage) SetTombstone(ctx sdk.Context, address []byte, tombstone *Tombstone) sdk.Error {
+	store := ctx.KVStore(vs.key)
+	tombstoneByte, err := vs.cdc.MarshalJSON(*tombstone)
+	if err != nil {
+		return ErrFailedToMarshalTombstone(err)
+	}
+	store.Set(GetTombstoneKey(address), tombstoneByte)
+	return nil
+}
+
+func (vs ValidatorStorage) IsTombstoned(ctx sdk.Context, address []byte) bool {
+	store := ctx.KVStore(vs.key)
+	return store.Has(GetTombstoneKey(address))
+}
+
+// GetEvidence - returns nil if no evidence of the key at the height
+func (vs ValidatorStorage) GetEvidence(ctx sdk.Context, address []byte, height int64) (*Evidence, sdk.Error) {
+	store := ctx.KVStore(vs.key)
+	evidenceByte := store.Get(GetEvidenceKey(address, height))
+	if evidenceByte == nil {
+		return nil, nil
+	}
+	evidence := new(Evidence)
+	if err := vs.cdc.UnmarshalJSON(evidenceByte, evidence); err != nil {
+		return nil, ErrFailedToUnmarshalEvidence(err)
+	}
+	return evidence, nil
+}
+
+func (vs ValidatorStorage) SetEvidence(ctx sdk.Context, evidence *Evidence) sdk.Error {
+	store := ctx.KVStore(vs.key)
+	evidenceByte, err := vs.cdc.MarshalJSON(*evidence)
+	if err != nil {
+		return ErrFailedToMarshalEvidence(err)
+	}
+	store.Set(GetEvidenceKey(evidence.Address, evidence.Height), evidenceByte)
+	return nil
+}
+
+// GetLedger - returns nil if the bundle doesn't exist
+func (vs ValidatorStorage) GetLedger(
+	ctx sdk.Context, username types.AccountKey, bundleSlot int64) (*Ledger, sdk.Error) {
+	store := ctx.KVStore(vs.key)
+	ledgerByte := store.Get(GetLedgerKey(username, bundleSlot))
+	if ledgerByte == nil {
+		return nil, nil
+	}
+	ledger := new(Ledger)
+	if err := vs.cdc.UnmarshalJSON(ledgerByte, ledger); err != nil {
+		return nil, ErrFailedToUnmarshalLedger(err)
+	}
+	return ledger, nil
+}
+
+func (vs ValidatorStorage) SetLedger(
+	ctx sdk.Context, username types.AccountKey, bundleSlot int64, ledger *Ledger) sdk.Error {
+	store := ctx.KVStore(vs.key)
+	ledgerByte, err := vs.cdc.MarshalJSON(*ledger)
+	if err != nil {
+		return ErrFailedToMarshalLedger(err)
+	}
+	store.Set(GetLedgerKey(username, bundleSlot), ledgerByte)
+	return nil
+}
+
+// GetLedgerMeta - returns empty meta if validator has no ledger entry
+func (vs ValidatorStorage) GetLedgerMeta(ctx sdk.Context, username types.AccountKey) (*LedgerMeta, sdk.Error) {
+	store := ctx.KVStore(vs.key)
+	metaByte := store.Get(GetLedgerMetaKey(username))
+	if metaByte == nil {
+		return &LedgerMeta{}, nil
+	}
+	meta := new(LedgerMeta)
+	if err := vs.cdc.UnmarshalJSON(metaByte, meta); err != nil {
+		return nil, ErrFailedToUnmarshalLedgerMeta(err)
+	}
+	return meta, nil
+}
+
+func (vs ValidatorStorage) SetLedgerMeta(ctx sdk.Context, username types.AccountKey, meta *LedgerMeta) sdk.Error {
+	store := ctx.KVStore(vs.key)
+	metaByte, err := vs.cdc.MarshalJSON(*meta)
+	if err != nil {
+		return ErrFailedToMarshalLedgerMeta(err)
+	}
+	store.Set(GetLedgerMetaKey(username), metaByte)
+	return nil
+}
+
+// GetUnbondings - returns empty unbondings if validator has no unbonding
+func (vs ValidatorStorage) GetUnbondings(ctx sdk.Context, username types.AccountKey) (*Unbondings, sdk.Error) {
+	store := ctx.KVStore(vs.key)
+	unbondingsByte := store.Get(GetUnbondingKey(username))
+	if unbondingsByte == nil {
+		return &Unbondings{}, nil
+	}
+	unbondings := new(Unbondings)
+	if err := vs.cdc.UnmarshalJSON(unbondingsByte, unbondings); err != nil {
+		return nil, ErrFailedToUnmarshalUnbondings(err)
+	}
+	return unbondings, nil
+}
+
+// SetUnbondings - unbondings are deleted if all of them are returned
+func (vs ValidatorStorage) SetUnbondings(
+	ctx sdk.Context, username types.AccountKey, unbondings *Unbondings) sdk.Error {
+	store := ctx.KVStore(vs.key)
+	if len(unbondings.Unbondings) == 0 {
+		store.Delete(GetUnbondingKey(username))
+		return nil
+	}
+	unbondingsByte, err := vs.cdc.MarshalJSON(*unbondings)
+	if err != nil {
+		return ErrFailedToMarshalUnbondings(err)
+	}
+	store.Set(GetUnbondingKey(username), unbondingsByte)
+	return nil
+}
+
 func GetValidatorKey(accKey types.AccountKey) []byte {
 	return append(validatorSubstore, accKey...)
 }
@@ -101,3 +272,35 @@ func GetValidatorKey(accKey types.AccountKey) []byte {
 func GetValidatorListKey() []byte {
 	return validatorListSubstore
 }
+
+func GetOncallHistoryKey(address []byte) []byte {
+	return append(oncallHistorySubstore, address...)
+}
+
+func GetTombstoneKey(address []byte) []byte {
+	return append(tombstoneSubstore, address...)
+}
+
+// GetEvidencePrefix - "evidence substore" + "address"
+func GetEvidencePrefix(address []byte) []byte {
+	return append(append(evidenceSubstore, address...), types.KeySeparator...)
+}
+
+// GetEvidenceKey - "evidence substore" + "address" + "height"
+func GetEvidenceKey(address []byte, height int64) []byte {
+	return append(GetEvidencePrefix(address), strconv.FormatInt(height, 10)...)
+}
+
+// GetLedgerKey - "ledger substore" + "username" + "bundle slot"
+func GetLedgerKey(username types.AccountKey, bundleSlot int64) []byte {
+	return append(append(append(ledgerSubstore, username...), types.KeySeparator...), strconv.FormatInt(bundleSlot, 10)...)
+}
+
+func GetLedgerMetaKey(username types.AccountKey) []byte {
+	return append(ledgerMetaSubstore, username...)
+}
+
+// GetUnbondingKey - "unbonding substore" + "username"
+func GetUnbondingKey(username types.AccountKey) []byte {
+	return append(unbondingSubstore, username...)
+}
@@ -66,8 +66,9 @@ func TestValidator(t *testing.T) {
 				Address: priv.PubKey().Address(),
 				PubKey:  tmtypes.TM2PB.PubKey(priv.PubKey()),
 				Power:   1000},
-			Username: tc.user,
-			Deposit:  tc.deposit,
+			Username:       tc.user,
+			Deposit:        tc.deposit,
+			CommissionRate: sdk.NewRat(1, 10),
 		}
 		err := vs.SetValidator(ctx, tc.user, &validator)
 		if err != nil {
@@ -121,3 +122,109 @@ func TestValidatorList(t *testing.T) {
 		}
 	}
 }
+
+func TestOncallHistory(t *testing.T) {
+	ctx, vs := setup(t)
+	address := secp256k1.GenPrivKey().PubKey().Address()
+
+	history, err := vs.GetOncallHistory(ctx, address)
+	assert.Nil(t, err)
+	assert.Nil(t, history)
+
+	expect := OncallHistory{
+		Username: types.AccountKey("user"),
+		Periods: []OncallPeriod{
+			{StartHeight: 1, EndHeight: 10, EndAt: 100},
+			{StartHeight: 20},
+		},
+	}
+	err = vs.SetOncallHistory(ctx, address, &expect)
+	assert.Nil(t, err)
+	history, err = vs.GetOncallHistory(ctx, address)
+	assert.Nil(t, err)
+	assert.Equal(t, expect, *history)
+
+	err = vs.DeleteOncallHistory(ctx, address)
+	assert.Nil(t, err)
+	history, err = vs.GetOncallHistory(ctx, address)
+	assert.Nil(t, err)
+	assert.Nil(t, history)
+}
+
+func TestEvidenceAndTombstone(t *testing.T) {
+	ctx, vs := setup(t)
+	address := secp256k1.GenPrivKey().PubKey().Address()
+
+	evidence, err := vs.GetEvidence(ctx, address, 10)
+	assert.Nil(t, err)
+	assert.Nil(t, evidence)
+	assert.False(t, vs.IsTombstoned(ctx, address))
+
+	expectEvidence := Evidence{
+		Username: types.AccountKey("user"),
+		Address:  address,
+		Height:   10,
+		Time:     100,
+		Penalty:  types.NewCoinFromInt64(1000),
+	}
+	err = vs.SetEvidence(ctx, &expectEvidence)
+	assert.Nil(t, err)
+	evidence, err = vs.GetEvidence(ctx, address, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, expectEvidence, *evidence)
+	evidence, err = vs.GetEvidence(ctx, address, 11)
+	assert.Nil(t, err)
+	assert.Nil(t, evidence)
+
+	expectTombstone := Tombstone{Username: types.AccountKey("user"), Height: 10, Time: 200}
+	err = vs.SetTombstone(ctx, address, &expectTombstone)
+	assert.Nil(t, err)
+	assert.True(t, vs.IsTombstoned(ctx, address))
+	tombstone, err := vs.GetTombstone(ctx, address)
+	assert.Nil(t, err)
+	assert.Equal(t, expectTombstone, *tombstone)
+}
+
+func TestLedger(t *testing.T) {
+	ctx, vs := setup(t)
+	user := types.AccountKey("user")
+
+	ledger, err := vs.GetLedger(ctx, user, 0)
+	assert.Nil(t, err)
+	assert.Nil(t, ledger)
+	meta, err := vs.GetLedgerMeta(ctx, user)
+	assert.Nil(t, err)
+	assert.Equal(t, LedgerMeta{}, *meta)
+
+	expectLedger := Ledger{Entries: []LedgerEntry{
+		{
+			Type:      types.ValidatorReward,
+			Amount:    types.NewCoinFromInt64(100),
+			Height:    10,
+			CreatedAt: 100,
+		},
+		{
+			Type:            types.ValidatorSlash,
+			PunishType:      types.PunishByzantine,
+			Amount:          types.NewCoinFromInt64(1000),
+			Height:          11,
+			CreatedAt:       110,
+			EvidenceHeight:  9,
+			EvidenceAddress: secp256k1.GenPrivKey().PubKey().Address(),
+		},
+	}}
+	err = vs.SetLedger(ctx, user, 1, &expectLedger)
+	assert.Nil(t, err)
+	ledger, err = vs.GetLedger(ctx, user, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, expectLedger, *ledger)
+	ledger, err = vs.GetLedger(ctx, user, 0)
+	assert.Nil(t, err)
+	assert.Nil(t, ledger)
+
+	err = vs.SetLedgerMeta(ctx, user, &LedgerMeta{NumOfEntries: 102})
+	assert.Nil(t, err)
+	meta, err = vs.GetLedgerMeta(ctx, user)
+	assert.Nil(t, err)
+	assert.Equal(t, LedgerMeta{NumOfEntries: 102}, *meta)
+}
@@ -1,11 +1,23 @@
 package model
 
 import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lino-network/lino/param"
 	types "github.com/lino-network/lino/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 )
 
-// Validator is basic structure records all validator information
+// Validator is basic structure records all validator information,
+// jailed validator stays registered but can't be oncall until unjailed.
+// Signing of recent blocks is tracked in a window of SigningWindow blocks,
+// bit of a block in MissedBlocksBitmap is set if validator missed it and
+// AbsentCommit is the number of missed blocks in the window.
+// CommissionRate of validator inflation is kept by validator, the rest is
+// shared with delegators of its voter account.
+// PreviousKey is the key tendermint knows before ABCIValidator key is rotated
+// in this block, it's replaced in validator set at the end of block.
 type Validator struct {
 	ABCIValidator   abci.Validator
 	Username        types.AccountKey `json:"username"`
@@ -14,6 +26,94 @@ type Validator struct {
 	ByzantineCommit int64            `json:"byzantine_commit"`
 	ProducedBlocks  int64            `json:"produced_blocks"`
 	Link            string           `json:"link"`
+	IsJailed        bool             `json:"is_jailed"`
+	JailedUntil     int64            `json:"jailed_until"`
+	SigningWindow   int64            `json:"signing_window"`
+	SigningIndex    int64            `json:"signing_index"`
+	MissedBlocks    []byte           `json:"missed_blocks_bitmap"`
+	CommissionRate  sdk.Rat          `json:"commission_rate"`
+	// CommissionUpdatedAt - unix time of last commission rate change
+	CommissionUpdatedAt int64           `json:"commission_updated_at"`
+	Description         Description     `json:"description"`
+	PreviousKey         *abci.Validator `json:"previous_key"`
+}
+
+// Description - validator information shown to delegators
+type Description struct {
+	Moniker string `json:"moniker"`
+	Website string `json:"website"`
+	Details string `json:"details"`
+	Contact string `json:"contact"`
+}
+
+// OncallPeriod - blocks from StartHeight to EndHeight a validator key is oncall,
+// EndHeight is 0 if the key is still oncall
+type OncallPeriod struct {
+	StartHeight int64 `json:"start_height"`
+	EndHeight   int64 `json:"end_height"`
+	EndAt       int64 `json:"end_at"`
+}
+
+// OncallHistory - oncall periods of a validator key, kept after validator revoked
+// so evidence about validators already rotated out can still be checked
+type OncallHistory struct {
+	Username types.AccountKey `json:"username"`
+	Periods  []OncallPeriod   `json:"periods"`
+}
+
+// Evidence - double sign evidence which has been handled
+type Evidence struct {
+	Username types.AccountKey `json:"username"`
+	Address  []byte           `json:"address"`
+	Height   int64            `json:"height"`
+	Time     int64            `json:"time"`
+	Penalty  types.Coin       `json:"penalty"`
+}
+
+// Tombstone - validator key which double signed and can never be validator again
+type Tombstone struct {
+	Username types.AccountKey `json:"username"`
+	Height   int64            `json:"height"`
+	Time     int64            `json:"time"`
+}
+
+// LedgerEntry - slash or inflation reward of validator. PunishType is only set
+// for slash, EvidenceHeight and EvidenceAddress refer to the double sign
+// evidence of byzantine slash
+type LedgerEntry struct {
+	Type            types.ValidatorLedgerType `json:"type"`
+	PunishType      types.PunishType          `json:"punish_type"`
+	Amount          types.Coin                `json:"amount"`
+	Height          int64                     `json:"height"`
+	CreatedAt       int64                     `json:"created_at"`
+	EvidenceHeight  int64                     `json:"evidence_height"`
+	EvidenceAddress []byte                    `json:"evidence_address"`
+}
+
+// Ledger - a bundle of ValidatorLedgerBundleSize ledger entries
+type Ledger struct {
+	Entries []LedgerEntry `json:"entries"`
+}
+
+// LedgerMeta - number of entries in ledger of validator, ledger is kept after
+// validator revoked
+type LedgerMeta struct {
+	NumOfEntries int64 `json:"num_of_entries"`
+}
+
+// Unbonding - deposit withdrawn or revoked by validator which hasn't been
+// returned yet, it is returned in return times pieces and can still be slashed
+// for byzantine behavior before it was withdrawn
+type Unbonding struct {
+	Amount        types.Coin `json:"amount"`
+	ReturnTimes   int64      `json:"return_times"`
+	CreatedHeight int64      `json:"created_height"`
+	CreatedAt     int64      `json:"created_at"`
+}
+
+// Unbondings - all unbondings of a validator
+type Unbondings struct {
+	Unbondings []Unbonding `json:"unbondings"`
 }
 
 // Validator list
@@ -24,3 +124,124 @@ type ValidatorList struct {
 	LowestPower        types.Coin         `json:"lowest_power"`
 	LowestValidator    types.AccountKey   `json:"lowest_validator"`
 }
+
+// CoinToPower - tendermint power is counted in LNO, oncall validator has
+// at least 1 power since 0 power removes it from validator set
+func CoinToPower(coin types.Coin) int64 {
+	power := coin.ToInt64() / types.Decimals
+	if power < 1 {
+		return 1
+	}
+	return power
+}
+
+// Candidate - validator ranked by committing deposit which decides oncall
+// validators, EffectivePower is committing deposit plus voting power in LNO
+// and Power is the power tendermint knows now
+type Candidate struct {
+	Rank           int              `json:"rank"`
+	Username       types.AccountKey `json:"username"`
+	Deposit        types.Coin       `json:"deposit"`
+	EffectivePower int64            `json:"effective_power"`
+	Power          int64            `json:"power"`
+	IsOncall       bool             `json:"is_oncall"`
+	IsJailed       bool             `json:"is_jailed"`
+}
+
+// CandidateRanking - validators ranked by committing deposit, jailed validators
+// are ranked last. EntryDeposit is the minimum committing deposit to enter
+// oncall validators now, Joining and Leaving are the projected changes of
+// oncall validators at next AdjustValidatorList
+type CandidateRanking struct {
+	Candidates        []Candidate      `json:"candidates"`
+	ValidatorListSize int64            `json:"validator_list_size"`
+	EntryDeposit      types.Coin       `json:"entry_deposit"`
+	Joining           types.AccountKey `json:"joining"`
+	Leaving           types.AccountKey `json:"leaving"`
+}
+
+// RankCandidates - rank all validators in validator list, voting power of
+// validator without voter account is 0
+func RankCandidates(
+	lst ValidatorList, validators map[types.AccountKey]*Validator,
+	votingPowers map[types.AccountKey]types.Coin, param *param.ValidatorParam) *CandidateRanking {
+	ranking := &CandidateRanking{
+		Candidates:        []Candidate{},
+		ValidatorListSize: param.ValidatorListSize,
+		EntryDeposit:      param.ValidatorMinCommittingDeposit,
+	}
+	for _, validatorName := range lst.AllValidators {
+		validator, exist := validators[validatorName]
+		if !exist {
+			continue
+		}
+		power := validator.Deposit
+		if votingPower, exist := votingPowers[validatorName]; exist {
+			power = power.Plus(votingPower)
+		}
+		ranking.Candidates = append(ranking.Candidates, Candidate{
+			Username:       validatorName,
+			Deposit:        validator.Deposit,
+			EffectivePower: CoinToPower(power),
+			Power:          validator.ABCIValidator.Power,
+			IsOncall:       types.FindAccountInList(validatorName, lst.OncallValidators) != -1,
+			IsJailed:       validator.IsJailed,
+		})
+	}
+	sort.SliceStable(ranking.Candidates, func(i, j int) bool {
+		if ranking.Candidates[i].IsJailed != ranking.Candidates[j].IsJailed {
+			return !ranking.Candidates[i].IsJailed
+		}
+		return ranking.Candidates[i].Deposit.IsGT(ranking.Candidates[j].Deposit)
+	})
+	for i := range ranking.Candidates {
+		ranking.Candidates[i].Rank = i + 1
+	}
+
+	// same as updateLowestValidator and getBestCandidate
+	lowestValidator := types.AccountKey("")
+	lowestDeposit := types.NewCoinFromInt64(0)
+	for _, validatorName := range lst.OncallValidators {
+		validator, exist := validators[validatorName]
+		if !exist {
+			continue
+		}
+		if lowestValidator == "" || lowestDeposit.IsGT(validator.Deposit) {
+			lowestValidator = validatorName
+			lowestDeposit = validator.Deposit
+		}
+	}
+	bestCandidate := types.AccountKey("")
+	bestCandidateDeposit := types.NewCoinFromInt64(0)
+	for _, validatorName := range lst.AllValidators {
+		validator, exist := validators[validatorName]
+		if !exist {
+			continue
+		}
+		if !validator.IsJailed &&
+			types.FindAccountInList(validatorName, lst.OncallValidators) == -1 &&
+			validator.Deposit.IsGT(bestCandidateDeposit) {
+			bestCandidate = validatorName
+			bestCandidateDeposit = validator.Deposit
+		}
+	}
+
+	// validator replaces the oncall validator with lowest deposit if oncall
+	// validators are full, it needs a higher deposit
+	isFull := int64(len(lst.OncallValidators)) >= param.ValidatorListSize
+	if isFull && lowestValidator != "" {
+		entryDeposit := lowestDeposit.Plus(types.NewCoinFromInt64(1))
+		if entryDeposit.IsGT(ranking.EntryDeposit) {
+			ranking.EntryDeposit = entryDeposit
+		}
+	}
+	if bestCandidate != "" && bestCandidateDeposit.IsGTE(param.ValidatorMinCommittingDeposit) {
+		if !isFull {
+			ranking.Joining = bestCandidate
+		} else if bestCandidateDeposit.IsGT(lowestDeposit) {
+			ranking.Joining = bestCandidate
+			ranking.Leaving = lowestValidator
+		}
+	}
+	return ranking
+}
@@ -0,0 +1,118 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/lino-network/lino/param"
+	"github.com/lino-network/lino/types"
+	"github.com/stretchr/testify/assert"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func TestRankCandidates(t *testing.T) {
+	valParam := &param.ValidatorParam{
+		ValidatorMinCommittingDeposit: types.NewCoinFromInt64(100 * types.Decimals),
+		ValidatorListSize:             2,
+	}
+	newValidator := func(username string, deposit int64, isJailed bool) *Validator {
+		return &Validator{
+			Username:      types.AccountKey(username),
+			Deposit:       types.NewCoinFromInt64(deposit * types.Decimals),
+			IsJailed:      isJailed,
+			ABCIValidator: abci.Validator{Power: deposit},
+		}
+	}
+	validators := map[types.AccountKey]*Validator{
+		"user1": newValidator("user1", 200, false),
+		"user2": newValidator("user2", 300, false),
+		"user3": newValidator("user3", 250, false),
+		"user4": newValidator("user4", 1000, true),
+		"user5": newValidator("user5", 50, false),
+	}
+	votingPowers := map[types.AccountKey]types.Coin{
+		"user1": types.NewCoinFromInt64(1000 * types.Decimals),
+	}
+
+	testCases := []struct {
+		testName           string
+		oncallValidators   []types.AccountKey
+		allValidators      []types.AccountKey
+		expectOrder        []types.AccountKey
+		expectEntryDeposit types.Coin
+		expectJoining      types.AccountKey
+		expectLeaving      types.AccountKey
+	}{
+		{
+			testName:           "candidate replaces oncall validator with lowest deposit",
+			oncallValidators:   []types.AccountKey{"user1", "user2"},
+			allValidators:      []types.AccountKey{"user1", "user2", "user3", "user4"},
+			expectOrder:        []types.AccountKey{"user2", "user3", "user1", "user4"},
+			expectEntryDeposit: types.NewCoinFromInt64(200*types.Decimals + 1),
+			expectJoining:      "user3",
+			expectLeaving:      "user1",
+		},
+		{
+			testName:           "candidate joins if oncall validators are not full",
+			oncallValidators:   []types.AccountKey{"user2"},
+			allValidators:      []types.AccountKey{"user1", "user2", "user4"},
+			expectOrder:        []types.AccountKey{"user2", "user1", "user4"},
+			expectEntryDeposit: types.NewCoinFromInt64(100 * types.Decimals),
+			expectJoining:      "user1",
+			expectLeaving:      "",
+		},
+		{
+			testName:           "candidate with lower deposit doesn't join",
+			oncallValidators:   []types.AccountKey{"user2", "user3"},
+			allValidators:      []types.AccountKey{"user1", "user2", "user3"},
+			expectOrder:        []types.AccountKey{"user2", "user3", "user1"},
+			expectEntryDeposit: types.NewCoinFromInt64(250*types.Decimals + 1),
+			expectJoining:      "",
+			expectLeaving:      "",
+		},
+		{
+			testName:           "candidate below minimum committing deposit doesn't join",
+			oncallValidators:   []types.AccountKey{"user2"},
+			allValidators:      []types.AccountKey{"user2", "user5"},
+			expectOrder:        []types.AccountKey{"user2", "user5"},
+			expectEntryDeposit: types.NewCoinFromInt64(100 * types.Decimals),
+			expectJoining:      "",
+			expectLeaving:      "",
+		},
+	}
+
+	for _, tc := range testCases {
+		lst := ValidatorList{
+			OncallValidators: tc.oncallValidators,
+			AllValidators:    tc.allValidators,
+		}
+		ranking := RankCandidates(lst, validators, votingPowers, valParam)
+		order := []types.AccountKey{}
+		for i, candidate := range ranking.Candidates {
+			order = append(order, candidate.Username)
+			if candidate.Rank != i+1 {
+				t.Errorf("%s: diff rank of %v, got %v, want %v", tc.testName, candidate.Username, candidate.Rank, i+1)
+			}
+			if candidate.IsOncall != (types.FindAccountInList(candidate.Username, tc.oncallValidators) != -1) {
+				t.Errorf("%s: diff oncall of %v, got %v", tc.testName, candidate.Username, candidate.IsOncall)
+			}
+		}
+		if !assert.Equal(t, tc.expectOrder, order) {
+			t.Errorf("%s: diff order, got %v, want %v", tc.testName, order, tc.expectOrder)
+		}
+		if !ranking.EntryDeposit.IsEqual(tc.expectEntryDeposit) {
+			t.Errorf("%s: diff entry deposit, got %v, want %v", tc.testName, ranking.EntryDeposit, tc.expectEntryDeposit)
+		}
+		if ranking.Joining != tc.expectJoining || ranking.Leaving != tc.expectLeaving {
+			t.Errorf("%s: diff projected change, got %v and %v, want %v and %v",
+				tc.testName, ranking.Joining, ranking.Leaving, tc.expectJoining, tc.expectLeaving)
+		}
+	}
+
+	// effective power includes voting power
+	ranking := RankCandidates(ValidatorList{
+		AllValidators: []types.AccountKey{"user1", "user2"}}, validators, votingPowers, valParam)
+	assert.Equal(t, int64(300), ranking.Candidates[0].EffectivePower)
+	assert.Equal(t, int64(1200), ranking.Candidates[1].EffectivePower)
+	assert.Equal(t, int64(200), ranking.Candidates[1].Power)
+}
@@ -12,6 +12,10 @@ import (
 var _ types.Msg = ValidatorDepositMsg{}
 var _ types.Msg = ValidatorWithdrawMsg{}
 var _ types.Msg = ValidatorRevokeMsg{}
+var _ types.Msg = ValidatorUnjailMsg{}
+var _ types.Msg = ValidatorSetCommissionMsg{}
+var _ types.Msg = ValidatorUpdateMsg{}
+var _ types.Msg = ValidatorRotateKeyMsg{}
 
 // ValidatorDepositMsg - deposit to become validator or add deposit
 type ValidatorDepositMsg struct {
@@ -32,6 +36,34 @@ type ValidatorRevokeMsg struct {
 	Username types.AccountKey `json:"username"`
 }
 
+// ValidatorUnjailMsg - unjail validator after jail duration
+type ValidatorUnjailMsg struct {
+	Username types.AccountKey `json:"username"`
+}
+
+// ValidatorSetCommissionMsg - set commission rate validator takes from its inflation
+type ValidatorSetCommissionMsg struct {
+	Username       types.AccountKey `json:"username"`
+	CommissionRate string           `json:"commission_rate"`
+}
+
+// ValidatorUpdateMsg - update validator information and commission rate,
+// commission rate is unchanged if empty
+type ValidatorUpdateMsg struct {
+	Username       types.AccountKey `json:"username"`
+	Moniker        string           `json:"moniker"`
+	Website        string           `json:"website"`
+	Description    string           `json:"description"`
+	Contact        string           `json:"contact"`
+	CommissionRate string           `json:"commission_rate"`
+}
+
+// ValidatorRotateKeyMsg - change consensus public key of validator
+type ValidatorRotateKeyMsg struct {
+	Username  types.AccountKey `json:"username"`
+	ValPubKey crypto.PubKey    `json:"validator_public_key"`
+}
+
 // ValidatorDepositMsg Msg Implementations
 func NewValidatorDepositMsg(validator string, deposit types.LNO, pubKey crypto.PubKey, link string) ValidatorDepositMsg {
 	return ValidatorDepositMsg{
@@ -190,3 +222,232 @@ func (msg ValidatorRevokeMsg) GetSigners() []sdk.AccAddress {
 func (msg ValidatorRevokeMsg) GetConsumeAmount() types.Coin {
 	return types.NewCoinFromInt64(0)
 }
+
+// ValidatorUnjailMsg Msg Implementations
+func NewValidatorUnjailMsg(validator string) ValidatorUnjailMsg {
+	return ValidatorUnjailMsg{
+		Username: types.AccountKey(validator),
+	}
+}
+
+// Type - implement sdk.Msg
+func (msg ValidatorUnjailMsg) Type() string { return types.ValidatorRouterName }
+
+// ValidateBasic - implement sdk.Msg
+func (msg ValidatorUnjailMsg) ValidateBasic() sdk.Error {
+	if len(msg.Username) < types.MinimumUsernameLength ||
+		len(msg.Username) > types.MaximumUsernameLength {
+		return ErrInvalidUsername()
+	}
+	return nil
+}
+
+func (msg ValidatorUnjailMsg) String() string {
+	return fmt.Sprintf("ValidatorUnjailMsg{Username:%v}", msg.Username)
+}
+
+// GetPermission - implement types.Msg
+func (msg ValidatorUnjailMsg) GetPermission() types.Permission {
+	return types.TransactionPermission
+}
+
+// GetSignBytes - implement sdk.Msg
+func (msg ValidatorUnjailMsg) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg) // XXX: ensure some canonical form
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners - implement sdk.Msg
+func (msg ValidatorUnjailMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Username)}
+}
+
+// GetConsumeAmount - implement types.Msg
+func (msg ValidatorUnjailMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
+
+// ValidatorSetCommissionMsg Msg Implementations
+func NewValidatorSetCommissionMsg(validator string, commissionRate string) ValidatorSetCommissionMsg {
+	return ValidatorSetCommissionMsg{
+		Username:       types.AccountKey(validator),
+		CommissionRate: commissionRate,
+	}
+}
+
+// Type - implement sdk.Msg
+func (msg ValidatorSetCommissionMsg) Type() string { return types.ValidatorRouterName }
+
+// ValidateBasic - implement sdk.Msg
+func (msg ValidatorSetCommissionMsg) ValidateBasic() sdk.Error {
+	if len(msg.Username) < types.MinimumUsernameLength ||
+		len(msg.Username) > types.MaximumUsernameLength {
+		return ErrInvalidUsername()
+	}
+	rate, err := sdk.NewRatFromDecimal(msg.CommissionRate, types.NewRatFromDecimalPrecision)
+	if err != nil {
+		return ErrInvalidCommissionRate()
+	}
+	if rate.LT(sdk.ZeroRat()) || rate.GT(sdk.OneRat()) {
+		return ErrInvalidCommissionRate()
+	}
+	return nil
+}
+
+func (msg ValidatorSetCommissionMsg) String() string {
+	return fmt.Sprintf("ValidatorSetCommissionMsg{Username:%v, CommissionRate:%v}", msg.Username, msg.CommissionRate)
+}
+
+// GetPermission - implement types.Msg
+func (msg ValidatorSetCommissionMsg) GetPermission() types.Permission {
+	return types.TransactionPermission
+}
+
+// GetSignBytes - implement sdk.Msg
+func (msg ValidatorSetCommissionMsg) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg) // XXX: ensure some canonical form
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners - implement sdk.Msg
+func (msg ValidatorSetCommissionMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Username)}
+}
+
+// GetConsumeAmount - implement types.Msg
+func (msg ValidatorSetCommissionMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
+
+// ValidatorUpdateMsg Msg Implementations
+func NewValidatorUpdateMsg(
+	validator, moniker, website, description, contact, commissionRate string) ValidatorUpdateMsg {
+	return ValidatorUpdateMsg{
+		Username:       types.AccountKey(validator),
+		Moniker:        moniker,
+		Website:        website,
+		Description:    description,
+		Contact:        contact,
+		CommissionRate: commissionRate,
+	}
+}
+
+// Type - implement sdk.Msg
+func (msg ValidatorUpdateMsg) Type() string { return types.ValidatorRouterName }
+
+// ValidateBasic - implement sdk.Msg
+func (msg ValidatorUpdateMsg) ValidateBasic() sdk.Error {
+	if len(msg.Username) < types.MinimumUsernameLength ||
+		len(msg.Username) > types.MaximumUsernameLength {
+		return ErrInvalidUsername()
+	}
+	if len(msg.Moniker) > types.MaximumLengthOfValidatorMoniker {
+		return ErrInvalidMoniker()
+	}
+	if len(msg.Website) > types.MaximumLengthOfValidatorWebsite {
+		return ErrInvalidWebsite()
+	}
+	if len(msg.Description) > types.MaximumLengthOfValidatorDescription {
+		return ErrInvalidValidatorDescription()
+	}
+	if len(msg.Contact) > types.MaximumLengthOfValidatorContact {
+		return ErrInvalidContact()
+	}
+	if len(msg.CommissionRate) > 0 {
+		rate, err := sdk.NewRatFromDecimal(msg.CommissionRate, types.NewRatFromDecimalPrecision)
+		if err != nil {
+			return ErrInvalidCommissionRate()
+		}
+		if rate.LT(sdk.ZeroRat()) || rate.GT(sdk.OneRat()) {
+			return ErrInvalidCommissionRate()
+		}
+	}
+	return nil
+}
+
+func (msg ValidatorUpdateMsg) String() string {
+	return fmt.Sprintf(
+		"ValidatorUpdateMsg{Username:%v, Moniker:%v, Website:%v, Description:%v, Contact:%v, CommissionRate:%v}",
+		msg.Username, msg.Moniker, msg.Website, msg.Description, msg.Contact, msg.CommissionRate)
+}
+
+// GetPermission - implement types.Msg
+func (msg ValidatorUpdateMsg) GetPermission() types.Permission {
+	return types.TransactionPermission
+}
+
+// GetSignBytes - implement sdk.Msg
+func (msg ValidatorUpdateMsg) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg) // XXX: ensure some canonical form
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners - implement sdk.Msg
+func (msg ValidatorUpdateMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Username)}
+}
+
+// GetConsumeAmount - implement types.Msg
+func (msg ValidatorUpdateMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
+
+// ValidatorRotateKeyMsg Msg Implementations
+func NewValidatorRotateKeyMsg(validator string, pubKey crypto.PubKey) ValidatorRotateKeyMsg {
+	return ValidatorRotateKeyMsg{
+		Username:  types.AccountKey(validator),
+		ValPubKey: pubKey,
+	}
+}
+
+// Type - implement sdk.Msg
+func (msg ValidatorRotateKeyMsg) Type() string { return types.ValidatorRouterName }
+
+// ValidateBasic - implement sdk.Msg
+func (msg ValidatorRotateKeyMsg) ValidateBasic() sdk.Error {
+	if len(msg.Username) < types.MinimumUsernameLength ||
+		len(msg.Username) > types.MaximumUsernameLength {
+		return ErrInvalidUsername()
+	}
+	if msg.ValPubKey == nil {
+		return ErrInvalidValidatorPubKey()
+	}
+	return nil
+}
+
+func (msg ValidatorRotateKeyMsg) String() string {
+	return fmt.Sprintf("ValidatorRotateKeyMsg{Username:%v, PubKey:%v}", msg.Username, msg.ValPubKey)
+}
+
+// GetPermission - implement types.Msg
+func (msg ValidatorRotateKeyMsg) GetPermission() types.Permission {
+	return types.TransactionPermission
+}
+
+// GetSignBytes - implement sdk.Msg
+func (msg ValidatorRotateKeyMsg) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg) // XXX: ensure some canonical form
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners - implement sdk.Msg
+func (msg ValidatorRotateKeyMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Username)}
+}
+
+// GetConsumeAmount - implement types.Msg
+func (msg ValidatorRotateKeyMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
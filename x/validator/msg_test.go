@@ -36,6 +36,168 @@ func TestValidatorRevokeMsg(t *testing.T) {
 	}
 }
 
+func TestValidatorUnjailMsg(t *testing.T) {
+	testCases := []struct {
+		testName           string
+		validatorUnjailMsg ValidatorUnjailMsg
+		expectedError      sdk.Error
+	}{
+		{
+			testName:           "normal case",
+			validatorUnjailMsg: NewValidatorUnjailMsg("user1"),
+			expectedError:      nil,
+		},
+		{
+			testName:           "invalid username",
+			validatorUnjailMsg: NewValidatorUnjailMsg(""),
+			expectedError:      ErrInvalidUsername(),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := tc.validatorUnjailMsg.ValidateBasic()
+		if !assert.Equal(t, result, tc.expectedError) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectedError)
+		}
+	}
+}
+
+func TestValidatorSetCommissionMsg(t *testing.T) {
+	testCases := []struct {
+		testName      string
+		msg           ValidatorSetCommissionMsg
+		expectedError sdk.Error
+	}{
+		{
+			testName:      "normal case",
+			msg:           NewValidatorSetCommissionMsg("user1", "0.1"),
+			expectedError: nil,
+		},
+		{
+			testName:      "invalid username",
+			msg:           NewValidatorSetCommissionMsg("", "0.1"),
+			expectedError: ErrInvalidUsername(),
+		},
+		{
+			testName:      "invalid commission rate",
+			msg:           NewValidatorSetCommissionMsg("user1", "a"),
+			expectedError: ErrInvalidCommissionRate(),
+		},
+		{
+			testName:      "negative commission rate",
+			msg:           NewValidatorSetCommissionMsg("user1", "-0.1"),
+			expectedError: ErrInvalidCommissionRate(),
+		},
+		{
+			testName:      "commission rate larger than 1",
+			msg:           NewValidatorSetCommissionMsg("user1", "1.1"),
+			expectedError: ErrInvalidCommissionRate(),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := tc.msg.ValidateBasic()
+		if !assert.Equal(t, result, tc.expectedError) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectedError)
+		}
+	}
+}
+
+func TestValidatorUpdateMsg(t *testing.T) {
+	testCases := []struct {
+		testName      string
+		msg           ValidatorUpdateMsg
+		expectedError sdk.Error
+	}{
+		{
+			testName:      "normal case",
+			msg:           NewValidatorUpdateMsg("user1", "moniker", "https://lino.network", "description", "contact", "0.1"),
+			expectedError: nil,
+		},
+		{
+			testName:      "commission rate is unchanged if empty",
+			msg:           NewValidatorUpdateMsg("user1", "moniker", "https://lino.network", "description", "contact", ""),
+			expectedError: nil,
+		},
+		{
+			testName:      "invalid username",
+			msg:           NewValidatorUpdateMsg("", "moniker", "https://lino.network", "description", "contact", "0.1"),
+			expectedError: ErrInvalidUsername(),
+		},
+		{
+			testName: "moniker is too long",
+			msg: NewValidatorUpdateMsg(
+				"user1", string(make([]byte, types.MaximumLengthOfValidatorMoniker+1)),
+				"https://lino.network", "description", "contact", "0.1"),
+			expectedError: ErrInvalidMoniker(),
+		},
+		{
+			testName: "website is too long",
+			msg: NewValidatorUpdateMsg(
+				"user1", "moniker", string(make([]byte, types.MaximumLengthOfValidatorWebsite+1)),
+				"description", "contact", "0.1"),
+			expectedError: ErrInvalidWebsite(),
+		},
+		{
+			testName: "description is too long",
+			msg: NewValidatorUpdateMsg(
+				"user1", "moniker", "https://lino.network",
+				string(make([]byte, types.MaximumLengthOfValidatorDescription+1)), "contact", "0.1"),
+			expectedError: ErrInvalidValidatorDescription(),
+		},
+		{
+			testName: "contact is too long",
+			msg: NewValidatorUpdateMsg(
+				"user1", "moniker", "https://lino.network", "description",
+				string(make([]byte, types.MaximumLengthOfValidatorContact+1)), "0.1"),
+			expectedError: ErrInvalidContact(),
+		},
+		{
+			testName:      "commission rate larger than 1",
+			msg:           NewValidatorUpdateMsg("user1", "moniker", "https://lino.network", "description", "contact", "1.1"),
+			expectedError: ErrInvalidCommissionRate(),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := tc.msg.ValidateBasic()
+		if !assert.Equal(t, result, tc.expectedError) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectedError)
+		}
+	}
+}
+
+func TestValidatorRotateKeyMsg(t *testing.T) {
+	testCases := []struct {
+		testName      string
+		msg           ValidatorRotateKeyMsg
+		expectedError sdk.Error
+	}{
+		{
+			testName:      "normal case",
+			msg:           NewValidatorRotateKeyMsg("user1", secp256k1.GenPrivKey().PubKey()),
+			expectedError: nil,
+		},
+		{
+			testName:      "invalid username",
+			msg:           NewValidatorRotateKeyMsg("", secp256k1.GenPrivKey().PubKey()),
+			expectedError: ErrInvalidUsername(),
+		},
+		{
+			testName:      "missing public key",
+			msg:           NewValidatorRotateKeyMsg("user1", nil),
+			expectedError: ErrInvalidValidatorPubKey(),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := tc.msg.ValidateBasic()
+		if !assert.Equal(t, result, tc.expectedError) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectedError)
+		}
+	}
+}
+
 func TestValidatorWithdrawMsg(t *testing.T) {
 	testCases := []struct {
 		testName             string
@@ -121,6 +283,26 @@ func TestMsgPermission(t *testing.T) {
 			msg:                NewValidatorRevokeMsg("test"),
 			expectedPermission: types.TransactionPermission,
 		},
+		{
+			testName:           "validator unjail msg",
+			msg:                NewValidatorUnjailMsg("test"),
+			expectedPermission: types.TransactionPermission,
+		},
+		{
+			testName:           "validator set commission msg",
+			msg:                NewValidatorSetCommissionMsg("test", "0.1"),
+			expectedPermission: types.TransactionPermission,
+		},
+		{
+			testName:           "validator update msg",
+			msg:                NewValidatorUpdateMsg("test", "moniker", "", "", "", ""),
+			expectedPermission: types.TransactionPermission,
+		},
+		{
+			testName:           "validator rotate key msg",
+			msg:                NewValidatorRotateKeyMsg("test", secp256k1.GenPrivKey().PubKey()),
+			expectedPermission: types.TransactionPermission,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -150,6 +332,22 @@ func TestGetSignBytes(t *testing.T) {
 			testName: "validator revoke msg",
 			msg:      NewValidatorRevokeMsg("test"),
 		},
+		{
+			testName: "validator unjail msg",
+			msg:      NewValidatorUnjailMsg("test"),
+		},
+		{
+			testName: "validator set commission msg",
+			msg:      NewValidatorSetCommissionMsg("test", "0.1"),
+		},
+		{
+			testName: "validator update msg",
+			msg:      NewValidatorUpdateMsg("test", "moniker", "", "", "", ""),
+		},
+		{
+			testName: "validator rotate key msg",
+			msg:      NewValidatorRotateKeyMsg("test", secp256k1.GenPrivKey().PubKey()),
+		},
 	}
 
 	for testName, tc := range testCases {
@@ -179,6 +377,26 @@ func TestGetSigners(t *testing.T) {
 			msg:           NewValidatorRevokeMsg("test"),
 			expectSigners: []types.AccountKey{"test"},
 		},
+		{
+			testName:      "validator unjail msg",
+			msg:           NewValidatorUnjailMsg("test"),
+			expectSigners: []types.AccountKey{"test"},
+		},
+		{
+			testName:      "validator set commission msg",
+			msg:           NewValidatorSetCommissionMsg("test", "0.1"),
+			expectSigners: []types.AccountKey{"test"},
+		},
+		{
+			testName:      "validator update msg",
+			msg:           NewValidatorUpdateMsg("test", "moniker", "", "", "", ""),
+			expectSigners: []types.AccountKey{"test"},
+		},
+		{
+			testName:      "validator rotate key msg",
+			msg:           NewValidatorRotateKeyMsg("test", secp256k1.GenPrivKey().PubKey()),
+			expectSigners: []types.AccountKey{"test"},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -3,12 +3,14 @@ package validator
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/x/vote"
 	abci "github.com/tendermint/tendermint/abci/types"
 )
 
 // BeginBlocker - execute before every block, update signing info and record validator set
 func BeginBlocker(
-	ctx sdk.Context, req abci.RequestBeginBlock, vm ValidatorManager) (panelty types.Coin) {
+	ctx sdk.Context, req abci.RequestBeginBlock, vm ValidatorManager,
+	voteManager vote.VoteManager) (panelty types.Coin) {
 	validatorList, err := vm.GetValidatorList(ctx)
 	if err != nil {
 		panic(err)
@@ -20,6 +22,6 @@ func BeginBlocker(
 
 	vm.UpdateSigningValidator(ctx, req.LastCommitInfo.Validators)
 
-	panelty, _ = vm.FireIncompetentValidator(ctx, req.ByzantineValidators)
+	panelty, _ = vm.FireIncompetentValidator(ctx, req.ByzantineValidators, voteManager)
 	return
 }
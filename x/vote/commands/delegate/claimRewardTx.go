@@ -0,0 +1,46 @@
+package delegate
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/lino-network/lino/client"
+	"github.com/lino-network/lino/x/vote"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/wire"
+)
+
+// ClaimDelegatorRewardTxCmd will create a claim reward tx and sign it with the given key
+func ClaimDelegatorRewardTxCmd(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegate-claim-reward",
+		Short: "claim reward of delegation to a voter",
+		RunE:  sendClaimDelegatorRewardTx(cdc),
+	}
+	cmd.Flags().String(client.FlagUser, "", "claim user")
+	cmd.Flags().String(client.FlagVoter, "", "claim from voter")
+	return cmd
+}
+
+func sendClaimDelegatorRewardTx(cdc *wire.Codec) client.CommandTxCallback {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := client.NewCoreContextFromViper()
+		user := viper.GetString(client.FlagUser)
+		voter := viper.GetString(client.FlagVoter)
+		// create the message
+		msg := vote.NewClaimDelegatorRewardMsg(user, voter)
+
+		// build and sign the transaction, then broadcast to Tendermint
+		res, signErr := ctx.SignBuildBroadcast([]sdk.Msg{msg}, cdc)
+
+		if signErr != nil {
+			return signErr
+		}
+
+		fmt.Printf("Committed at block %d. Hash: %s\n", res.Height, res.Hash.String())
+		return nil
+	}
+}
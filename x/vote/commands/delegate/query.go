@@ -11,6 +11,7 @@ import (
 	"github.com/lino-network/lino/types"
 	"github.com/lino-network/lino/x/vote/model"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/wire"
 )
 
@@ -27,6 +28,32 @@ func GetDelegationCmd(storeName string, cdc *wire.Codec) *cobra.Command {
 	}
 }
 
+// GetDelegatorRewardCmd returns unclaimed reward of a delegation
+func GetDelegatorRewardCmd(storeName string, cdc *wire.Codec) *cobra.Command {
+	cmdr := commander{
+		storeName,
+		cdc,
+	}
+	return &cobra.Command{
+		Use:   "delegator-reward <voter> <delegator>",
+		Short: "Query unclaimed reward of a delegation",
+		RunE:  cmdr.getDelegatorRewardCmd,
+	}
+}
+
+// GetRedelegationsCmd returns redelegations of a delegator
+func GetRedelegationsCmd(storeName string, cdc *wire.Codec) *cobra.Command {
+	cmdr := commander{
+		storeName,
+		cdc,
+	}
+	return &cobra.Command{
+		Use:   "redelegations <delegator>",
+		Short: "Query redelegations of a delegator",
+		RunE:  cmdr.getRedelegationsCmd,
+	}
+}
+
 type commander struct {
 	storeName string
 	cdc       *wire.Codec
@@ -58,3 +85,86 @@ func (c commander) getDelegationCmd(cmd *cobra.Command, args []string) error {
 	fmt.Println(string(output))
 	return nil
 }
+
+func (c commander) getRedelegationsCmd(cmd *cobra.Command, args []string) error {
+	ctx := client.NewCoreContextFromViper()
+	if len(args) != 1 {
+		return errors.New("You must provide delegator name")
+	}
+
+	delegator := types.AccountKey(args[0])
+
+	lst := &model.RedelegationList{Redelegations: []model.Redelegation{}}
+	res, err := ctx.Query(model.GetRedelegationListKey(delegator), c.storeName)
+	if err != nil {
+		return err
+	}
+	if len(res) != 0 {
+		if err := c.cdc.UnmarshalJSON(res, lst); err != nil {
+			return err
+		}
+	}
+
+	if err := client.PrintIndent(lst); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c commander) getDelegatorRewardCmd(cmd *cobra.Command, args []string) error {
+	ctx := client.NewCoreContextFromViper()
+	if len(args) != 2 {
+		return errors.New("You must provide voter and delegator name")
+	}
+
+	voter := types.AccountKey(args[0])
+	delegator := types.AccountKey(args[1])
+
+	amount := types.NewCoinFromInt64(0)
+	res, err := ctx.Query(model.GetDelegationKey(voter, delegator), c.storeName)
+	if err != nil {
+		return err
+	}
+	if len(res) != 0 {
+		delegation := new(model.Delegation)
+		if err := c.cdc.UnmarshalJSON(res, delegation); err != nil {
+			return err
+		}
+		amount = delegation.Amount
+	}
+
+	rewardPerPower := sdk.ZeroRat()
+	res, err = ctx.Query(model.GetVoterRewardKey(voter), c.storeName)
+	if err != nil {
+		return err
+	}
+	if len(res) != 0 {
+		voterReward := new(model.VoterReward)
+		if err := c.cdc.UnmarshalJSON(res, voterReward); err != nil {
+			return err
+		}
+		rewardPerPower = voterReward.RewardPerPower
+	}
+
+	// reward not settled yet is accumulated after last reward per power
+	reward := &model.DelegatorReward{
+		LastRewardPerPower: sdk.ZeroRat(),
+		UnclaimedReward:    types.NewCoinFromInt64(0),
+	}
+	res, err = ctx.Query(model.GetDelegatorRewardKey(voter, delegator), c.storeName)
+	if err != nil {
+		return err
+	}
+	if len(res) != 0 {
+		if err := c.cdc.UnmarshalJSON(res, reward); err != nil {
+			return err
+		}
+	}
+	unclaimed := reward.UnclaimedReward.Plus(
+		types.RatToCoin(amount.ToRat().Mul(rewardPerPower.Sub(reward.LastRewardPerPower))))
+
+	if err := client.PrintIndent(unclaimed); err != nil {
+		return err
+	}
+	return nil
+}
@@ -37,6 +37,21 @@ func ErrInsufficientDeposit() sdk.Error {
 	return types.NewError(types.CodeInsufficientDeposit, fmt.Sprintf("deposit is not enough"))
 }
 
+// ErrInvalidRedelegation - error if redelegation is to the same voter or exceeds delegation
+func ErrInvalidRedelegation() sdk.Error {
+	return types.NewError(types.CodeInvalidRedelegation, fmt.Sprintf("invalid redelegation"))
+}
+
+// ErrRedelegationInCooldown - error if redelegate from a voter power was redelegated to in cooldown
+func ErrRedelegationInCooldown() sdk.Error {
+	return types.NewError(types.CodeRedelegationInCooldown, fmt.Sprintf("redelegation is in cooldown"))
+}
+
+// ErrTooManyRedelegations - error if delegator has too many redelegations in cooldown
+func ErrTooManyRedelegations() sdk.Error {
+	return types.NewError(types.CodeTooManyRedelegations, fmt.Sprintf("too many redelegations in cooldown"))
+}
+
 // ErrInvalidUsername - error if username is invalid
 func ErrInvalidUsername() sdk.Error {
 	return types.NewError(types.CodeInvalidUsername, fmt.Sprintf("invalid username"))
@@ -0,0 +1,17 @@
+package vote
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lino-network/lino/types"
+)
+
+// RedelegationCompleteEvent - drop redelegations of a delegator whose cooldown
+// has ended, together with their index under source voters
+type RedelegationCompleteEvent struct {
+	Delegator types.AccountKey `json:"delegator"`
+}
+
+// Execute - execute redelegation complete event
+func (event RedelegationCompleteEvent) Execute(ctx sdk.Context, vm VoteManager) sdk.Error {
+	return vm.PruneRedelegations(ctx, event.Delegator)
+}
@@ -27,6 +27,10 @@ func NewHandler(vm VoteManager, am acc.AccountManager, gm global.GlobalManager)
 			return handleDelegatorWithdrawMsg(ctx, vm, gm, am, msg)
 		case RevokeDelegationMsg:
 			return handleRevokeDelegationMsg(ctx, vm, gm, am, msg)
+		case ClaimDelegatorRewardMsg:
+			return handleClaimDelegatorRewardMsg(ctx, vm, am, msg)
+		case RedelegateMsg:
+			return handleRedelegateMsg(ctx, vm, gm, msg)
 		default:
 			errMsg := fmt.Sprintf("Unrecognized vote msg type: %v", reflect.TypeOf(msg).Name())
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -109,12 +113,15 @@ func handleVoterRevokeMsg(
 	if err != nil {
 		return err.Result()
 	}
-	// return coins to all delegators
+	// return coins and reward to all delegators
 	for _, delegator := range delegators {
 		coin, withdrawErr := vm.DelegatorWithdrawAll(ctx, msg.Username, delegator)
 		if withdrawErr != nil {
 			return withdrawErr.Result()
 		}
+		if err := claimDelegatorReward(ctx, vm, am, msg.Username, delegator); err != nil {
+			return err.Result()
+		}
 		if err := returnCoinTo(
 			ctx, delegator, gm, am, param.DelegatorCoinReturnTimes,
 			param.DelegatorCoinReturnIntervalSec, coin, types.DelegationReturnCoin); err != nil {
@@ -194,6 +201,9 @@ func handleRevokeDelegationMsg(
 	if withdrawErr != nil {
 		return withdrawErr.Result()
 	}
+	if err := claimDelegatorReward(ctx, vm, am, msg.Voter, msg.Delegator); err != nil {
+		return err.Result()
+	}
 
 	param, err := vm.paramHolder.GetVoteParam(ctx)
 	if err != nil {
@@ -208,6 +218,49 @@ func handleRevokeDelegationMsg(
 	return sdk.Result{}
 }
 
+func handleClaimDelegatorRewardMsg(
+	ctx sdk.Context, vm VoteManager, am acc.AccountManager, msg ClaimDelegatorRewardMsg) sdk.Result {
+	if err := claimDelegatorReward(ctx, vm, am, msg.Voter, msg.Delegator); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{}
+}
+
+func handleRedelegateMsg(
+	ctx sdk.Context, vm VoteManager, gm global.GlobalManager, msg RedelegateMsg) sdk.Result {
+	coin, err := types.LinoToCoin(msg.Amount)
+	if err != nil {
+		return err.Result()
+	}
+	if err := vm.Redelegate(ctx, msg.Delegator, msg.FromVoter, msg.ToVoter, coin); err != nil {
+		return err.Result()
+	}
+	param, err := vm.paramHolder.GetVoteParam(ctx)
+	if err != nil {
+		return err.Result()
+	}
+	// drop the redelegation and its index once cooldown ends
+	if err := gm.RegisterRedelegationCompleteEvent(
+		ctx, param.RedelegationCooldownSec,
+		RedelegationCompleteEvent{Delegator: msg.Delegator}); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{}
+}
+
+// claimDelegatorReward - add unclaimed reward of delegation to delegator saving
+func claimDelegatorReward(
+	ctx sdk.Context, vm VoteManager, am acc.AccountManager, voter, delegator types.AccountKey) sdk.Error {
+	reward, err := vm.ClaimDelegatorReward(ctx, voter, delegator)
+	if err != nil {
+		return err
+	}
+	if reward.IsZero() {
+		return nil
+	}
+	return am.AddSavingCoin(ctx, delegator, reward, voter, "", types.DelegatorReward)
+}
+
 func returnCoinTo(
 	ctx sdk.Context, name types.AccountKey, gm global.GlobalManager, am acc.AccountManager,
 	times int64, interval int64, coin types.Coin, returnType types.TransferDetailType) sdk.Error {
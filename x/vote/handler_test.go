@@ -157,6 +157,37 @@ func TestRevokeBasic(t *testing.T) {
 	assert.Equal(t, minBalance.Minus(delegatedCoin), acc2Balance)
 }
 
+func TestClaimDelegatorReward(t *testing.T) {
+	ctx, am, vm, gm := setupTest(t, 0)
+	handler := NewHandler(vm, am, gm)
+	voteParam, _ := vm.paramHolder.GetVoteParam(ctx)
+	minBalance := types.NewCoinFromInt64(2000 * types.Decimals)
+
+	createTestAccount(ctx, am, "user1", minBalance.Plus(voteParam.VoterMinDeposit))
+	user2 := createTestAccount(ctx, am, "user2", minBalance.Plus(voteParam.VoterMinDeposit))
+	user3 := createTestAccount(ctx, am, "user3", minBalance.Plus(voteParam.VoterMinDeposit))
+	handler(ctx, NewVoterDepositMsg("user1", coinToString(voteParam.VoterMinDeposit)))
+	handler(ctx, NewDelegateMsg("user2", "user1", coinToString(voteParam.VoterMinDeposit)))
+	handler(ctx, NewDelegateMsg("user3", "user1", coinToString(voteParam.VoterMinDeposit)))
+
+	reward := types.NewCoinFromInt64(300 * types.Decimals)
+	voterReward, err := vm.DistributeDelegatorReward(ctx, "user1", reward)
+	assert.Nil(t, err)
+	assert.Equal(t, types.NewCoinFromInt64(100*types.Decimals), voterReward)
+
+	// user2 claims reward to saving
+	result := handler(ctx, NewClaimDelegatorRewardMsg("user2", "user1"))
+	assert.Equal(t, sdk.Result{}, result)
+	saving, _ := am.GetSavingFromBank(ctx, user2)
+	assert.Equal(t, minBalance.Plus(voterReward), saving)
+
+	// user3 gets reward when revoking delegation
+	result = handler(ctx, NewRevokeDelegationMsg("user3", "user1"))
+	assert.Equal(t, sdk.Result{}, result)
+	saving, _ = am.GetSavingFromBank(ctx, user3)
+	assert.Equal(t, minBalance.Plus(voterReward), saving)
+}
+
 func TestVoterWithdraw(t *testing.T) {
 	ctx, am, vm, gm := setupTest(t, 0)
 	handler := NewHandler(vm, am, gm)
@@ -266,6 +297,24 @@ func TestDelegatorWithdraw(t *testing.T) {
 	}
 }
 
+func TestRedelegateRegistersCompleteEvent(t *testing.T) {
+	ctx, am, vm, gm := setupTest(t, 0)
+	handler := NewHandler(vm, am, gm)
+
+	param, _ := vm.paramHolder.GetVoteParam(ctx)
+	vm.AddVoter(ctx, "user1", param.VoterMinDeposit)
+	vm.AddVoter(ctx, "user2", param.VoterMinDeposit)
+	vm.AddDelegation(ctx, "user1", "delegator", types.NewCoinFromInt64(100*types.Decimals))
+
+	msg := NewRedelegateMsg("delegator", "user1", "user2", "100")
+	result := handler(ctx, msg)
+	assert.Equal(t, sdk.Result{}, result)
+
+	eventList := gm.GetTimeEventListAtTime(
+		ctx, ctx.BlockHeader().Time.Unix()+param.RedelegationCooldownSec)
+	assert.Equal(t, []types.Event{RedelegationCompleteEvent{Delegator: "delegator"}}, eventList.Events)
+}
+
 func TestAddFrozenMoney(t *testing.T) {
 	ctx, am, vm, gm := setupTest(t, 0)
 	vm.InitGenesis(ctx)
@@ -347,7 +396,7 @@ func TestDeleteVoteBasic(t *testing.T) {
 	handler(ctx, depositMsg)
 
 	// add vote
-	_ = vm.AddVote(ctx, proposalID1, user2, true)
+	_, _ = vm.AddVote(ctx, proposalID1, user2, true)
 
 	voteList, _ := vm.storage.GetAllVotes(ctx, proposalID1)
 	assert.Equal(t, user2, voteList[0].Voter)
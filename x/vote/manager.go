@@ -8,6 +8,10 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// rewardPerPowerPrecision - reward per unit of delegated power is far less than
+// one coin, it's rounded with higher precision than types.PrecisionFactor
+const rewardPerPowerPrecision = 1000000000000
+
 // VoteManager - vote manager
 type VoteManager struct {
 	storage     model.VoteStorage
@@ -123,17 +127,66 @@ func (vm VoteManager) CanBecomeValidator(ctx sdk.Context, username types.Account
 	return voter.Deposit.IsGTE(param.ValidatorMinVotingDeposit)
 }
 
-// AddVote - voter vote for a proposal
-func (vm VoteManager) AddVote(ctx sdk.Context, proposalID types.ProposalKey, voter types.AccountKey, res bool) sdk.Error {
+// IsDelegator - check if user delegates to any voter
+func (vm VoteManager) IsDelegator(ctx sdk.Context, username types.AccountKey) bool {
+	delegatees, err := vm.storage.GetAllDelegatees(ctx, username)
+	return err == nil && len(delegatees) > 0
+}
+
+// AddVote - voter or delegator vote for a proposal. Delegation of a delegator who votes
+// by itself is moved from votes of its voters to its own vote, and delegation of each
+// delegator is counted at most once for a proposal, so the result doesn't depend on vote
+// order or delegation changes between votes. Returns the part of votes already cast by
+// voters which is overridden by this vote and should be removed from proposal voting status
+func (vm VoteManager) AddVote(
+	ctx sdk.Context, proposalID types.ProposalKey, voter types.AccountKey, res bool) ([]model.Vote, sdk.Error) {
 	// check if the vote exist
 	if vm.DoesVoteExist(ctx, proposalID, voter) {
-		return ErrVoteAlreadyExist()
+		return nil, ErrVoteAlreadyExist()
 	}
 
-	votingPower, err := vm.GetVotingPower(ctx, voter)
+	votingPower := types.NewCoinFromInt64(0)
+	if vm.DoesVoterExist(ctx, voter) {
+		power, err := vm.countVoterVotingPower(ctx, proposalID, voter)
+		if err != nil {
+			return nil, err
+		}
+		votingPower = votingPower.Plus(power)
+	}
+
+	// delegation already counted in votes of voters is moved to this vote
+	overridden := []model.Vote{}
+	countedDelegations, err := vm.storage.GetAllCountedDelegations(ctx, proposalID, voter)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	for _, counted := range countedDelegations {
+		delegateeVote, err := vm.storage.GetVote(ctx, proposalID, counted.Voter)
+		if err != nil {
+			return nil, err
+		}
+		amount := counted.Amount
+		if amount.IsGT(delegateeVote.VotingPower) {
+			amount = delegateeVote.VotingPower
+		}
+		delegateeVote.VotingPower = delegateeVote.VotingPower.Minus(amount)
+		if err := vm.storage.SetVote(ctx, proposalID, counted.Voter, delegateeVote); err != nil {
+			return nil, err
+		}
+		if err := vm.storage.DeleteCountedDelegation(ctx, proposalID, voter, counted.Voter); err != nil {
+			return nil, err
+		}
+		overridden = append(overridden, model.Vote{
+			Voter:       counted.Voter,
+			VotingPower: amount,
+			Result:      delegateeVote.Result,
+		})
 	}
+	totalDelegation, err := vm.getTotalDelegation(ctx, voter)
+	if err != nil {
+		return nil, err
+	}
+	votingPower = votingPower.Plus(totalDelegation)
 
 	vote := model.Vote{
 		Voter:       voter,
@@ -142,9 +195,93 @@ func (vm VoteManager) AddVote(ctx sdk.Context, proposalID types.ProposalKey, vot
 	}
 
 	if err := vm.storage.SetVote(ctx, proposalID, voter, &vote); err != nil {
-		return err
+		return nil, err
 	}
-	return nil
+	return overridden, nil
+}
+
+// countVoterVotingPower - count voting power of voter for a proposal and record the
+// delegation counted for each delegator. Delegation of delegators who already voted by
+// themselves isn't counted, and delegation moved by redelegation after it was counted
+// in the vote of another voter isn't counted again
+func (vm VoteManager) countVoterVotingPower(
+	ctx sdk.Context, proposalID types.ProposalKey, voterName types.AccountKey) (types.Coin, sdk.Error) {
+	voter, err := vm.storage.GetVoter(ctx, voterName)
+	if err != nil {
+		return types.Coin{}, err
+	}
+	votingPower := voter.Deposit
+	delegators, err := vm.storage.GetAllDelegators(ctx, voterName)
+	if err != nil {
+		return types.Coin{}, err
+	}
+	for _, delegator := range delegators {
+		if vm.DoesVoteExist(ctx, proposalID, delegator) {
+			continue
+		}
+		delegation, err := vm.storage.GetDelegation(ctx, voterName, delegator)
+		if err != nil {
+			return types.Coin{}, err
+		}
+		uncounted, err := vm.getUncountedDelegation(ctx, proposalID, delegator)
+		if err != nil {
+			return types.Coin{}, err
+		}
+		amount := delegation.Amount
+		if amount.IsGT(uncounted) {
+			amount = uncounted
+		}
+		if !amount.IsPositive() {
+			continue
+		}
+		if err := vm.storage.SetCountedDelegation(ctx, proposalID, &model.CountedDelegation{
+			Delegator: delegator,
+			Voter:     voterName,
+			Amount:    amount,
+		}); err != nil {
+			return types.Coin{}, err
+		}
+		votingPower = votingPower.Plus(amount)
+	}
+	return votingPower, nil
+}
+
+// getUncountedDelegation - get total delegation of delegator which isn't counted
+// in any vote for a proposal yet
+func (vm VoteManager) getUncountedDelegation(
+	ctx sdk.Context, proposalID types.ProposalKey, delegator types.AccountKey) (types.Coin, sdk.Error) {
+	uncounted, err := vm.getTotalDelegation(ctx, delegator)
+	if err != nil {
+		return types.Coin{}, err
+	}
+	countedDelegations, err := vm.storage.GetAllCountedDelegations(ctx, proposalID, delegator)
+	if err != nil {
+		return types.Coin{}, err
+	}
+	for _, counted := range countedDelegations {
+		uncounted = uncounted.Minus(counted.Amount)
+	}
+	if !uncounted.IsNotNegative() {
+		uncounted = types.NewCoinFromInt64(0)
+	}
+	return uncounted, nil
+}
+
+// getTotalDelegation - get sum of delegation of delegator to all voters
+func (vm VoteManager) getTotalDelegation(ctx sdk.Context, delegator types.AccountKey) (types.Coin, sdk.Error) {
+	total := types.NewCoinFromInt64(0)
+	delegatees, err := vm.storage.GetAllDelegatees(ctx, delegator)
+	if err != nil {
+		return total, err
+	}
+	for _, delegatee := range delegatees {
+		delegation, err := vm.storage.GetDelegation(ctx, delegatee, delegator)
+		if err != nil {
+			return total, err
+		}
+		total = total.Plus(delegation.Amount)
+	}
+	return total, nil
 }
 
 // GetVote - get vote detail based on voter and proposal ID
@@ -173,9 +310,17 @@ func (vm VoteManager) AddDelegation(ctx sdk.Context, voterName types.AccountKey,
 	if err != nil {
 		return err
 	}
+	// settle reward of current amount before delegation changes
+	reward, err := vm.settleDelegatorReward(ctx, voterName, delegatorName, delegation.Amount)
+	if err != nil {
+		return err
+	}
 	voter.DelegatedPower = voter.DelegatedPower.Plus(coin)
 	delegation.Amount = delegation.Amount.Plus(coin)
 
+	if err := vm.storage.SetDelegatorReward(ctx, voterName, delegatorName, reward); err != nil {
+		return err
+	}
 	if err := vm.storage.SetDelegation(ctx, voterName, delegatorName, delegation); err != nil {
 		return err
 	}
@@ -236,6 +381,9 @@ func (vm VoteManager) VoterWithdraw(ctx sdk.Context, username types.AccountKey,
 		if err := vm.storage.DeleteVoter(ctx, username); err != nil {
 			return err
 		}
+		if err := vm.storage.DeleteVoterReward(ctx, username); err != nil {
+			return err
+		}
 	} else {
 		if err := vm.storage.SetVoter(ctx, username, voter); err != nil {
 			return err
@@ -278,8 +426,14 @@ func (vm VoteManager) DelegatorWithdraw(
 	if err != nil {
 		return err
 	}
+	// settle reward of current amount before delegation changes
+	reward, err := vm.settleDelegatorReward(ctx, voterName, delegatorName, delegation.Amount)
+	if err != nil {
+		return err
+	}
 	delegation.Amount = delegation.Amount.Minus(coin)
 
+	// unclaimed reward is kept until claimed even if delegation is revoked
 	if delegation.Amount.IsZero() {
 		if err := vm.storage.DeleteDelegation(ctx, voterName, delegatorName); err != nil {
 			return err
@@ -287,6 +441,9 @@ func (vm VoteManager) DelegatorWithdraw(
 	} else {
 		vm.storage.SetDelegation(ctx, voterName, delegatorName, delegation)
 	}
+	if err := vm.storage.SetDelegatorReward(ctx, voterName, delegatorName, reward); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -303,6 +460,279 @@ func (vm VoteManager) DelegatorWithdrawAll(ctx sdk.Context, voterName types.Acco
 	return delegation.Amount, nil
 }
 
+// Redelegate - move delegation from one voter to another atomically. Redelegation is
+// recorded with previous voter until cooldown ends, during which the moved power can
+// still be slashed for misbehaviour of previous voter and can't be redelegated again
+func (vm VoteManager) Redelegate(
+	ctx sdk.Context, delegatorName types.AccountKey, fromVoter types.AccountKey,
+	toVoter types.AccountKey, coin types.Coin) sdk.Error {
+	if fromVoter == toVoter || !coin.IsPositive() {
+		return ErrInvalidRedelegation()
+	}
+	if !vm.DoesVoterExist(ctx, toVoter) {
+		return model.ErrVoterNotFound()
+	}
+	delegation, err := vm.storage.GetDelegation(ctx, fromVoter, delegatorName)
+	if err != nil {
+		return err
+	}
+	if !delegation.Amount.IsGTE(coin) {
+		return ErrInvalidRedelegation()
+	}
+
+	param, err := vm.paramHolder.GetVoteParam(ctx)
+	if err != nil {
+		return err
+	}
+	redelegations, err := vm.GetRedelegations(ctx, delegatorName)
+	if err != nil {
+		return err
+	}
+	for _, redelegation := range redelegations {
+		if redelegation.ToVoter == fromVoter {
+			return ErrRedelegationInCooldown()
+		}
+	}
+	if int64(len(redelegations)) >= param.MaxRedelegations {
+		return ErrTooManyRedelegations()
+	}
+
+	if err := vm.DelegatorWithdraw(ctx, fromVoter, delegatorName, coin); err != nil {
+		return err
+	}
+	if err := vm.AddDelegation(ctx, toVoter, delegatorName, coin); err != nil {
+		return err
+	}
+
+	now := ctx.BlockHeader().Time.Unix()
+	redelegations = append(redelegations, model.Redelegation{
+		Delegator:     delegatorName,
+		FromVoter:     fromVoter,
+		ToVoter:       toVoter,
+		Amount:        coin,
+		CreatedHeight: ctx.BlockHeight(),
+		CreatedAt:     now,
+		CompleteAt:    now + param.RedelegationCooldownSec,
+	})
+	return vm.storage.SetRedelegationList(
+		ctx, delegatorName, &model.RedelegationList{Redelegations: redelegations})
+}
+
+// GetRedelegations - get redelegations of a delegator still in cooldown
+func (vm VoteManager) GetRedelegations(
+	ctx sdk.Context, delegatorName types.AccountKey) ([]model.Redelegation, sdk.Error) {
+	lst, err := vm.storage.GetRedelegationList(ctx, delegatorName)
+	if err != nil || lst == nil {
+		return []model.Redelegation{}, err
+	}
+	now := ctx.BlockHeader().Time.Unix()
+	redelegations := []model.Redelegation{}
+	for _, redelegation := range lst.Redelegations {
+		if redelegation.CompleteAt > now {
+			redelegations = append(redelegations, redelegation)
+		}
+	}
+	return redelegations, nil
+}
+
+// PruneRedelegations - remove redelegations of a delegator whose cooldown has ended
+// from KVStore, index under source voters is rewritten with the remaining ones
+func (vm VoteManager) PruneRedelegations(ctx sdk.Context, delegatorName types.AccountKey) sdk.Error {
+	lst, err := vm.storage.GetRedelegationList(ctx, delegatorName)
+	if err != nil || lst == nil {
+		return err
+	}
+	redelegations, err := vm.GetRedelegations(ctx, delegatorName)
+	if err != nil {
+		return err
+	}
+	if len(redelegations) == len(lst.Redelegations) {
+		return nil
+	}
+	if len(redelegations) == 0 {
+		return vm.storage.DeleteRedelegationList(ctx, delegatorName)
+	}
+	return vm.storage.SetRedelegationList(
+		ctx, delegatorName, &model.RedelegationList{Redelegations: redelegations})
+}
+
+// GetSlashableRedelegations - get redelegations in cooldown moved away from a voter
+// at or after infraction height, which should be slashed with misbehaviour of the voter
+func (vm VoteManager) GetSlashableRedelegations(
+	ctx sdk.Context, voterName types.AccountKey, infractionHeight int64) ([]model.Redelegation, sdk.Error) {
+	delegators, err := vm.storage.GetAllRedelegators(ctx, voterName)
+	if err != nil {
+		return nil, err
+	}
+	redelegations := []model.Redelegation{}
+	for _, delegator := range delegators {
+		lst, err := vm.GetRedelegations(ctx, delegator)
+		if err != nil {
+			return nil, err
+		}
+		for _, redelegation := range lst {
+			if redelegation.FromVoter == voterName && redelegation.CreatedHeight >= infractionHeight {
+				redelegations = append(redelegations, redelegation)
+			}
+		}
+	}
+	return redelegations, nil
+}
+
+// SlashRedelegations - slash fraction of redelegations moved away from a misbehaving voter
+// since infraction height. Slash is taken from delegation to the voter it's redelegated to,
+// capped by the remaining delegation, and the total slashed coin is returned
+func (vm VoteManager) SlashRedelegations(
+	ctx sdk.Context, voterName types.AccountKey, infractionHeight int64, fraction sdk.Rat) (types.Coin, sdk.Error) {
+	totalSlash := types.NewCoinFromInt64(0)
+	redelegations, err := vm.GetSlashableRedelegations(ctx, voterName, infractionHeight)
+	if err != nil {
+		return totalSlash, err
+	}
+	for _, redelegation := range redelegations {
+		// delegation may have been withdrawn after redelegation
+		if !vm.DoesDelegationExist(ctx, redelegation.ToVoter, redelegation.Delegator) {
+			continue
+		}
+		delegation, err := vm.storage.GetDelegation(ctx, redelegation.ToVoter, redelegation.Delegator)
+		if err != nil {
+			return totalSlash, err
+		}
+		slash := types.RatToCoin(redelegation.Amount.ToRat().Mul(fraction))
+		if slash.IsGT(delegation.Amount) {
+			slash = delegation.Amount
+		}
+		if !slash.IsPositive() {
+			continue
+		}
+		if err := vm.DelegatorWithdraw(ctx, redelegation.ToVoter, redelegation.Delegator, slash); err != nil {
+			return totalSlash, err
+		}
+		totalSlash = totalSlash.Plus(slash)
+	}
+	return totalSlash, nil
+}
+
+// DistributeDelegatorReward - split reward between voter and its delegators pro rata by
+// voter deposit and delegated power. Delegators' part only increases reward per power of
+// the voter and is settled to each delegation lazily, voter's part is returned
+func (vm VoteManager) DistributeDelegatorReward(
+	ctx sdk.Context, voterName types.AccountKey, reward types.Coin) (types.Coin, sdk.Error) {
+	if !vm.storage.DoesVoterExist(ctx, voterName) {
+		return reward, nil
+	}
+	voter, err := vm.storage.GetVoter(ctx, voterName)
+	if err != nil {
+		return reward, err
+	}
+	if voter.DelegatedPower.IsZero() || reward.IsZero() {
+		return reward, nil
+	}
+
+	totalPower := voter.Deposit.Plus(voter.DelegatedPower)
+	delegatorsReward := types.RatToCoin(
+		reward.ToRat().Mul(voter.DelegatedPower.ToRat()).Quo(totalPower.ToRat()))
+	voterReward, err := vm.getVoterReward(ctx, voterName)
+	if err != nil {
+		return reward, err
+	}
+	voterReward.RewardPerPower = voterReward.RewardPerPower.Add(
+		delegatorsReward.ToRat().Quo(voter.DelegatedPower.ToRat())).Round(rewardPerPowerPrecision)
+	voterReward.TotalDistributed = voterReward.TotalDistributed.Plus(delegatorsReward)
+	if err := vm.storage.SetVoterReward(ctx, voterName, voterReward); err != nil {
+		return reward, err
+	}
+	return reward.Minus(delegatorsReward), nil
+}
+
+// ClaimDelegatorReward - settle and clear unclaimed reward of a delegation,
+// caller should add the claimed reward to delegator
+func (vm VoteManager) ClaimDelegatorReward(
+	ctx sdk.Context, voterName types.AccountKey, delegatorName types.AccountKey) (types.Coin, sdk.Error) {
+	amount := types.NewCoinFromInt64(0)
+	if vm.storage.DoesDelegationExist(ctx, voterName, delegatorName) {
+		delegation, err := vm.storage.GetDelegation(ctx, voterName, delegatorName)
+		if err != nil {
+			return types.NewCoinFromInt64(0), err
+		}
+		amount = delegation.Amount
+	}
+	reward, err := vm.settleDelegatorReward(ctx, voterName, delegatorName, amount)
+	if err != nil {
+		return types.NewCoinFromInt64(0), err
+	}
+	claimed := reward.UnclaimedReward
+	if amount.IsZero() {
+		// delegation has been revoked, nothing to settle anymore
+		if err := vm.storage.DeleteDelegatorReward(ctx, voterName, delegatorName); err != nil {
+			return types.NewCoinFromInt64(0), err
+		}
+		return claimed, nil
+	}
+	reward.UnclaimedReward = types.NewCoinFromInt64(0)
+	if err := vm.storage.SetDelegatorReward(ctx, voterName, delegatorName, reward); err != nil {
+		return types.NewCoinFromInt64(0), err
+	}
+	return claimed, nil
+}
+
+// GetDelegatorReward - get unclaimed reward of a delegation including reward not settled yet
+func (vm VoteManager) GetDelegatorReward(
+	ctx sdk.Context, voterName types.AccountKey, delegatorName types.AccountKey) (types.Coin, sdk.Error) {
+	amount := types.NewCoinFromInt64(0)
+	if vm.storage.DoesDelegationExist(ctx, voterName, delegatorName) {
+		delegation, err := vm.storage.GetDelegation(ctx, voterName, delegatorName)
+		if err != nil {
+			return types.NewCoinFromInt64(0), err
+		}
+		amount = delegation.Amount
+	}
+	reward, err := vm.settleDelegatorReward(ctx, voterName, delegatorName, amount)
+	if err != nil {
+		return types.NewCoinFromInt64(0), err
+	}
+	return reward.UnclaimedReward, nil
+}
+
+// settleDelegatorReward - settle reward accumulated since last settlement to unclaimed
+// reward of the delegation, amount is the delegation amount during accumulation
+func (vm VoteManager) settleDelegatorReward(
+	ctx sdk.Context, voterName types.AccountKey, delegatorName types.AccountKey,
+	amount types.Coin) (*model.DelegatorReward, sdk.Error) {
+	voterReward, err := vm.getVoterReward(ctx, voterName)
+	if err != nil {
+		return nil, err
+	}
+	reward, err := vm.storage.GetDelegatorReward(ctx, voterName, delegatorName)
+	if err != nil {
+		return nil, err
+	}
+	if reward == nil {
+		reward = &model.DelegatorReward{
+			LastRewardPerPower: sdk.ZeroRat(),
+			UnclaimedReward:    types.NewCoinFromInt64(0),
+		}
+	}
+	accumulated := voterReward.RewardPerPower.Sub(reward.LastRewardPerPower)
+	reward.UnclaimedReward = reward.UnclaimedReward.Plus(types.RatToCoin(amount.ToRat().Mul(accumulated)))
+	reward.LastRewardPerPower = voterReward.RewardPerPower
+	return reward, nil
+}
+
+func (vm VoteManager) getVoterReward(ctx sdk.Context, voterName types.AccountKey) (*model.VoterReward, sdk.Error) {
+	voterReward, err := vm.storage.GetVoterReward(ctx, voterName)
+	if err != nil {
+		return nil, err
+	}
+	if voterReward == nil {
+		voterReward = &model.VoterReward{
+			RewardPerPower:   sdk.ZeroRat(),
+			TotalDistributed: types.NewCoinFromInt64(0),
+		}
+	}
+	return voterReward, nil
+}
+
 // GetVotingPower - get voter voting power
 func (vm VoteManager) GetVotingPower(ctx sdk.Context, voterName types.AccountKey) (types.Coin, sdk.Error) {
 	voter, err := vm.storage.GetVoter(ctx, voterName)
@@ -335,8 +765,6 @@ func (vm VoteManager) GetPenaltyList(
 				break
 			}
 		}
-		// TODO: Decide if we wanna delete vote.
-		// vm.storage.DeleteVote(ctx, proposalID, vote.Voter)
 	}
 
 	// put all validators who didn't vote on these two types proposal into penalty list
@@ -346,6 +774,11 @@ func (vm VoteManager) GetPenaltyList(
 	return penaltyList, nil
 }
 
+// DeleteProposalVotes - delete all votes and counted delegations of a decided proposal
+func (vm VoteManager) DeleteProposalVotes(ctx sdk.Context, proposalID types.ProposalKey) sdk.Error {
+	return vm.storage.DeleteProposalVotes(ctx, proposalID)
+}
+
 // GetVoterDeposit - get voter deposit
 func (vm VoteManager) GetVoterDeposit(ctx sdk.Context, accKey types.AccountKey) (types.Coin, sdk.Error) {
 	voter, err := vm.storage.GetVoter(ctx, accKey)
@@ -1,13 +1,16 @@
 package vote
 
 import (
+	"strconv"
 	"testing"
+	"time"
 
 	"github.com/lino-network/lino/types"
 	"github.com/lino-network/lino/x/vote/model"
 	"github.com/stretchr/testify/assert"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
 )
 
 func TestAddVoter(t *testing.T) {
@@ -274,3 +277,454 @@ func TestIsLegalDelegatorWithdraw(t *testing.T) {
 		}
 	}
 }
+
+func TestDistributeDelegatorReward(t *testing.T) {
+	ctx, _, vm, _ := setupTest(t, 0)
+	voteParam, _ := vm.paramHolder.GetVoteParam(ctx)
+	user1 := types.AccountKey("user1")
+	user2 := types.AccountKey("user2")
+	user3 := types.AccountKey("user3")
+	vm.AddVoter(ctx, user1, voteParam.VoterMinDeposit)
+
+	// voter without delegation keeps all reward
+	voterReward, err := vm.DistributeDelegatorReward(ctx, user1, types.NewCoinFromInt64(1000))
+	assert.Nil(t, err)
+	assert.Equal(t, types.NewCoinFromInt64(1000), voterReward)
+
+	// user2 and user3 delegate 3 and 1 times of voter deposit
+	deposit := voteParam.VoterMinDeposit
+	vm.AddDelegation(ctx, user1, user2, types.RatToCoin(deposit.ToRat().Mul(sdk.NewRat(3))))
+	vm.AddDelegation(ctx, user1, user3, deposit)
+
+	testCases := []struct {
+		testName          string
+		reward            types.Coin
+		addDelegation     types.Coin
+		expectVoterReward types.Coin
+		expectUser2Reward types.Coin
+		expectUser3Reward types.Coin
+	}{
+		{
+			testName:          "reward shared by voter and delegators pro rata",
+			reward:            types.NewCoinFromInt64(1000),
+			addDelegation:     deposit,
+			expectVoterReward: types.NewCoinFromInt64(200),
+			expectUser2Reward: types.NewCoinFromInt64(600),
+			expectUser3Reward: types.NewCoinFromInt64(200),
+		},
+		{
+			testName:          "reward settled before user3 delegation changes",
+			reward:            types.NewCoinFromInt64(600),
+			addDelegation:     types.NewCoinFromInt64(0),
+			expectVoterReward: types.NewCoinFromInt64(100),
+			expectUser2Reward: types.NewCoinFromInt64(900),
+			expectUser3Reward: types.NewCoinFromInt64(400),
+		},
+	}
+	for _, tc := range testCases {
+		voterReward, err := vm.DistributeDelegatorReward(ctx, user1, tc.reward)
+		if err != nil {
+			t.Errorf("%s: failed to distribute reward, got err %v", tc.testName, err)
+		}
+		if !voterReward.IsEqual(tc.expectVoterReward) {
+			t.Errorf("%s: diff voter reward, got %v, want %v", tc.testName, voterReward, tc.expectVoterReward)
+		}
+		if !tc.addDelegation.IsZero() {
+			vm.AddDelegation(ctx, user1, user3, tc.addDelegation)
+		}
+		user2Reward, _ := vm.GetDelegatorReward(ctx, user1, user2)
+		if !user2Reward.IsEqual(tc.expectUser2Reward) {
+			t.Errorf("%s: diff user2 reward, got %v, want %v", tc.testName, user2Reward, tc.expectUser2Reward)
+		}
+		user3Reward, _ := vm.GetDelegatorReward(ctx, user1, user3)
+		if !user3Reward.IsEqual(tc.expectUser3Reward) {
+			t.Errorf("%s: diff user3 reward, got %v, want %v", tc.testName, user3Reward, tc.expectUser3Reward)
+		}
+	}
+
+	// reward is cleared after claim
+	claimed, err := vm.ClaimDelegatorReward(ctx, user1, user2)
+	assert.Nil(t, err)
+	assert.Equal(t, types.NewCoinFromInt64(900), claimed)
+	claimed, err = vm.ClaimDelegatorReward(ctx, user1, user2)
+	assert.Nil(t, err)
+	assert.Equal(t, types.NewCoinFromInt64(0), claimed)
+
+	// unclaimed reward is kept after delegation revoked
+	_, err = vm.DelegatorWithdrawAll(ctx, user1, user3)
+	assert.Nil(t, err)
+	claimed, err = vm.ClaimDelegatorReward(ctx, user1, user3)
+	assert.Nil(t, err)
+	assert.Equal(t, types.NewCoinFromInt64(400), claimed)
+	reward, err := vm.storage.GetDelegatorReward(ctx, user1, user3)
+	assert.Nil(t, err)
+	assert.Nil(t, reward)
+}
+
+func TestRedelegate(t *testing.T) {
+	ctx, _, vm, _ := setupTest(t, 10)
+	voteParam, _ := vm.paramHolder.GetVoteParam(ctx)
+	user1 := types.AccountKey("user1")
+	user2 := types.AccountKey("user2")
+	user3 := types.AccountKey("user3")
+	delegator := types.AccountKey("delegator")
+	vm.AddVoter(ctx, user1, voteParam.VoterMinDeposit)
+	vm.AddVoter(ctx, user2, voteParam.VoterMinDeposit)
+	vm.AddDelegation(ctx, user1, delegator, types.NewCoinFromInt64(100))
+
+	testCases := []struct {
+		testName  string
+		fromVoter types.AccountKey
+		toVoter   types.AccountKey
+		amount    types.Coin
+		expectErr sdk.Error
+	}{
+		{
+			testName:  "redelegate to same voter",
+			fromVoter: user1,
+			toVoter:   user1,
+			amount:    types.NewCoinFromInt64(60),
+			expectErr: ErrInvalidRedelegation(),
+		},
+		{
+			testName:  "redelegate to non-voter",
+			fromVoter: user1,
+			toVoter:   user3,
+			amount:    types.NewCoinFromInt64(60),
+			expectErr: model.ErrVoterNotFound(),
+		},
+		{
+			testName:  "redelegate more than delegation",
+			fromVoter: user1,
+			toVoter:   user2,
+			amount:    types.NewCoinFromInt64(101),
+			expectErr: ErrInvalidRedelegation(),
+		},
+		{
+			testName:  "normal case",
+			fromVoter: user1,
+			toVoter:   user2,
+			amount:    types.NewCoinFromInt64(60),
+			expectErr: nil,
+		},
+		{
+			testName:  "redelegated power can't be moved again in cooldown",
+			fromVoter: user2,
+			toVoter:   user1,
+			amount:    types.NewCoinFromInt64(60),
+			expectErr: ErrRedelegationInCooldown(),
+		},
+	}
+	for _, tc := range testCases {
+		err := vm.Redelegate(ctx, delegator, tc.fromVoter, tc.toVoter, tc.amount)
+		if !assert.Equal(t, tc.expectErr, err) {
+			t.Errorf("%s: diff err, got %v, want %v", tc.testName, err, tc.expectErr)
+		}
+	}
+
+	delegation, _ := vm.storage.GetDelegation(ctx, user1, delegator)
+	assert.Equal(t, types.NewCoinFromInt64(40), delegation.Amount)
+	delegation, _ = vm.storage.GetDelegation(ctx, user2, delegator)
+	assert.Equal(t, types.NewCoinFromInt64(60), delegation.Amount)
+	power, _ := vm.GetVotingPower(ctx, user2)
+	assert.Equal(t, voteParam.VoterMinDeposit.Plus(types.NewCoinFromInt64(60)), power)
+
+	// redelegation is slashable for misbehaviour of previous voter before the move
+	expect := model.Redelegation{
+		Delegator:     delegator,
+		FromVoter:     user1,
+		ToVoter:       user2,
+		Amount:        types.NewCoinFromInt64(60),
+		CreatedHeight: 10,
+		CreatedAt:     ctx.BlockHeader().Time.Unix(),
+		CompleteAt:    ctx.BlockHeader().Time.Unix() + voteParam.RedelegationCooldownSec,
+	}
+	redelegations, err := vm.GetSlashableRedelegations(ctx, user1, 9)
+	assert.Nil(t, err)
+	assert.Equal(t, []model.Redelegation{expect}, redelegations)
+	redelegations, err = vm.GetSlashableRedelegations(ctx, user1, 11)
+	assert.Nil(t, err)
+	assert.Equal(t, []model.Redelegation{}, redelegations)
+	redelegations, err = vm.GetSlashableRedelegations(ctx, user2, 9)
+	assert.Nil(t, err)
+	assert.Equal(t, []model.Redelegation{}, redelegations)
+
+	// redelegations in cooldown are capped
+	for i := int64(1); i < voteParam.MaxRedelegations; i++ {
+		voter := types.AccountKey("voter" + strconv.FormatInt(i, 10))
+		vm.AddVoter(ctx, voter, voteParam.VoterMinDeposit)
+		err := vm.Redelegate(ctx, delegator, user1, voter, types.NewCoinFromInt64(1))
+		assert.Nil(t, err)
+	}
+	vm.AddVoter(ctx, user3, voteParam.VoterMinDeposit)
+	err = vm.Redelegate(ctx, delegator, user1, user3, types.NewCoinFromInt64(1))
+	assert.Equal(t, ErrTooManyRedelegations(), err)
+
+	// redelegation completes after cooldown
+	ctx = ctx.WithBlockHeader(abci.Header{
+		Time: ctx.BlockHeader().Time.Add(time.Duration(voteParam.RedelegationCooldownSec) * time.Second)})
+	redelegations, err = vm.GetRedelegations(ctx, delegator)
+	assert.Nil(t, err)
+	assert.Equal(t, []model.Redelegation{}, redelegations)
+	redelegations, err = vm.GetSlashableRedelegations(ctx, user1, 9)
+	assert.Nil(t, err)
+	assert.Equal(t, []model.Redelegation{}, redelegations)
+	err = vm.Redelegate(ctx, delegator, user2, user1, types.NewCoinFromInt64(60))
+	assert.Nil(t, err)
+	redelegations, err = vm.GetRedelegations(ctx, delegator)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(redelegations))
+}
+
+func TestPruneRedelegations(t *testing.T) {
+	ctx, _, vm, _ := setupTest(t, 10)
+	voteParam, _ := vm.paramHolder.GetVoteParam(ctx)
+	user1 := types.AccountKey("user1")
+	user2 := types.AccountKey("user2")
+	user3 := types.AccountKey("user3")
+	delegator := types.AccountKey("delegator")
+	vm.AddVoter(ctx, user1, voteParam.VoterMinDeposit)
+	vm.AddVoter(ctx, user2, voteParam.VoterMinDeposit)
+	vm.AddVoter(ctx, user3, voteParam.VoterMinDeposit)
+	vm.AddDelegation(ctx, user1, delegator, types.NewCoinFromInt64(100))
+	vm.AddDelegation(ctx, user2, delegator, types.NewCoinFromInt64(100))
+
+	err := vm.Redelegate(ctx, delegator, user1, user3, types.NewCoinFromInt64(100))
+	assert.Nil(t, err)
+	ctx = ctx.WithBlockHeader(abci.Header{Time: ctx.BlockHeader().Time.Add(time.Hour)})
+	err = vm.Redelegate(ctx, delegator, user2, user3, types.NewCoinFromInt64(100))
+	assert.Nil(t, err)
+
+	// nothing is pruned in cooldown
+	err = vm.PruneRedelegations(ctx, delegator)
+	assert.Nil(t, err)
+	lst, _ := vm.storage.GetRedelegationList(ctx, delegator)
+	assert.Equal(t, 2, len(lst.Redelegations))
+
+	// first redelegation and its index are dropped after its cooldown
+	ctx = ctx.WithBlockHeader(abci.Header{
+		Time: ctx.BlockHeader().Time.Add(time.Duration(voteParam.RedelegationCooldownSec)*time.Second - time.Hour)})
+	err = vm.PruneRedelegations(ctx, delegator)
+	assert.Nil(t, err)
+	lst, _ = vm.storage.GetRedelegationList(ctx, delegator)
+	assert.Equal(t, 1, len(lst.Redelegations))
+	assert.Equal(t, user2, lst.Redelegations[0].FromVoter)
+	redelegators, _ := vm.storage.GetAllRedelegators(ctx, user1)
+	assert.Equal(t, 0, len(redelegators))
+	redelegators, _ = vm.storage.GetAllRedelegators(ctx, user2)
+	assert.Equal(t, []types.AccountKey{delegator}, redelegators)
+
+	// list is deleted after all cooldowns end
+	ctx = ctx.WithBlockHeader(abci.Header{Time: ctx.BlockHeader().Time.Add(time.Hour)})
+	err = vm.PruneRedelegations(ctx, delegator)
+	assert.Nil(t, err)
+	lst, _ = vm.storage.GetRedelegationList(ctx, delegator)
+	assert.Nil(t, lst)
+	redelegators, _ = vm.storage.GetAllRedelegators(ctx, user2)
+	assert.Equal(t, 0, len(redelegators))
+}
+
+func TestSlashRedelegations(t *testing.T) {
+	ctx, _, vm, _ := setupTest(t, 10)
+	voteParam, _ := vm.paramHolder.GetVoteParam(ctx)
+	user1 := types.AccountKey("user1")
+	user2 := types.AccountKey("user2")
+	user3 := types.AccountKey("user3")
+	delegator1 := types.AccountKey("delegator1")
+	delegator2 := types.AccountKey("delegator2")
+	vm.AddVoter(ctx, user1, voteParam.VoterMinDeposit)
+	vm.AddVoter(ctx, user2, voteParam.VoterMinDeposit)
+	vm.AddVoter(ctx, user3, voteParam.VoterMinDeposit)
+	vm.AddDelegation(ctx, user1, delegator1, types.NewCoinFromInt64(100))
+	vm.AddDelegation(ctx, user1, delegator2, types.NewCoinFromInt64(100))
+	vm.AddDelegation(ctx, user3, delegator2, types.NewCoinFromInt64(100))
+
+	// delegator2 withdraws most of redelegated power before slash
+	err := vm.Redelegate(ctx, delegator1, user1, user2, types.NewCoinFromInt64(100))
+	assert.Nil(t, err)
+	err = vm.Redelegate(ctx, delegator2, user1, user2, types.NewCoinFromInt64(100))
+	assert.Nil(t, err)
+	err = vm.DelegatorWithdraw(ctx, user2, delegator2, types.NewCoinFromInt64(95))
+	assert.Nil(t, err)
+	err = vm.Redelegate(ctx, delegator2, user3, user1, types.NewCoinFromInt64(100))
+	assert.Nil(t, err)
+
+	slash, err := vm.SlashRedelegations(ctx, user1, 11, sdk.NewRat(1, 10))
+	assert.Nil(t, err)
+	assert.Equal(t, types.NewCoinFromInt64(0), slash)
+
+	slash, err = vm.SlashRedelegations(ctx, user1, 9, sdk.NewRat(1, 10))
+	assert.Nil(t, err)
+	assert.Equal(t, types.NewCoinFromInt64(15), slash)
+	delegation, _ := vm.storage.GetDelegation(ctx, user2, delegator1)
+	assert.Equal(t, types.NewCoinFromInt64(90), delegation.Amount)
+	assert.False(t, vm.DoesDelegationExist(ctx, user2, delegator2))
+	power, _ := vm.GetVotingPower(ctx, user2)
+	assert.Equal(t, voteParam.VoterMinDeposit.Plus(types.NewCoinFromInt64(90)), power)
+
+	// redelegation to the slashed voter isn't affected
+	delegation, _ = vm.storage.GetDelegation(ctx, user1, delegator2)
+	assert.Equal(t, types.NewCoinFromInt64(100), delegation.Amount)
+}
+
+func TestAddVoteWithDelegatorOverride(t *testing.T) {
+	ctx, _, vm, _ := setupTest(t, 0)
+	voteParam, _ := vm.paramHolder.GetVoteParam(ctx)
+	user1 := types.AccountKey("user1")
+	user2 := types.AccountKey("user2")
+	user3 := types.AccountKey("user3")
+	deposit := voteParam.VoterMinDeposit
+	vm.AddVoter(ctx, user1, deposit)
+	vm.AddVoter(ctx, user2, deposit)
+	vm.AddDelegation(ctx, user1, user3, types.NewCoinFromInt64(100))
+	vm.AddDelegation(ctx, user2, user3, types.NewCoinFromInt64(200))
+	proposalID := types.ProposalKey("1")
+
+	// user1 votes before delegator
+	overridden, err := vm.AddVote(ctx, proposalID, user1, true)
+	assert.Nil(t, err)
+	assert.Equal(t, []model.Vote{}, overridden)
+
+	// delegator overrides user1 and user2
+	overridden, err = vm.AddVote(ctx, proposalID, user3, false)
+	assert.Nil(t, err)
+	assert.Equal(t, []model.Vote{
+		{Voter: user1, VotingPower: types.NewCoinFromInt64(100), Result: true}}, overridden)
+	vote, _ := vm.GetVote(ctx, proposalID, user3)
+	assert.Equal(t, types.NewCoinFromInt64(300), vote.VotingPower)
+	vote, _ = vm.GetVote(ctx, proposalID, user1)
+	assert.Equal(t, deposit, vote.VotingPower)
+
+	// user2 votes after delegator without its delegation
+	overridden, err = vm.AddVote(ctx, proposalID, user2, true)
+	assert.Nil(t, err)
+	assert.Equal(t, []model.Vote{}, overridden)
+	vote, _ = vm.GetVote(ctx, proposalID, user2)
+	assert.Equal(t, deposit, vote.VotingPower)
+
+	_, err = vm.AddVote(ctx, proposalID, user3, true)
+	assert.Equal(t, ErrVoteAlreadyExist(), err)
+	assert.True(t, vm.IsDelegator(ctx, user3))
+	assert.False(t, vm.IsDelegator(ctx, user1))
+}
+
+func TestAddVoteWithDelegationChange(t *testing.T) {
+	ctx, _, vm, _ := setupTest(t, 0)
+	voteParam, _ := vm.paramHolder.GetVoteParam(ctx)
+	user1 := types.AccountKey("user1")
+	user2 := types.AccountKey("user2")
+	delegator := types.AccountKey("delegator")
+	deposit := voteParam.VoterMinDeposit
+	delegation := types.NewCoinFromInt64(100)
+	vm.AddVoter(ctx, user1, deposit)
+	vm.AddVoter(ctx, user2, deposit)
+
+	testCases := []struct {
+		testName             string
+		delegatorFirst       bool
+		change               func(ctx sdk.Context) sdk.Error
+		expectDelegatorPower types.Coin
+		expectUser1Power     types.Coin
+		expectUser2Power     types.Coin
+	}{
+		{
+			testName:       "delegator votes and withdraws before voter votes",
+			delegatorFirst: true,
+			change: func(ctx sdk.Context) sdk.Error {
+				return vm.DelegatorWithdraw(ctx, user1, delegator, delegation)
+			},
+			expectDelegatorPower: delegation,
+			expectUser1Power:     deposit,
+			expectUser2Power:     deposit,
+		},
+		{
+			testName:       "delegator votes and redelegates before voter votes",
+			delegatorFirst: true,
+			change: func(ctx sdk.Context) sdk.Error {
+				return vm.Redelegate(ctx, delegator, user1, user2, delegation)
+			},
+			expectDelegatorPower: delegation,
+			expectUser1Power:     deposit,
+			expectUser2Power:     deposit,
+		},
+		{
+			testName:       "voter votes and delegator withdraws before delegator votes",
+			delegatorFirst: false,
+			change: func(ctx sdk.Context) sdk.Error {
+				return vm.DelegatorWithdraw(ctx, user1, delegator, delegation)
+			},
+			expectDelegatorPower: types.NewCoinFromInt64(0),
+			expectUser1Power:     deposit,
+			expectUser2Power:     deposit,
+		},
+		{
+			testName:       "voter votes and delegator redelegates before delegator votes",
+			delegatorFirst: false,
+			change: func(ctx sdk.Context) sdk.Error {
+				return vm.Redelegate(ctx, delegator, user1, user2, delegation)
+			},
+			expectDelegatorPower: delegation,
+			expectUser1Power:     deposit,
+			expectUser2Power:     deposit,
+		},
+	}
+
+	for i, tc := range testCases {
+		proposalID := types.ProposalKey(strconv.Itoa(i + 1))
+		for _, voter := range []types.AccountKey{user1, user2} {
+			if vm.DoesDelegationExist(ctx, voter, delegator) {
+				vm.DelegatorWithdrawAll(ctx, voter, delegator)
+			}
+		}
+		vm.storage.DeleteRedelegationList(ctx, delegator)
+		vm.AddDelegation(ctx, user1, delegator, delegation)
+
+		// sum of votes of user1, user2 and delegator for the proposal
+		tally := types.NewCoinFromInt64(0)
+		addVote := func(voter types.AccountKey) {
+			overridden, err := vm.AddVote(ctx, proposalID, voter, true)
+			if err != nil {
+				t.Errorf("%s: failed to add vote of %v, got err %v", tc.testName, voter, err)
+			}
+			vote, _ := vm.GetVote(ctx, proposalID, voter)
+			tally = tally.Plus(vote.VotingPower)
+			for _, o := range overridden {
+				tally = tally.Minus(o.VotingPower)
+			}
+		}
+		if tc.delegatorFirst {
+			addVote(delegator)
+		} else {
+			addVote(user1)
+		}
+		if err := tc.change(ctx); err != nil {
+			t.Errorf("%s: failed to change delegation, got err %v", tc.testName, err)
+		}
+		if tc.delegatorFirst {
+			addVote(user1)
+			addVote(user2)
+		} else {
+			addVote(user2)
+			addVote(delegator)
+		}
+
+		for _, expect := range []struct {
+			voter types.AccountKey
+			power types.Coin
+		}{
+			{delegator, tc.expectDelegatorPower},
+			{user1, tc.expectUser1Power},
+			{user2, tc.expectUser2Power},
+		} {
+			vote, _ := vm.GetVote(ctx, proposalID, expect.voter)
+			if !vote.VotingPower.IsEqual(expect.power) {
+				t.Errorf("%s: diff voting power of %v, got %v, want %v",
+					tc.testName, expect.voter, vote.VotingPower, expect.power)
+			}
+		}
+		expectTally := tc.expectDelegatorPower.Plus(tc.expectUser1Power).Plus(tc.expectUser2Power)
+		if !tally.IsEqual(expectTally) {
+			t.Errorf("%s: diff tally, got %v, want %v", tc.testName, tally, expectTally)
+		}
+	}
+}
@@ -47,6 +47,26 @@ func ErrFailedToMarshalReferenceList(err error) sdk.Error {
 	return types.NewError(types.CodeFailedToMarshalReferenceList, fmt.Sprintf("failed to marshal reference list: %s", err.Error()))
 }
 
+// ErrFailedToMarshalVoterReward - error if marshal voter reward failed
+func ErrFailedToMarshalVoterReward(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalVoterReward, fmt.Sprintf("failed to marshal voter reward: %s", err.Error()))
+}
+
+// ErrFailedToMarshalDelegatorReward - error if marshal delegator reward failed
+func ErrFailedToMarshalDelegatorReward(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalDelegatorReward, fmt.Sprintf("failed to marshal delegator reward: %s", err.Error()))
+}
+
+// ErrFailedToMarshalRedelegationList - error if marshal redelegation list failed
+func ErrFailedToMarshalRedelegationList(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalRedelegationList, fmt.Sprintf("failed to marshal redelegation list: %s", err.Error()))
+}
+
+// ErrFailedToMarshalCountedDelegation - error if marshal counted delegation failed
+func ErrFailedToMarshalCountedDelegation(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalCountedDelegation, fmt.Sprintf("failed to marshal counted delegation: %s", err.Error()))
+}
+
 // ErrFailedToUnmarshalVoter - error if unmarshal voter failed
 func ErrFailedToUnmarshalVoter(err error) sdk.Error {
 	return types.NewError(types.CodeFailedToUnmarshalVoter, fmt.Sprintf("failed to unmarshal voter: %s", err.Error()))
@@ -66,3 +86,23 @@ func ErrFailedToUnmarshalDelegation(err error) sdk.Error {
 func ErrFailedToUnmarshalReferenceList(err error) sdk.Error {
 	return types.NewError(types.CodeFailedToUnmarshalReferenceList, fmt.Sprintf("failed to unmarshal reference list: %s", err.Error()))
 }
+
+// ErrFailedToUnmarshalVoterReward - error if unmarshal voter reward failed
+func ErrFailedToUnmarshalVoterReward(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalVoterReward, fmt.Sprintf("failed to unmarshal voter reward: %s", err.Error()))
+}
+
+// ErrFailedToUnmarshalDelegatorReward - error if unmarshal delegator reward failed
+func ErrFailedToUnmarshalDelegatorReward(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalDelegatorReward, fmt.Sprintf("failed to unmarshal delegator reward: %s", err.Error()))
+}
+
+// ErrFailedToUnmarshalRedelegationList - error if unmarshal redelegation list failed
+func ErrFailedToUnmarshalRedelegationList(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalRedelegationList, fmt.Sprintf("failed to unmarshal redelegation list: %s", err.Error()))
+}
+
+// ErrFailedToUnmarshalCountedDelegation - error if unmarshal counted delegation failed
+func ErrFailedToUnmarshalCountedDelegation(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalCountedDelegation, fmt.Sprintf("failed to unmarshal counted delegation: %s", err.Error()))
+}
@@ -8,11 +8,16 @@ import (
 )
 
 var (
-	delegationSubstore    = []byte{0x00}
-	voterSubstore         = []byte{0x01}
-	voteSubstore          = []byte{0x02}
-	referenceListSubStore = []byte{0x03}
-	delegateeSubStore     = []byte{0x04}
+	delegationSubstore        = []byte{0x00}
+	voterSubstore             = []byte{0x01}
+	voteSubstore              = []byte{0x02}
+	referenceListSubStore     = []byte{0x03}
+	delegateeSubStore         = []byte{0x04}
+	voterRewardSubStore       = []byte{0x05}
+	delegatorRewardSubStore   = []byte{0x06}
+	redelegationSubStore      = []byte{0x07}
+	countedDelegationSubStore = []byte{0x08}
+	redelegatorSubStore       = []byte{0x09}
 )
 
 // VoteStorage - vote storage
@@ -124,6 +129,66 @@ func (vs VoteStorage) DeleteVote(ctx sdk.Context, proposalID types.ProposalKey,
 	return nil
 }
 
+// SetCountedDelegation - set delegation counted in a vote for a proposal to KVStore
+func (vs VoteStorage) SetCountedDelegation(
+	ctx sdk.Context, proposalID types.ProposalKey, counted *CountedDelegation) sdk.Error {
+	store := ctx.KVStore(vs.key)
+	countedByte, err := vs.cdc.MarshalJSON(*counted)
+	if err != nil {
+		return ErrFailedToMarshalCountedDelegation(err)
+	}
+	store.Set(GetCountedDelegationKey(proposalID, counted.Delegator, counted.Voter), countedByte)
+	return nil
+}
+
+// DeleteCountedDelegation - delete delegation counted in a vote for a proposal from KVStore
+func (vs VoteStorage) DeleteCountedDelegation(
+	ctx sdk.Context, proposalID types.ProposalKey, delegator types.AccountKey, voter types.AccountKey) sdk.Error {
+	store := ctx.KVStore(vs.key)
+	store.Delete(GetCountedDelegationKey(proposalID, delegator, voter))
+	return nil
+}
+
+// DeleteProposalVotes - delete all votes and counted delegations of a proposal from KVStore
+func (vs VoteStorage) DeleteProposalVotes(ctx sdk.Context, proposalID types.ProposalKey) sdk.Error {
+	store := ctx.KVStore(vs.key)
+	var keys [][]byte
+	for _, prefix := range [][]byte{
+		getVotePrefix(proposalID), getProposalCountedDelegationPrefix(proposalID)} {
+		iterator := store.Iterator(subspace(prefix))
+		for ; iterator.Valid(); iterator.Next() {
+			keys = append(keys, iterator.Key())
+		}
+		iterator.Close()
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	return nil
+}
+
+// GetAllCountedDelegations - get delegations of a delegator counted in votes for a proposal from KVStore
+func (vs VoteStorage) GetAllCountedDelegations(
+	ctx sdk.Context, proposalID types.ProposalKey, delegator types.AccountKey) ([]CountedDelegation, sdk.Error) {
+	store := ctx.KVStore(vs.key)
+	iterator := store.Iterator(subspace(getCountedDelegationPrefix(proposalID, delegator)))
+
+	var countedDelegations []CountedDelegation
+
+	for ; iterator.Valid(); iterator.Next() {
+		countedBytes := iterator.Value()
+		var counted CountedDelegation
+		err := vs.cdc.UnmarshalJSON(countedBytes, &counted)
+		if err != nil {
+			iterator.Close()
+			return nil, ErrFailedToUnmarshalCountedDelegation(err)
+		}
+		countedDelegations = append(countedDelegations, counted)
+	}
+	iterator.Close()
+	return countedDelegations, nil
+}
+
 // GetDelegation - get delegation from KVStore
 func (vs VoteStorage) GetDelegation(ctx sdk.Context, voter types.AccountKey, delegator types.AccountKey) (*Delegation, sdk.Error) {
 	store := ctx.KVStore(vs.key)
@@ -178,6 +243,21 @@ func (vs VoteStorage) GetAllDelegators(ctx sdk.Context, voterName types.AccountK
 	return delegators, nil
 }
 
+// GetAllDelegatees - get all voters a delegator delegates to from KVStore
+func (vs VoteStorage) GetAllDelegatees(ctx sdk.Context, delegatorName types.AccountKey) ([]types.AccountKey, sdk.Error) {
+	store := ctx.KVStore(vs.key)
+	prefix := getDelegateePrefix(delegatorName)
+	iterator := store.Iterator(subspace(prefix))
+
+	var delegatees []types.AccountKey
+
+	for ; iterator.Valid(); iterator.Next() {
+		delegatees = append(delegatees, types.AccountKey(iterator.Key()[len(prefix):]))
+	}
+	iterator.Close()
+	return delegatees, nil
+}
+
 // GetAllVotes - get all votes of a proposal from KVStore
 func (vs VoteStorage) GetAllVotes(ctx sdk.Context, proposalID types.ProposalKey) ([]Vote, sdk.Error) {
 	store := ctx.KVStore(vs.key)
@@ -223,6 +303,136 @@ func (vs VoteStorage) SetReferenceList(ctx sdk.Context, lst *ReferenceList) sdk.
 	return nil
 }
 
+// GetVoterReward - get voter reward accumulator from KVStore, returns nil if
+// no reward has been distributed to delegators of the voter
+func (vs VoteStorage) GetVoterReward(ctx sdk.Context, voter types.AccountKey) (*VoterReward, sdk.Error) {
+	store := ctx.KVStore(vs.key)
+	rewardByte := store.Get(GetVoterRewardKey(voter))
+	if rewardByte == nil {
+		return nil, nil
+	}
+	reward := new(VoterReward)
+	if err := vs.cdc.UnmarshalJSON(rewardByte, reward); err != nil {
+		return nil, ErrFailedToUnmarshalVoterReward(err)
+	}
+	return reward, nil
+}
+
+// SetVoterReward - set voter reward accumulator to KVStore
+func (vs VoteStorage) SetVoterReward(ctx sdk.Context, voter types.AccountKey, reward *VoterReward) sdk.Error {
+	store := ctx.KVStore(vs.key)
+	rewardByte, err := vs.cdc.MarshalJSON(*reward)
+	if err != nil {
+		return ErrFailedToMarshalVoterReward(err)
+	}
+	store.Set(GetVoterRewardKey(voter), rewardByte)
+	return nil
+}
+
+// DeleteVoterReward - delete voter reward accumulator from KVStore
+func (vs VoteStorage) DeleteVoterReward(ctx sdk.Context, voter types.AccountKey) sdk.Error {
+	store := ctx.KVStore(vs.key)
+	store.Delete(GetVoterRewardKey(voter))
+	return nil
+}
+
+// GetDelegatorReward - get delegator reward from KVStore, returns nil if not exist
+func (vs VoteStorage) GetDelegatorReward(
+	ctx sdk.Context, voter types.AccountKey, delegator types.AccountKey) (*DelegatorReward, sdk.Error) {
+	store := ctx.KVStore(vs.key)
+	rewardByte := store.Get(GetDelegatorRewardKey(voter, delegator))
+	if rewardByte == nil {
+		return nil, nil
+	}
+	reward := new(DelegatorReward)
+	if err := vs.cdc.UnmarshalJSON(rewardByte, reward); err != nil {
+		return nil, ErrFailedToUnmarshalDelegatorReward(err)
+	}
+	return reward, nil
+}
+
+// SetDelegatorReward - set delegator reward to KVStore
+func (vs VoteStorage) SetDelegatorReward(
+	ctx sdk.Context, voter types.AccountKey, delegator types.AccountKey, reward *DelegatorReward) sdk.Error {
+	store := ctx.KVStore(vs.key)
+	rewardByte, err := vs.cdc.MarshalJSON(*reward)
+	if err != nil {
+		return ErrFailedToMarshalDelegatorReward(err)
+	}
+	store.Set(GetDelegatorRewardKey(voter, delegator), rewardByte)
+	return nil
+}
+
+// DeleteDelegatorReward - delete delegator reward from KVStore
+func (vs VoteStorage) DeleteDelegatorReward(ctx sdk.Context, voter types.AccountKey, delegator types.AccountKey) sdk.Error {
+	store := ctx.KVStore(vs.key)
+	store.Delete(GetDelegatorRewardKey(voter, delegator))
+	return nil
+}
+
+// GetRedelegationList - get redelegation list of a delegator from KVStore, returns nil if not exist
+func (vs VoteStorage) GetRedelegationList(ctx sdk.Context, delegator types.AccountKey) (*RedelegationList, sdk.Error) {
+	store := ctx.KVStore(vs.key)
+	lstByte := store.Get(GetRedelegationListKey(delegator))
+	if lstByte == nil {
+		return nil, nil
+	}
+	lst := new(RedelegationList)
+	if err := vs.cdc.UnmarshalJSON(lstByte, lst); err != nil {
+		return nil, ErrFailedToUnmarshalRedelegationList(err)
+	}
+	return lst, nil
+}
+
+// SetRedelegationList - set redelegation list of a delegator to KVStore,
+// delegator is indexed by source voters of its redelegations
+func (vs VoteStorage) SetRedelegationList(ctx sdk.Context, delegator types.AccountKey, lst *RedelegationList) sdk.Error {
+	if err := vs.DeleteRedelegationList(ctx, delegator); err != nil {
+		return err
+	}
+	store := ctx.KVStore(vs.key)
+	lstByte, err := vs.cdc.MarshalJSON(*lst)
+	if err != nil {
+		return ErrFailedToMarshalRedelegationList(err)
+	}
+	store.Set(GetRedelegationListKey(delegator), lstByte)
+	for _, redelegation := range lst.Redelegations {
+		store.Set(getRedelegatorKey(redelegation.FromVoter, delegator), []byte(delegator))
+	}
+	return nil
+}
+
+// DeleteRedelegationList - delete redelegation list of a delegator and its index from KVStore
+func (vs VoteStorage) DeleteRedelegationList(ctx sdk.Context, delegator types.AccountKey) sdk.Error {
+	lst, err := vs.GetRedelegationList(ctx, delegator)
+	if err != nil {
+		return err
+	}
+	store := ctx.KVStore(vs.key)
+	if lst != nil {
+		for _, redelegation := range lst.Redelegations {
+			store.Delete(getRedelegatorKey(redelegation.FromVoter, delegator))
+		}
+	}
+	store.Delete(GetRedelegationListKey(delegator))
+	return nil
+}
+
+// GetAllRedelegators - get all delegators redelegated from a voter from KVStore
+func (vs VoteStorage) GetAllRedelegators(ctx sdk.Context, fromVoter types.AccountKey) ([]types.AccountKey, sdk.Error) {
+	store := ctx.KVStore(vs.key)
+	prefix := getRedelegatorPrefix(fromVoter)
+	iterator := store.Iterator(subspace(prefix))
+
+	var redelegators []types.AccountKey
+
+	for ; iterator.Valid(); iterator.Next() {
+		redelegators = append(redelegators, types.AccountKey(iterator.Key()[len(prefix):]))
+	}
+	iterator.Close()
+	return redelegators, nil
+}
+
 func getDelegationPrefix(me types.AccountKey) []byte {
 	return append(append(delegationSubstore, me...), types.KeySeparator...)
 }
@@ -250,6 +460,42 @@ func getReferenceListKey() []byte {
 	return referenceListSubStore
 }
 
+// GetVoterRewardKey - "voter reward substore" + "voter"
+func GetVoterRewardKey(me types.AccountKey) []byte {
+	return append(voterRewardSubStore, me...)
+}
+
+// GetDelegatorRewardKey - "delegator reward substore" + "voter" + "delegator"
+func GetDelegatorRewardKey(me types.AccountKey, myDelegator types.AccountKey) []byte {
+	return append(append(append(delegatorRewardSubStore, me...), types.KeySeparator...), myDelegator...)
+}
+
+// GetRedelegationListKey - "redelegation substore" + "delegator"
+func GetRedelegationListKey(delegator types.AccountKey) []byte {
+	return append(redelegationSubStore, delegator...)
+}
+
+func getProposalCountedDelegationPrefix(proposalID types.ProposalKey) []byte {
+	return append(append(countedDelegationSubStore, proposalID...), types.KeySeparator...)
+}
+
+func getCountedDelegationPrefix(proposalID types.ProposalKey, delegator types.AccountKey) []byte {
+	return append(append(getProposalCountedDelegationPrefix(proposalID), delegator...), types.KeySeparator...)
+}
+
+// GetCountedDelegationKey - "counted delegation substore" + "proposalID" + "delegator" + "voter"
+func GetCountedDelegationKey(proposalID types.ProposalKey, delegator types.AccountKey, voter types.AccountKey) []byte {
+	return append(getCountedDelegationPrefix(proposalID, delegator), voter...)
+}
+
+func getRedelegatorPrefix(fromVoter types.AccountKey) []byte {
+	return append(append(redelegatorSubStore, fromVoter...), types.KeySeparator...)
+}
+
+func getRedelegatorKey(fromVoter, delegator types.AccountKey) []byte {
+	return append(getRedelegatorPrefix(fromVoter), delegator...)
+}
+
 func getDelegateePrefix(me types.AccountKey) []byte {
 	return append(append(delegateeSubStore, me...), types.KeySeparator...)
 }
@@ -508,3 +508,142 @@ func TestAllDelegation(t *testing.T) {
 		}
 	}
 }
+
+func TestVoterReward(t *testing.T) {
+	ctx, vs := setup(t)
+	user := types.AccountKey("user")
+
+	reward, err := vs.GetVoterReward(ctx, user)
+	assert.Nil(t, err)
+	assert.Nil(t, reward)
+
+	expect := VoterReward{
+		RewardPerPower:   sdk.NewRat(1, 10),
+		TotalDistributed: types.NewCoinFromInt64(1000),
+	}
+	err = vs.SetVoterReward(ctx, user, &expect)
+	assert.Nil(t, err)
+	reward, err = vs.GetVoterReward(ctx, user)
+	assert.Nil(t, err)
+	assert.Equal(t, expect, *reward)
+
+	err = vs.DeleteVoterReward(ctx, user)
+	assert.Nil(t, err)
+	reward, err = vs.GetVoterReward(ctx, user)
+	assert.Nil(t, err)
+	assert.Nil(t, reward)
+}
+
+func TestDelegatorReward(t *testing.T) {
+	ctx, vs := setup(t)
+	voter := types.AccountKey("voter")
+	delegator := types.AccountKey("delegator")
+
+	reward, err := vs.GetDelegatorReward(ctx, voter, delegator)
+	assert.Nil(t, err)
+	assert.Nil(t, reward)
+
+	expect := DelegatorReward{
+		LastRewardPerPower: sdk.NewRat(1, 10),
+		UnclaimedReward:    types.NewCoinFromInt64(100),
+	}
+	err = vs.SetDelegatorReward(ctx, voter, delegator, &expect)
+	assert.Nil(t, err)
+	reward, err = vs.GetDelegatorReward(ctx, voter, delegator)
+	assert.Nil(t, err)
+	assert.Equal(t, expect, *reward)
+	reward, err = vs.GetDelegatorReward(ctx, delegator, voter)
+	assert.Nil(t, err)
+	assert.Nil(t, reward)
+
+	err = vs.DeleteDelegatorReward(ctx, voter, delegator)
+	assert.Nil(t, err)
+	reward, err = vs.GetDelegatorReward(ctx, voter, delegator)
+	assert.Nil(t, err)
+	assert.Nil(t, reward)
+}
+
+func TestDeleteProposalVotes(t *testing.T) {
+	ctx, vs := setup(t)
+	voter, delegator := types.AccountKey("voter"), types.AccountKey("delegator")
+	proposalID1, proposalID10 := types.ProposalKey("1"), types.ProposalKey("10")
+	vote := Vote{Voter: voter, VotingPower: types.NewCoinFromInt64(1000), Result: true}
+	counted := CountedDelegation{Delegator: delegator, Voter: voter, Amount: types.NewCoinFromInt64(100)}
+	for _, proposalID := range []types.ProposalKey{proposalID1, proposalID10} {
+		assert.Nil(t, vs.SetVote(ctx, proposalID, voter, &vote))
+		assert.Nil(t, vs.SetCountedDelegation(ctx, proposalID, &counted))
+	}
+
+	err := vs.DeleteProposalVotes(ctx, proposalID1)
+	assert.Nil(t, err)
+	votes, err := vs.GetAllVotes(ctx, proposalID1)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(votes))
+	countedDelegations, err := vs.GetAllCountedDelegations(ctx, proposalID1, delegator)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(countedDelegations))
+
+	// proposal sharing the id prefix is untouched
+	votes, err = vs.GetAllVotes(ctx, proposalID10)
+	assert.Nil(t, err)
+	assert.Equal(t, []Vote{vote}, votes)
+	countedDelegations, err = vs.GetAllCountedDelegations(ctx, proposalID10, delegator)
+	assert.Nil(t, err)
+	assert.Equal(t, []CountedDelegation{counted}, countedDelegations)
+}
+
+func TestRedelegationList(t *testing.T) {
+	ctx, vs := setup(t)
+	delegator := types.AccountKey("delegator")
+
+	lst, err := vs.GetRedelegationList(ctx, delegator)
+	assert.Nil(t, err)
+	assert.Nil(t, lst)
+
+	redelegation := Redelegation{
+		Delegator:     delegator,
+		FromVoter:     types.AccountKey("voter1"),
+		ToVoter:       types.AccountKey("voter2"),
+		Amount:        types.NewCoinFromInt64(100),
+		CreatedHeight: 1,
+		CreatedAt:     100,
+		CompleteAt:    200,
+	}
+	expect := RedelegationList{Redelegations: []Redelegation{redelegation}}
+	err = vs.SetRedelegationList(ctx, delegator, &expect)
+	assert.Nil(t, err)
+	lst, err = vs.GetRedelegationList(ctx, delegator)
+	assert.Nil(t, err)
+	assert.Equal(t, expect, *lst)
+
+	other := redelegation
+	other.Delegator = types.AccountKey("other")
+	err = vs.SetRedelegationList(
+		ctx, other.Delegator, &RedelegationList{Redelegations: []Redelegation{other}})
+	assert.Nil(t, err)
+	redelegators, err := vs.GetAllRedelegators(ctx, types.AccountKey("voter1"))
+	assert.Nil(t, err)
+	assert.Equal(t, []types.AccountKey{delegator, other.Delegator}, redelegators)
+
+	// index follows source voters of the latest list
+	moved := redelegation
+	moved.FromVoter = types.AccountKey("voter3")
+	err = vs.SetRedelegationList(
+		ctx, delegator, &RedelegationList{Redelegations: []Redelegation{moved}})
+	assert.Nil(t, err)
+	redelegators, err = vs.GetAllRedelegators(ctx, types.AccountKey("voter1"))
+	assert.Nil(t, err)
+	assert.Equal(t, []types.AccountKey{other.Delegator}, redelegators)
+	redelegators, err = vs.GetAllRedelegators(ctx, types.AccountKey("voter3"))
+	assert.Nil(t, err)
+	assert.Equal(t, []types.AccountKey{delegator}, redelegators)
+
+	err = vs.DeleteRedelegationList(ctx, delegator)
+	assert.Nil(t, err)
+	lst, err = vs.GetRedelegationList(ctx, delegator)
+	assert.Nil(t, err)
+	assert.Nil(t, lst)
+	redelegators, err = vs.GetAllRedelegators(ctx, types.AccountKey("voter3"))
+	assert.Nil(t, err)
+	assert.Nil(t, redelegators)
+}
@@ -2,6 +2,8 @@ package model
 
 import (
 	types "github.com/lino-network/lino/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // Voter - a voter in blockchain is account with voter deposit, who can vote for a proposal
@@ -18,12 +20,51 @@ type Vote struct {
 	Result      bool             `json:"result"`
 }
 
+// CountedDelegation - snapshot of delegation of a delegator counted in the vote
+// of Voter for a proposal when the voter voted
+type CountedDelegation struct {
+	Delegator types.AccountKey `json:"delegator"`
+	Voter     types.AccountKey `json:"voter"`
+	Amount    types.Coin       `json:"amount"`
+}
+
 // Delegation - normal user can delegate money to a voter to increase voter's voting power
 type Delegation struct {
 	Delegator types.AccountKey `json:"delegator"`
 	Amount    types.Coin       `json:"amount"`
 }
 
+// VoterReward - reward accumulator of a voter, RewardPerPower is total reward
+// distributed to delegators per unit of delegated power since voter registered
+type VoterReward struct {
+	RewardPerPower   sdk.Rat    `json:"reward_per_power"`
+	TotalDistributed types.Coin `json:"total_distributed"`
+}
+
+// DelegatorReward - reward of a delegation, reward accumulated after
+// LastRewardPerPower hasn't been settled to UnclaimedReward yet
+type DelegatorReward struct {
+	LastRewardPerPower sdk.Rat    `json:"last_reward_per_power"`
+	UnclaimedReward    types.Coin `json:"unclaimed_reward"`
+}
+
+// Redelegation - delegation moved from FromVoter to ToVoter, the moved amount
+// can still be slashed for misbehaviour of FromVoter until CompleteAt
+type Redelegation struct {
+	Delegator     types.AccountKey `json:"delegator"`
+	FromVoter     types.AccountKey `json:"from_voter"`
+	ToVoter       types.AccountKey `json:"to_voter"`
+	Amount        types.Coin       `json:"amount"`
+	CreatedHeight int64            `json:"created_height"`
+	CreatedAt     int64            `json:"created_at"`
+	CompleteAt    int64            `json:"complete_at"`
+}
+
+// RedelegationList - redelegations of a delegator
+type RedelegationList struct {
+	Redelegations []Redelegation `json:"redelegations"`
+}
+
 // ReferenceList - record validator to punish the validator who doesn't vote for proposal
 type ReferenceList struct {
 	AllValidators []types.AccountKey `json:"all_validators"`
@@ -15,6 +15,8 @@ var _ types.Msg = VoterRevokeMsg{}
 var _ types.Msg = DelegateMsg{}
 var _ types.Msg = DelegatorWithdrawMsg{}
 var _ types.Msg = RevokeDelegationMsg{}
+var _ types.Msg = ClaimDelegatorRewardMsg{}
+var _ types.Msg = RedelegateMsg{}
 
 // VoterDepositMsg - voter deposit
 type VoterDepositMsg struct {
@@ -53,6 +55,20 @@ type RevokeDelegationMsg struct {
 	Voter     types.AccountKey `json:"voter"`
 }
 
+// ClaimDelegatorRewardMsg - delegator claim reward of delegation to a voter
+type ClaimDelegatorRewardMsg struct {
+	Delegator types.AccountKey `json:"delegator"`
+	Voter     types.AccountKey `json:"voter"`
+}
+
+// RedelegateMsg - delegator move delegation from one voter to another
+type RedelegateMsg struct {
+	Delegator types.AccountKey `json:"delegator"`
+	FromVoter types.AccountKey `json:"from_voter"`
+	ToVoter   types.AccountKey `json:"to_voter"`
+	Amount    types.LNO        `json:"amount"`
+}
+
 // NewVoterDepositMsg - return a VoterDepositMsg
 func NewVoterDepositMsg(username string, deposit types.LNO) VoterDepositMsg {
 	return VoterDepositMsg{
@@ -312,6 +328,57 @@ func (msg RevokeDelegationMsg) GetConsumeAmount() types.Coin {
 	return types.NewCoinFromInt64(0)
 }
 
+// NewClaimDelegatorRewardMsg - return ClaimDelegatorRewardMsg
+func NewClaimDelegatorRewardMsg(delegator string, voter string) ClaimDelegatorRewardMsg {
+	return ClaimDelegatorRewardMsg{
+		Delegator: types.AccountKey(delegator),
+		Voter:     types.AccountKey(voter),
+	}
+}
+
+// Type - implements sdk.Msg
+func (msg ClaimDelegatorRewardMsg) Type() string { return types.VoteRouterName }
+
+// ValidateBasic - implements sdk.Msg
+func (msg ClaimDelegatorRewardMsg) ValidateBasic() sdk.Error {
+	if len(msg.Delegator) < types.MinimumUsernameLength ||
+		len(msg.Delegator) > types.MaximumUsernameLength ||
+		len(msg.Voter) < types.MinimumUsernameLength ||
+		len(msg.Voter) > types.MaximumUsernameLength {
+		return ErrInvalidUsername()
+	}
+
+	return nil
+}
+
+func (msg ClaimDelegatorRewardMsg) String() string {
+	return fmt.Sprintf("ClaimDelegatorRewardMsg{Delegator:%v, Voter:%v}", msg.Delegator, msg.Voter)
+}
+
+// GetPermission - implements types.Msg
+func (msg ClaimDelegatorRewardMsg) GetPermission() types.Permission {
+	return types.TransactionPermission
+}
+
+// GetSignBytes - implements sdk.Msg
+func (msg ClaimDelegatorRewardMsg) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners - implements sdk.Msg
+func (msg ClaimDelegatorRewardMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Delegator)}
+}
+
+// GetConsumeAmount - implement types.Msg
+func (msg ClaimDelegatorRewardMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
+
 // NewRevokeDelegationMsg - return NewDelegatorWithdrawMsg
 func NewDelegatorWithdrawMsg(delegator string, voter string, amount types.LNO) DelegatorWithdrawMsg {
 	return DelegatorWithdrawMsg{
@@ -366,3 +433,66 @@ func (msg DelegatorWithdrawMsg) GetSigners() []sdk.AccAddress {
 func (msg DelegatorWithdrawMsg) GetConsumeAmount() types.Coin {
 	return types.NewCoinFromInt64(0)
 }
+
+// NewRedelegateMsg - return RedelegateMsg
+func NewRedelegateMsg(delegator string, fromVoter string, toVoter string, amount types.LNO) RedelegateMsg {
+	return RedelegateMsg{
+		Delegator: types.AccountKey(delegator),
+		FromVoter: types.AccountKey(fromVoter),
+		ToVoter:   types.AccountKey(toVoter),
+		Amount:    amount,
+	}
+}
+
+// Type - implements sdk.Msg
+func (msg RedelegateMsg) Type() string { return types.VoteRouterName }
+
+// ValidateBasic - implements sdk.Msg
+func (msg RedelegateMsg) ValidateBasic() sdk.Error {
+	if len(msg.Delegator) < types.MinimumUsernameLength ||
+		len(msg.Delegator) > types.MaximumUsernameLength ||
+		len(msg.FromVoter) < types.MinimumUsernameLength ||
+		len(msg.FromVoter) > types.MaximumUsernameLength ||
+		len(msg.ToVoter) < types.MinimumUsernameLength ||
+		len(msg.ToVoter) > types.MaximumUsernameLength {
+		return ErrInvalidUsername()
+	}
+	if msg.FromVoter == msg.ToVoter {
+		return ErrInvalidRedelegation()
+	}
+	_, err := types.LinoToCoin(msg.Amount)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (msg RedelegateMsg) String() string {
+	return fmt.Sprintf(
+		"RedelegateMsg{Delegator:%v, FromVoter:%v, ToVoter:%v, Amount:%v}",
+		msg.Delegator, msg.FromVoter, msg.ToVoter, msg.Amount)
+}
+
+// GetPermission - implements types.Msg
+func (msg RedelegateMsg) GetPermission() types.Permission {
+	return types.TransactionPermission
+}
+
+// GetSignBytes - implements sdk.Msg
+func (msg RedelegateMsg) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners - implements sdk.Msg
+func (msg RedelegateMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Delegator)}
+}
+
+// GetConsumeAmount - implement types.Msg
+func (msg RedelegateMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
@@ -174,6 +174,37 @@ func TestRevokeDelegationMsg(t *testing.T) {
 	}
 }
 
+func TestClaimDelegatorRewardMsg(t *testing.T) {
+	testCases := []struct {
+		testName      string
+		claimMsg      ClaimDelegatorRewardMsg
+		expectedError sdk.Error
+	}{
+		{
+			testName:      "normal case",
+			claimMsg:      NewClaimDelegatorRewardMsg("user1", "user2"),
+			expectedError: nil,
+		},
+		{
+			testName:      "invalid delegator",
+			claimMsg:      NewClaimDelegatorRewardMsg("", "user2"),
+			expectedError: ErrInvalidUsername(),
+		},
+		{
+			testName:      "invalid voter",
+			claimMsg:      NewClaimDelegatorRewardMsg("user1", ""),
+			expectedError: ErrInvalidUsername(),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := tc.claimMsg.ValidateBasic()
+		if !assert.Equal(t, result, tc.expectedError) {
+			t.Errorf("%s: diff result, got %v, expect %v", tc.testName, result, tc.expectedError)
+		}
+	}
+}
+
 func TestDelegatorWithdrawMsg(t *testing.T) {
 	testCases := []struct {
 		testName             string
@@ -205,6 +236,42 @@ func TestDelegatorWithdrawMsg(t *testing.T) {
 	}
 }
 
+func TestRedelegateMsg(t *testing.T) {
+	testCases := []struct {
+		testName      string
+		redelegateMsg RedelegateMsg
+		expectedError sdk.Error
+	}{
+		{
+			testName:      "normal case",
+			redelegateMsg: NewRedelegateMsg("user1", "user2", "user3", "1"),
+			expectedError: nil,
+		},
+		{
+			testName:      "invalid username",
+			redelegateMsg: NewRedelegateMsg("user1", "user2", "", "1"),
+			expectedError: ErrInvalidUsername(),
+		},
+		{
+			testName:      "redelegate to same voter",
+			redelegateMsg: NewRedelegateMsg("user1", "user2", "user2", "1"),
+			expectedError: ErrInvalidRedelegation(),
+		},
+		{
+			testName:      "invalid redelegate amount",
+			redelegateMsg: NewRedelegateMsg("user1", "user2", "user3", "-1"),
+			expectedError: types.ErrInvalidCoins("LNO can't be less than lower bound"),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := tc.redelegateMsg.ValidateBasic()
+		if !assert.Equal(t, result, tc.expectedError) {
+			t.Errorf("%s: diff result, got %v, expect %v", tc.testName, result, tc.expectedError)
+		}
+	}
+}
+
 func TestMsgPermission(t *testing.T) {
 	testCases := []struct {
 		testName           string
@@ -241,6 +308,16 @@ func TestMsgPermission(t *testing.T) {
 			msg:                NewRevokeDelegationMsg("delegator", "voter"),
 			expectedPermission: types.TransactionPermission,
 		},
+		{
+			testName:           "claim delegator reward",
+			msg:                NewClaimDelegatorRewardMsg("delegator", "voter"),
+			expectedPermission: types.TransactionPermission,
+		},
+		{
+			testName:           "redelegate",
+			msg:                NewRedelegateMsg("delegator", "voter1", "voter2", types.LNO("1")),
+			expectedPermission: types.TransactionPermission,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -280,6 +357,14 @@ func TestGetSignBytes(t *testing.T) {
 			testName: "revoke delegation",
 			msg:      NewRevokeDelegationMsg("delegator", "voter"),
 		},
+		{
+			testName: "claim delegator reward",
+			msg:      NewClaimDelegatorRewardMsg("delegator", "voter"),
+		},
+		{
+			testName: "redelegate",
+			msg:      NewRedelegateMsg("delegator", "voter1", "voter2", types.LNO("1")),
+		},
 	}
 
 	for _, tc := range testCases {
@@ -323,6 +408,16 @@ func TestGetSigners(t *testing.T) {
 			msg:           NewRevokeDelegationMsg("delegator", "voter"),
 			expectSigners: []types.AccountKey{"delegator"},
 		},
+		{
+			testName:      "claim delegator reward",
+			msg:           NewClaimDelegatorRewardMsg("delegator", "voter"),
+			expectSigners: []types.AccountKey{"delegator"},
+		},
+		{
+			testName:      "redelegate",
+			msg:           NewRedelegateMsg("delegator", "voter1", "voter2", types.LNO("1")),
+			expectSigners: []types.AccountKey{"delegator"},
+		},
 	}
 
 	for _, tc := range testCases {
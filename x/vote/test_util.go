@@ -43,6 +43,7 @@ func setupTest(t *testing.T, height int64) (sdk.Context,
 	cdc := globalManager.WireCodec()
 	cdc.RegisterInterface((*types.Event)(nil), nil)
 	cdc.RegisterConcrete(acc.ReturnCoinEvent{}, "1", nil)
+	cdc.RegisterConcrete(RedelegationCompleteEvent{}, "2", nil)
 
 	err := initGlobalManager(ctx, globalManager)
 	assert.Nil(t, err)
@@ -12,6 +12,8 @@ func RegisterWire(cdc *wire.Codec) {
 	cdc.RegisterConcrete(DelegateMsg{}, "lino/delegate", nil)
 	cdc.RegisterConcrete(DelegatorWithdrawMsg{}, "lino/delegateWithdraw", nil)
 	cdc.RegisterConcrete(RevokeDelegationMsg{}, "lino/delegateRevoke", nil)
+	cdc.RegisterConcrete(ClaimDelegatorRewardMsg{}, "lino/delegateClaimReward", nil)
+	cdc.RegisterConcrete(RedelegateMsg{}, "lino/redelegate", nil)
 }
 
 var msgCdc = wire.NewCodec()